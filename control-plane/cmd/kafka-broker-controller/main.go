@@ -19,18 +19,29 @@ package main
 import (
 	"context"
 	"log"
+	"sync"
 
 	"github.com/kelseyhightower/envconfig"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/sharedmain"
+	"knative.dev/pkg/signals"
+	"knative.dev/pkg/webhook"
+	"knative.dev/pkg/webhook/certificates"
 
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/contract"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/trigger"
 )
 
 const (
 	component = "kafka-broker-controller"
+
+	// The webhook validating Kafka-class Brokers is served by the controller.
+	webhookServiceName = "kafka-broker-webhook"
+	webhookSecretName  = "kafka-broker-webhook-certs"
+	webhookDefaultPort = 8443
 )
 
 func main() {
@@ -47,15 +58,46 @@ func main() {
 		BootstrapServers: "",
 	}
 
-	sharedmain.Main(
+	// The Broker and the Trigger reconcilers share the ContractWriter, so that their updates of the data plane config
+	// are coalesced.
+	var contractWriter *base.ContractWriter
+	var contractWriterOnce sync.Once
+	getContractWriter := func(ctx context.Context) *base.ContractWriter {
+		contractWriterOnce.Do(func() {
+			contractWriter = broker.NewContractWriter(ctx, &brokerConfigs.EnvConfigs)
+			// Data plane config maps that can't be decoded are rebuilt from the Brokers and Triggers in the
+			// informer caches.
+			contractWriter.Rebuild = contract.NewBuilder(ctx, contractWriter.Reconciler).Build
+		})
+		return contractWriter
+	}
+
+	ctx := webhook.WithOptions(signals.NewContext(), webhook.Options{
+		ServiceName: webhookServiceName,
+		SecretName:  webhookSecretName,
+		Port:        webhook.PortFromEnv(webhookDefaultPort),
+	})
+
+	sharedmain.MainWithContext(
+		ctx,
 		component,
 
 		func(ctx context.Context, watcher configmap.Watcher) *controller.Impl {
-			return broker.NewController(ctx, watcher, brokerConfigs)
+			return broker.NewController(ctx, watcher, brokerConfigs, getContractWriter(ctx))
 		},
 
 		func(ctx context.Context, watcher configmap.Watcher) *controller.Impl {
-			return trigger.NewController(ctx, watcher, &brokerConfigs.EnvConfigs)
+			return trigger.NewController(ctx, watcher, &brokerConfigs.EnvConfigs, getContractWriter(ctx))
+		},
+
+		func(ctx context.Context, watcher configmap.Watcher) *controller.Impl {
+			return contract.NewController(ctx, watcher, &brokerConfigs.EnvConfigs, getContractWriter(ctx))
+		},
+
+		certificates.NewController,
+
+		func(ctx context.Context, watcher configmap.Watcher) *controller.Impl {
+			return broker.NewValidationAdmissionController(ctx, watcher, &brokerConfigs.EnvConfigs)
 		},
 	)
 }
@@ -0,0 +1,341 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package clusteradmin provides caches of sarama ClusterAdmin and Client, so that reconcilers reuse connections to
+// Kafka clusters instead of opening a new connection for every reconciliation.
+package clusteradmin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/security"
+)
+
+const (
+	// DefaultIdleTimeout is the time after which an unused ClusterAdmin or Client is closed.
+	DefaultIdleTimeout = 10 * time.Minute
+
+	// DefaultHealthCheckInterval is the minimum interval between two health checks of a cached ClusterAdmin or
+	// Client.
+	DefaultHealthCheckInterval = 30 * time.Second
+)
+
+// NewClusterAdminFunc creates a new sarama ClusterAdmin.
+type NewClusterAdminFunc func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error)
+
+// NewClientFunc creates a new sarama Client.
+type NewClientFunc func(addrs []string, config *sarama.Config) (sarama.Client, error)
+
+// ReleaseFunc releases a ClusterAdmin returned by Cache.Get, or a Client returned by ClientCache.Get.
+// The error is the result of the operations done with the ClusterAdmin or the Client, a non nil error forces a health
+// check the next time it's requested.
+type ReleaseFunc func(err error)
+
+// Cache is a concurrency safe cache of sarama ClusterAdmin keyed by bootstrap servers and auth secret.
+//
+// Cached ClusterAdmin are health checked before being reused, broken ones are replaced, and ClusterAdmin unused for
+// the idle timeout are closed by Run.
+type Cache struct {
+	pool
+}
+
+// NewCache creates a new Cache using the given function to create ClusterAdmin.
+func NewCache(newClusterAdmin NewClusterAdminFunc) *Cache {
+	return &Cache{
+		pool: newPool(
+			"cluster admin",
+			func(addrs []string, config *sarama.Config) (io.Closer, error) {
+				return newClusterAdmin(addrs, config)
+			},
+			func(c io.Closer) error {
+				_, _, err := c.(sarama.ClusterAdmin).DescribeCluster()
+				return err
+			},
+		),
+	}
+}
+
+// Get returns a ClusterAdmin connecting to the given bootstrap servers using TLS and SASL settings of the given
+// secret, when not nil.
+//
+// The returned ClusterAdmin must not be closed, callers must call the returned ReleaseFunc when they're done with it.
+func (c *Cache) Get(bootstrapServers []string, secret *corev1.Secret) (sarama.ClusterAdmin, ReleaseFunc, error) {
+	admin, release, err := c.get(bootstrapServers, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	return admin.(sarama.ClusterAdmin), release, nil
+}
+
+// ClientCache is a concurrency safe cache of sarama Client keyed by bootstrap servers and auth secret, it works like
+// Cache.
+type ClientCache struct {
+	pool
+}
+
+// NewClientCache creates a new ClientCache using the given function to create Client.
+func NewClientCache(newClient NewClientFunc) *ClientCache {
+	return &ClientCache{
+		pool: newPool(
+			"client",
+			func(addrs []string, config *sarama.Config) (io.Closer, error) {
+				return newClient(addrs, config)
+			},
+			func(c io.Closer) error {
+				_, err := c.(sarama.Client).RefreshController()
+				return err
+			},
+		),
+	}
+}
+
+// Get returns a Client connecting to the given bootstrap servers using TLS and SASL settings of the given secret,
+// when not nil.
+//
+// The returned Client must not be closed, callers must call the returned ReleaseFunc when they're done with it.
+func (c *ClientCache) Get(bootstrapServers []string, secret *corev1.Secret) (sarama.Client, ReleaseFunc, error) {
+	client, release, err := c.get(bootstrapServers, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.(sarama.Client), release, nil
+}
+
+// pool is the cache shared by Cache and ClientCache, the cached values are connections to Kafka clusters.
+type pool struct {
+	// kind is the kind of connection, used in error messages.
+	kind string
+	// create creates a connection.
+	create func(addrs []string, config *sarama.Config) (io.Closer, error)
+	// check returns an error when the given connection is broken.
+	check func(c io.Closer) error
+
+	idleTimeout         time.Duration
+	healthCheckInterval time.Duration
+	now                 func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	conn io.Closer
+
+	// number of callers using conn.
+	users int
+	// lastUsed is the last time conn was released.
+	lastUsed time.Time
+	// lastHealthCheck is the last time conn was known to be healthy.
+	lastHealthCheck time.Time
+	// broken signals that conn has been replaced and it has to be closed once released by every user.
+	broken bool
+}
+
+func newPool(kind string, create func(addrs []string, config *sarama.Config) (io.Closer, error), check func(c io.Closer) error) pool {
+	return pool{
+		kind:                kind,
+		create:              create,
+		check:               check,
+		idleTimeout:         DefaultIdleTimeout,
+		healthCheckInterval: DefaultHealthCheckInterval,
+		now:                 time.Now,
+		entries:             make(map[string]*entry),
+	}
+}
+
+func (c *pool) get(bootstrapServers []string, secret *corev1.Secret) (io.Closer, ReleaseFunc, error) {
+	k := key(bootstrapServers, secret)
+
+	if e := c.acquire(k); e != nil {
+		if c.healthy(e) {
+			return e.conn, c.releaseFunc(k, e), nil
+		}
+		c.markBroken(k, e)
+	}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+
+	if err := security.ConfigureSarama(config, secret); err != nil {
+		return nil, nil, fmt.Errorf("failed to configure %s: %w", c.kind, err)
+	}
+
+	// Creating a connection connects to the cluster, so don't hold the lock.
+	conn, err := c.create(bootstrapServers, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s: %w", c.kind, err)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if e, ok := c.entries[k]; ok {
+		// Another caller created a connection in the meantime, use it and close ours.
+		_ = conn.Close()
+		e.users++
+		return e.conn, c.releaseFunc(k, e), nil
+	}
+
+	e := &entry{
+		conn:            conn,
+		users:           1,
+		lastUsed:        c.now(),
+		lastHealthCheck: c.now(),
+	}
+	c.entries[k] = e
+
+	return e.conn, c.releaseFunc(k, e), nil
+}
+
+// acquire returns the cached entry for the given key, if any, incrementing its users.
+func (c *pool) acquire(k string) *entry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	e, ok := c.entries[k]
+	if !ok {
+		return nil
+	}
+	e.users++
+	return e
+}
+
+// healthy checks the health of the given entry when it hasn't been checked in the last health check interval.
+func (c *pool) healthy(e *entry) bool {
+	c.mutex.Lock()
+	checked := c.now().Sub(e.lastHealthCheck) < c.healthCheckInterval
+	c.mutex.Unlock()
+
+	if checked {
+		return true
+	}
+
+	if err := c.check(e.conn); err != nil {
+		return false
+	}
+
+	c.mutex.Lock()
+	e.lastHealthCheck = c.now()
+	c.mutex.Unlock()
+
+	return true
+}
+
+// markBroken removes the given entry from the cache and releases it, so that it's closed once unused.
+func (c *pool) markBroken(k string, e *entry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.entries[k] == e {
+		delete(c.entries, k)
+	}
+	e.broken = true
+	c.release(e)
+}
+
+func (c *pool) releaseFunc(k string, e *entry) ReleaseFunc {
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() {
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+
+			if err != nil {
+				// Force a health check on the next Get.
+				e.lastHealthCheck = time.Time{}
+			}
+			c.release(e)
+		})
+	}
+}
+
+// release decrements users of the given entry and closes it when it's broken and unused.
+// The caller must hold the lock.
+func (c *pool) release(e *entry) {
+	e.users--
+	e.lastUsed = c.now()
+
+	if e.broken && e.users == 0 {
+		_ = e.conn.Close()
+	}
+}
+
+// EvictIdle closes and removes every connection unused for the idle timeout.
+func (c *pool) EvictIdle() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+	for k, e := range c.entries {
+		if e.users == 0 && now.Sub(e.lastUsed) >= c.idleTimeout {
+			delete(c.entries, k)
+			_ = e.conn.Close()
+		}
+	}
+}
+
+// Run periodically evicts idle connections until the given context is done, then it closes every unused
+// connection.
+func (c *pool) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.idleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.close()
+			return
+		case <-ticker.C:
+			c.EvictIdle()
+		}
+	}
+}
+
+func (c *pool) close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for k, e := range c.entries {
+		delete(c.entries, k)
+		e.broken = true
+		if e.users == 0 {
+			_ = e.conn.Close()
+		}
+	}
+}
+
+// key returns the cache key of the given bootstrap servers and secret.
+// The secret resource version is part of the key, so that a connection is recreated when credentials change.
+func key(bootstrapServers []string, secret *corev1.Secret) string {
+	servers := make([]string, len(bootstrapServers))
+	copy(servers, bootstrapServers)
+	sort.Strings(servers)
+
+	k := strings.Join(servers, ",")
+	if secret != nil {
+		k += fmt.Sprintf("|%s/%s/%s/%s", secret.Namespace, secret.Name, secret.UID, secret.ResourceVersion)
+	}
+	return k
+}
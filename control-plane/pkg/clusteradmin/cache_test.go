@@ -0,0 +1,318 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusteradmin
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeClusterAdmin struct {
+	sarama.ClusterAdmin
+
+	mutex                  sync.Mutex
+	closed                 bool
+	describeClusterCalls   int
+	errorOnDescribeCluster error
+}
+
+func (f *fakeClusterAdmin) DescribeCluster() ([]*sarama.Broker, int32, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.describeClusterCalls++
+	return nil, 0, f.errorOnDescribeCluster
+}
+
+func (f *fakeClusterAdmin) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.closed = true
+	return nil
+}
+
+func (f *fakeClusterAdmin) isClosed() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.closed
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestCache(t *testing.T) (*Cache, *fakeClock, *[]*fakeClusterAdmin) {
+	t.Helper()
+
+	var mutex sync.Mutex
+	admins := &[]*fakeClusterAdmin{}
+	clock := &fakeClock{now: time.Now()}
+
+	cache := NewCache(func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		admin := &fakeClusterAdmin{}
+		*admins = append(*admins, admin)
+		return admin, nil
+	})
+	cache.now = clock.Now
+
+	return cache, clock, admins
+}
+
+func TestCacheReusesClusterAdmin(t *testing.T) {
+	cache, _, admins := newTestCache(t)
+
+	admin1, release1, err := cache.Get([]string{"b1:9092", "b2:9092"}, nil)
+	assert.Nil(t, err)
+	release1(nil)
+
+	// Same bootstrap servers in a different order.
+	admin2, release2, err := cache.Get([]string{"b2:9092", "b1:9092"}, nil)
+	assert.Nil(t, err)
+	release2(nil)
+
+	assert.Same(t, admin1, admin2)
+	assert.Len(t, *admins, 1)
+	assert.False(t, (*admins)[0].isClosed())
+	assert.Equal(t, 0, (*admins)[0].describeClusterCalls)
+}
+
+func TestCacheKeyedByAuthSecret(t *testing.T) {
+	cache, _, admins := newTestCache(t)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns",
+			Name:            "name",
+			ResourceVersion: "1",
+		},
+	}
+
+	admin1, release1, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release1(nil)
+
+	admin2, release2, err := cache.Get([]string{"b1:9092"}, secret)
+	assert.Nil(t, err)
+	release2(nil)
+
+	updated := secret.DeepCopy()
+	updated.ResourceVersion = "2"
+
+	admin3, release3, err := cache.Get([]string{"b1:9092"}, updated)
+	assert.Nil(t, err)
+	release3(nil)
+
+	assert.NotSame(t, admin1, admin2)
+	assert.NotSame(t, admin2, admin3)
+	assert.Len(t, *admins, 3)
+}
+
+func TestCacheInvalidAuthSecret(t *testing.T) {
+	cache, _, admins := newTestCache(t)
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"protocol": []byte("unknown"),
+		},
+	}
+
+	_, _, err := cache.Get([]string{"b1:9092"}, secret)
+	assert.NotNil(t, err)
+	assert.Len(t, *admins, 0)
+}
+
+func TestCacheHealthCheck(t *testing.T) {
+	cache, clock, admins := newTestCache(t)
+
+	_, release, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release(nil)
+
+	clock.now = clock.now.Add(DefaultHealthCheckInterval)
+
+	_, release, err = cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release(nil)
+
+	assert.Len(t, *admins, 1)
+	assert.Equal(t, 1, (*admins)[0].describeClusterCalls)
+}
+
+func TestCacheReplacesBrokenClusterAdmin(t *testing.T) {
+	cache, _, admins := newTestCache(t)
+
+	admin1, release1, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+
+	(*admins)[0].errorOnDescribeCluster = sarama.ErrOutOfBrokers
+
+	// An error forces a health check on the next Get.
+	release1(errors.New("failed"))
+
+	admin2, release2, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release2(nil)
+
+	assert.NotSame(t, admin1, admin2)
+	assert.Len(t, *admins, 2)
+	assert.True(t, (*admins)[0].isClosed())
+	assert.False(t, (*admins)[1].isClosed())
+}
+
+func TestCacheClosesBrokenClusterAdminWhenReleased(t *testing.T) {
+	cache, clock, admins := newTestCache(t)
+
+	_, release1, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+
+	(*admins)[0].errorOnDescribeCluster = sarama.ErrOutOfBrokers
+	clock.now = clock.now.Add(DefaultHealthCheckInterval)
+
+	_, release2, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release2(nil)
+
+	// Still in use.
+	assert.False(t, (*admins)[0].isClosed())
+
+	release1(nil)
+	release1(nil) // releasing twice is a no-op
+
+	assert.True(t, (*admins)[0].isClosed())
+	assert.False(t, (*admins)[1].isClosed())
+}
+
+func TestCacheEvictIdle(t *testing.T) {
+	cache, clock, admins := newTestCache(t)
+
+	_, release1, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release1(nil)
+
+	_, release2, err := cache.Get([]string{"b2:9092"}, nil)
+	assert.Nil(t, err)
+
+	clock.now = clock.now.Add(DefaultIdleTimeout)
+	cache.EvictIdle()
+
+	assert.True(t, (*admins)[0].isClosed())
+	assert.False(t, (*admins)[1].isClosed(), "in use cluster admin evicted")
+
+	release2(nil)
+
+	_, release1, err = cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release1(nil)
+
+	assert.Len(t, *admins, 3)
+}
+
+func TestCacheConcurrentGet(t *testing.T) {
+	cache, _, admins := newTestCache(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, release, err := cache.Get([]string{"b1:9092"}, nil)
+			assert.Nil(t, err)
+			release(nil)
+		}()
+	}
+	wg.Wait()
+
+	open := 0
+	for _, admin := range *admins {
+		if !admin.isClosed() {
+			open++
+		}
+	}
+	assert.Equal(t, 1, open)
+}
+
+type fakeClient struct {
+	sarama.Client
+
+	closed                   bool
+	refreshControllerCalls   int
+	errorOnRefreshController error
+}
+
+func (f *fakeClient) RefreshController() (*sarama.Broker, error) {
+	f.refreshControllerCalls++
+	return nil, f.errorOnRefreshController
+}
+
+func (f *fakeClient) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestClientCache(t *testing.T) {
+	var clients []*fakeClient
+	clock := &fakeClock{now: time.Now()}
+
+	cache := NewClientCache(func(addrs []string, config *sarama.Config) (sarama.Client, error) {
+		client := &fakeClient{}
+		clients = append(clients, client)
+		return client, nil
+	})
+	cache.now = clock.Now
+
+	client1, release1, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release1(nil)
+
+	client2, release2, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release2(nil)
+
+	assert.Same(t, client1, client2)
+	assert.Len(t, clients, 1)
+	assert.Equal(t, 0, clients[0].refreshControllerCalls)
+
+	clients[0].errorOnRefreshController = sarama.ErrOutOfBrokers
+	clock.now = clock.now.Add(DefaultHealthCheckInterval)
+
+	client3, release3, err := cache.Get([]string{"b1:9092"}, nil)
+	assert.Nil(t, err)
+	release3(nil)
+
+	assert.NotSame(t, client1, client3)
+	assert.Len(t, clients, 2)
+	assert.Equal(t, 1, clients[0].refreshControllerCalls)
+	assert.True(t, clients[0].closed)
+	assert.False(t, clients[1].closed)
+}
@@ -20,6 +20,103 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type BackoffPolicy int32
+
+const (
+	// delay is backoffDelay*2^<numberOfRetries>.
+	BackoffPolicy_Exponential BackoffPolicy = 0
+	// delay is backoffDelay between every retry.
+	BackoffPolicy_Linear BackoffPolicy = 1
+)
+
+var BackoffPolicy_name = map[int32]string{
+	0: "Exponential",
+	1: "Linear",
+}
+
+var BackoffPolicy_value = map[string]int32{
+	"Exponential": 0,
+	"Linear":      1,
+}
+
+func (x BackoffPolicy) String() string {
+	return proto.EnumName(BackoffPolicy_name, int32(x))
+}
+
+func (BackoffPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3cd32e421bcc2dd3, []int{0}
+}
+
+type DeliveryOrder int32
+
+const (
+	// events are sent concurrently, without waiting for the destination to handle previous events.
+	DeliveryOrder_Unordered DeliveryOrder = 0
+	// events of the same partition are sent one at a time, in the order they were appended to the partition.
+	DeliveryOrder_Ordered DeliveryOrder = 1
+)
+
+var DeliveryOrder_name = map[int32]string{
+	0: "Unordered",
+	1: "Ordered",
+}
+
+var DeliveryOrder_value = map[string]int32{
+	"Unordered": 0,
+	"Ordered":   1,
+}
+
+func (x DeliveryOrder) String() string {
+	return proto.EnumName(DeliveryOrder_name, int32(x))
+}
+
+func (DeliveryOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3cd32e421bcc2dd3, []int{1}
+}
+
+type FilterDialect int32
+
+const (
+	// attributes values are equal to the event context attributes values.
+	FilterDialect_Exact FilterDialect = 0
+	// attributes values are prefixes of the event context attributes values.
+	FilterDialect_Prefix FilterDialect = 1
+	// attributes values are suffixes of the event context attributes values.
+	FilterDialect_Suffix FilterDialect = 2
+	// the event matches all the nested filters.
+	FilterDialect_All FilterDialect = 3
+	// the event matches at least one of the nested filters.
+	FilterDialect_Any FilterDialect = 4
+	// the event doesn't match the nested filter.
+	FilterDialect_Not FilterDialect = 5
+)
+
+var FilterDialect_name = map[int32]string{
+	0: "Exact",
+	1: "Prefix",
+	2: "Suffix",
+	3: "All",
+	4: "Any",
+	5: "Not",
+}
+
+var FilterDialect_value = map[string]int32{
+	"Exact":  0,
+	"Prefix": 1,
+	"Suffix": 2,
+	"All":    3,
+	"Any":    4,
+	"Not":    5,
+}
+
+func (x FilterDialect) String() string {
+	return proto.EnumName(FilterDialect_name, int32(x))
+}
+
+func (FilterDialect) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3cd32e421bcc2dd3, []int{2}
+}
+
 type Trigger struct {
 	// attributes filters events by exact match on event context attributes.
 	// Each key in the map is compared with the equivalent key in the event
@@ -31,10 +128,22 @@ type Trigger struct {
 	// destination is the address that receives events from the Broker that pass the Filter.
 	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
 	// trigger identifier
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// paused triggers aren't consumed, dispatchers leave the consumer group of paused triggers.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	// dead letter sink URI, when empty the dead letter sink of the broker is used.
+	DeadLetterSink string `protobuf:"bytes,5,opt,name=deadLetterSink,proto3" json:"deadLetterSink,omitempty"`
+	// delivery guarantees applied to events sent to the trigger destination.
+	// When not set, the egress config of the broker is used.
+	EgressConfig *EgressConfig `protobuf:"bytes,6,opt,name=egressConfig,proto3" json:"egressConfig,omitempty"`
+	// order in which events are sent to the trigger destination.
+	DeliveryOrder DeliveryOrder `protobuf:"varint,7,opt,name=deliveryOrder,proto3,enum=DeliveryOrder" json:"deliveryOrder,omitempty"`
+	// filters events by matching filter expressions on event context attributes.
+	// An event passes the filter if it matches every expression and the attributes filter.
+	Filters              []*Filter `protobuf:"bytes,8,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Trigger) Reset()         { *m = Trigger{} }
@@ -83,6 +192,41 @@ func (m *Trigger) GetId() string {
 	return ""
 }
 
+func (m *Trigger) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Trigger) GetDeadLetterSink() string {
+	if m != nil {
+		return m.DeadLetterSink
+	}
+	return ""
+}
+
+func (m *Trigger) GetEgressConfig() *EgressConfig {
+	if m != nil {
+		return m.EgressConfig
+	}
+	return nil
+}
+
+func (m *Trigger) GetDeliveryOrder() DeliveryOrder {
+	if m != nil {
+		return m.DeliveryOrder
+	}
+	return DeliveryOrder_Unordered
+}
+
+func (m *Trigger) GetFilters() []*Filter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
 type Broker struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// the Kafka topic to consume.
@@ -94,10 +238,15 @@ type Broker struct {
 	// path to listen for incoming events.
 	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
 	// A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
-	BootstrapServers     string   `protobuf:"bytes,6,opt,name=bootstrapServers,proto3" json:"bootstrapServers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	BootstrapServers string `protobuf:"bytes,6,opt,name=bootstrapServers,proto3" json:"bootstrapServers,omitempty"`
+	// reference to the secret containing the credentials to connect to the Kafka cluster.
+	// The data plane loads the secret, credentials are never written in the contract.
+	AuthSecret *SecretReference `protobuf:"bytes,7,opt,name=authSecret,proto3" json:"authSecret,omitempty"`
+	// delivery guarantees applied to events sent to triggers of the broker.
+	EgressConfig         *EgressConfig `protobuf:"bytes,8,opt,name=egressConfig,proto3" json:"egressConfig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Broker) Reset()         { *m = Broker{} }
@@ -167,6 +316,78 @@ func (m *Broker) GetBootstrapServers() string {
 	return ""
 }
 
+func (m *Broker) GetAuthSecret() *SecretReference {
+	if m != nil {
+		return m.AuthSecret
+	}
+	return nil
+}
+
+func (m *Broker) GetEgressConfig() *EgressConfig {
+	if m != nil {
+		return m.EgressConfig
+	}
+	return nil
+}
+
+type SecretReference struct {
+	// secret namespace.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// secret name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// version of the secret data, it changes when credentials are rotated.
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretReference) Reset()         { *m = SecretReference{} }
+func (m *SecretReference) String() string { return proto.CompactTextString(m) }
+func (*SecretReference) ProtoMessage()    {}
+func (*SecretReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd32e421bcc2dd3, []int{2}
+}
+
+func (m *SecretReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SecretReference.Unmarshal(m, b)
+}
+func (m *SecretReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SecretReference.Marshal(b, m, deterministic)
+}
+func (m *SecretReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretReference.Merge(m, src)
+}
+func (m *SecretReference) XXX_Size() int {
+	return xxx_messageInfo_SecretReference.Size(m)
+}
+func (m *SecretReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretReference proto.InternalMessageInfo
+
+func (m *SecretReference) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SecretReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SecretReference) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 type Brokers struct {
 	Brokers []*Broker `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
 	// Count each config map update.
@@ -181,7 +402,7 @@ func (m *Brokers) Reset()         { *m = Brokers{} }
 func (m *Brokers) String() string { return proto.CompactTextString(m) }
 func (*Brokers) ProtoMessage()    {}
 func (*Brokers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cd32e421bcc2dd3, []int{2}
+	return fileDescriptor_3cd32e421bcc2dd3, []int{3}
 }
 
 func (m *Brokers) XXX_Unmarshal(b []byte) error {
@@ -216,38 +437,194 @@ func (m *Brokers) GetVolumeGeneration() uint64 {
 	return 0
 }
 
+type EgressConfig struct {
+	// number of retries before moving the event to the dead letter sink, 0 means no retries.
+	Retry uint32 `protobuf:"varint,1,opt,name=retry,proto3" json:"retry,omitempty"`
+	// retry backoff policy.
+	BackoffPolicy BackoffPolicy `protobuf:"varint,2,opt,name=backoffPolicy,proto3,enum=BackoffPolicy" json:"backoffPolicy,omitempty"`
+	// delay before retrying in milliseconds.
+	BackoffDelay uint64 `protobuf:"varint,3,opt,name=backoffDelay,proto3" json:"backoffDelay,omitempty"`
+	// timeout of each request sent to the destination in milliseconds, 0 means no timeout.
+	Timeout              uint64   `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EgressConfig) Reset()         { *m = EgressConfig{} }
+func (m *EgressConfig) String() string { return proto.CompactTextString(m) }
+func (*EgressConfig) ProtoMessage()    {}
+func (*EgressConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd32e421bcc2dd3, []int{4}
+}
+
+func (m *EgressConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EgressConfig.Unmarshal(m, b)
+}
+func (m *EgressConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EgressConfig.Marshal(b, m, deterministic)
+}
+func (m *EgressConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressConfig.Merge(m, src)
+}
+func (m *EgressConfig) XXX_Size() int {
+	return xxx_messageInfo_EgressConfig.Size(m)
+}
+func (m *EgressConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressConfig proto.InternalMessageInfo
+
+func (m *EgressConfig) GetRetry() uint32 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+func (m *EgressConfig) GetBackoffPolicy() BackoffPolicy {
+	if m != nil {
+		return m.BackoffPolicy
+	}
+	return BackoffPolicy_Exponential
+}
+
+func (m *EgressConfig) GetBackoffDelay() uint64 {
+	if m != nil {
+		return m.BackoffDelay
+	}
+	return 0
+}
+
+func (m *EgressConfig) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type Filter struct {
+	// attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// dialect of the filter.
+	Dialect FilterDialect `protobuf:"varint,2,opt,name=dialect,proto3,enum=FilterDialect" json:"dialect,omitempty"`
+	// nested filters, used by the All, Any and Not dialects.
+	// The Not dialect has exactly one nested filter.
+	Filters              []*Filter `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Filter) Reset()         { *m = Filter{} }
+func (m *Filter) String() string { return proto.CompactTextString(m) }
+func (*Filter) ProtoMessage()    {}
+func (*Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd32e421bcc2dd3, []int{5}
+}
+
+func (m *Filter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Filter.Unmarshal(m, b)
+}
+func (m *Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Filter.Marshal(b, m, deterministic)
+}
+func (m *Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Filter.Merge(m, src)
+}
+func (m *Filter) XXX_Size() int {
+	return xxx_messageInfo_Filter.Size(m)
+}
+func (m *Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Filter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Filter proto.InternalMessageInfo
+
+func (m *Filter) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Filter) GetDialect() FilterDialect {
+	if m != nil {
+		return m.Dialect
+	}
+	return FilterDialect_Exact
+}
+
+func (m *Filter) GetFilters() []*Filter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("BackoffPolicy", BackoffPolicy_name, BackoffPolicy_value)
+	proto.RegisterEnum("DeliveryOrder", DeliveryOrder_name, DeliveryOrder_value)
+	proto.RegisterEnum("FilterDialect", FilterDialect_name, FilterDialect_value)
 	proto.RegisterType((*Trigger)(nil), "Trigger")
 	proto.RegisterMapType((map[string]string)(nil), "Trigger.AttributesEntry")
 	proto.RegisterType((*Broker)(nil), "Broker")
+	proto.RegisterType((*SecretReference)(nil), "SecretReference")
 	proto.RegisterType((*Brokers)(nil), "Brokers")
+	proto.RegisterType((*EgressConfig)(nil), "EgressConfig")
+	proto.RegisterType((*Filter)(nil), "Filter")
+	proto.RegisterMapType((map[string]string)(nil), "Filter.AttributesEntry")
 }
 
 func init() { proto.RegisterFile("proto/def/triggers.proto", fileDescriptor_3cd32e421bcc2dd3) }
 
 var fileDescriptor_3cd32e421bcc2dd3 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x6b, 0xdb, 0x40,
-	0x10, 0xc5, 0x91, 0xfc, 0x47, 0xee, 0x98, 0xba, 0x66, 0xe9, 0x61, 0x29, 0x14, 0x5c, 0x53, 0x8a,
-	0x29, 0x78, 0x05, 0xed, 0xc5, 0x14, 0x7a, 0xa8, 0x4b, 0xe9, 0x25, 0x27, 0x39, 0x87, 0x10, 0xc8,
-	0x61, 0x25, 0x8d, 0x95, 0x45, 0xca, 0xae, 0x58, 0x8d, 0x05, 0xfe, 0x5e, 0x39, 0xe6, 0xc3, 0x05,
-	0xad, 0x24, 0xc7, 0xd8, 0xb7, 0x99, 0xf7, 0xde, 0xfe, 0x18, 0x1e, 0x0b, 0xbc, 0xb4, 0x86, 0x4c,
-	0x98, 0xe2, 0x3e, 0x24, 0xab, 0xb2, 0x0c, 0x6d, 0x25, 0x9c, 0xb4, 0x7c, 0xf6, 0x20, 0xb8, 0x6d,
-	0x25, 0xb6, 0x01, 0x90, 0x44, 0x56, 0xc5, 0x07, 0xc2, 0x8a, 0x7b, 0x8b, 0xc1, 0x6a, 0xfa, 0x83,
-	0x8b, 0xce, 0x15, 0x7f, 0x4e, 0xd6, 0x3f, 0x4d, 0xf6, 0x18, 0x9d, 0x65, 0xd9, 0x02, 0xa6, 0x29,
-	0x56, 0xa4, 0xb4, 0x24, 0x65, 0x34, 0xf7, 0x17, 0xde, 0xea, 0x5d, 0x74, 0x2e, 0xb1, 0x19, 0xf8,
-	0x2a, 0xe5, 0x03, 0x67, 0xf8, 0x2a, 0xfd, 0xf4, 0x1b, 0x3e, 0x5c, 0x00, 0xd9, 0x1c, 0x06, 0x39,
-	0x1e, 0xb9, 0xe7, 0x32, 0xcd, 0xc8, 0x3e, 0xc2, 0xa8, 0x96, 0xc5, 0x01, 0x3b, 0x60, 0xbb, 0xfc,
-	0xf2, 0x37, 0xde, 0xf2, 0xc5, 0x83, 0xf1, 0xd6, 0x9a, 0x1c, 0x6d, 0x47, 0xf6, 0x7a, 0x72, 0xf3,
-	0x88, 0x4c, 0xa9, 0x92, 0xfe, 0x91, 0x5b, 0xd8, 0x37, 0x98, 0xa5, 0x28, 0xd3, 0x1b, 0x24, 0x42,
-	0xbb, 0x53, 0x3a, 0xef, 0x6e, 0xb9, 0x50, 0xd9, 0x57, 0x98, 0xf4, 0x0d, 0xf1, 0xa1, 0x6b, 0x60,
-	0xd2, 0x37, 0x10, 0x9d, 0x1c, 0xc6, 0x60, 0x58, 0x4a, 0x7a, 0xe4, 0x23, 0xc7, 0x70, 0x33, 0xfb,
-	0x0e, 0xf3, 0xd8, 0x18, 0xaa, 0xc8, 0xca, 0x72, 0x87, 0xb6, 0x6e, 0x08, 0x63, 0xe7, 0x5f, 0xe9,
-	0xcb, 0x3b, 0x08, 0xda, 0xeb, 0x2b, 0xf6, 0x05, 0x82, 0xb8, 0x1d, 0xbb, 0xc6, 0x03, 0xd1, 0x5a,
-	0x51, 0xaf, 0x37, 0xe4, 0xda, 0x14, 0x87, 0x27, 0xfc, 0x8f, 0x1a, 0xed, 0x5b, 0xc5, 0xc3, 0xe8,
-	0x4a, 0xdf, 0x3e, 0xc0, 0x3a, 0xc5, 0x5a, 0xe4, 0x4d, 0xed, 0x35, 0x0a, 0xac, 0x51, 0x93, 0xd2,
-	0x99, 0xc8, 0xe5, 0x3e, 0x97, 0xa2, 0x25, 0x8a, 0xc4, 0x58, 0x14, 0x89, 0xd1, 0x7b, 0x95, 0x6d,
-	0xdf, 0x77, 0x87, 0xfc, 0x75, 0xeb, 0xfd, 0xe7, 0xc4, 0x68, 0xb2, 0xa6, 0x58, 0x97, 0x85, 0xd4,
-	0x18, 0x96, 0x79, 0x16, 0x36, 0xe9, 0xb0, 0x4d, 0xc7, 0x63, 0xf7, 0x6b, 0x7e, 0xbe, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x70, 0x2e, 0x75, 0x08, 0x51, 0x02, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x8e, 0xe3, 0x44,
+	0x10, 0x5d, 0xc7, 0x49, 0x9c, 0xa9, 0x8c, 0x67, 0xac, 0xd6, 0x0a, 0x2c, 0x04, 0x52, 0x88, 0x10,
+	0x8a, 0x02, 0xeb, 0x40, 0x40, 0x62, 0x85, 0xc4, 0x61, 0xc3, 0x04, 0x2e, 0x0b, 0xbb, 0xea, 0x80,
+	0x84, 0x90, 0xf6, 0xd0, 0xb1, 0xcb, 0xd9, 0x96, 0x3d, 0xdd, 0x56, 0xbb, 0x13, 0x4d, 0x6e, 0x7c,
+	0x06, 0x57, 0x3e, 0x88, 0x7f, 0x42, 0xdd, 0x6d, 0x67, 0x9d, 0x99, 0x11, 0x9c, 0xb8, 0xd5, 0x7b,
+	0x55, 0x6e, 0x55, 0xbd, 0x7a, 0x65, 0x88, 0x2b, 0x25, 0xb5, 0x5c, 0x64, 0x98, 0x2f, 0xb4, 0xe2,
+	0xbb, 0x1d, 0xaa, 0x3a, 0xb1, 0xd4, 0xf4, 0x0f, 0x1f, 0x82, 0x5f, 0x1c, 0x45, 0x9e, 0x03, 0x30,
+	0xad, 0x15, 0xdf, 0xee, 0x35, 0xd6, 0xb1, 0x37, 0xf1, 0x67, 0xe3, 0x65, 0x9c, 0x34, 0xd9, 0xe4,
+	0xc5, 0x29, 0xb5, 0x16, 0x5a, 0x1d, 0x69, 0xa7, 0x96, 0x4c, 0x60, 0x9c, 0x61, 0xad, 0xb9, 0x60,
+	0x9a, 0x4b, 0x11, 0xf7, 0x26, 0xde, 0xec, 0x82, 0x76, 0x29, 0x72, 0x05, 0x3d, 0x9e, 0xc5, 0xbe,
+	0x4d, 0xf4, 0x78, 0x46, 0xde, 0x83, 0x61, 0xc5, 0xf6, 0x35, 0x66, 0x71, 0x7f, 0xe2, 0xcd, 0x46,
+	0xb4, 0x41, 0xe4, 0x53, 0xb8, 0xca, 0x90, 0x65, 0x2f, 0x51, 0x6b, 0x54, 0x1b, 0x2e, 0x8a, 0x78,
+	0x60, 0xbf, 0xb9, 0xc7, 0x92, 0x2f, 0xe1, 0x12, 0x77, 0x0a, 0xeb, 0xfa, 0x7b, 0x29, 0x72, 0xbe,
+	0x8b, 0x87, 0x13, 0x6f, 0x36, 0x5e, 0x86, 0xc9, 0xba, 0x43, 0xd2, 0xb3, 0x12, 0xf2, 0x35, 0x84,
+	0x19, 0x96, 0xfc, 0x80, 0xea, 0xf8, 0x4a, 0x65, 0xa8, 0xe2, 0x60, 0xe2, 0xcd, 0xae, 0x96, 0x57,
+	0xc9, 0x4d, 0x97, 0xa5, 0xe7, 0x45, 0xe4, 0x63, 0x08, 0x72, 0x5e, 0x6a, 0x54, 0x75, 0x3c, 0xb2,
+	0x8a, 0x04, 0xc9, 0x0f, 0x16, 0xd3, 0x96, 0xff, 0xe0, 0x3b, 0xb8, 0xbe, 0x27, 0x0e, 0x89, 0xc0,
+	0x2f, 0xf0, 0x18, 0x7b, 0xb6, 0x77, 0x13, 0x92, 0xa7, 0x30, 0x38, 0xb0, 0x72, 0x8f, 0x8d, 0x38,
+	0x0e, 0x7c, 0xdb, 0x7b, 0xee, 0x4d, 0xff, 0xea, 0xc1, 0x70, 0xa5, 0x64, 0x81, 0xaa, 0x51, 0xc9,
+	0x3b, 0xa9, 0xf4, 0x14, 0x06, 0x5a, 0x56, 0x3c, 0x6d, 0x3f, 0xb2, 0xe0, 0x11, 0x8d, 0xfc, 0x47,
+	0x35, 0xfa, 0x04, 0x46, 0xed, 0xb6, 0xe3, 0xbe, 0xed, 0x7d, 0xd4, 0x6e, 0x93, 0x9e, 0x32, 0x84,
+	0x40, 0xbf, 0x62, 0xfa, 0x6d, 0xa3, 0xb3, 0x8d, 0xc9, 0x1c, 0xa2, 0xad, 0x94, 0xba, 0xd6, 0x8a,
+	0x55, 0x1b, 0x54, 0x07, 0xf3, 0xc2, 0xd0, 0xe6, 0x1f, 0xf0, 0xe4, 0x0b, 0x00, 0xb6, 0xd7, 0x6f,
+	0x37, 0x98, 0x2a, 0xd4, 0x56, 0xd3, 0xf1, 0x32, 0x4a, 0x1c, 0xa4, 0x98, 0xa3, 0x42, 0x91, 0x22,
+	0xed, 0xd4, 0x3c, 0xd8, 0xdd, 0xe8, 0x3f, 0x77, 0x37, 0x7d, 0x03, 0xd7, 0xf7, 0x5e, 0x24, 0x1f,
+	0xc2, 0x85, 0x60, 0xb7, 0x58, 0x57, 0x2c, 0xc5, 0x46, 0xb2, 0x77, 0x84, 0x99, 0xca, 0x80, 0x46,
+	0x38, 0x1b, 0x93, 0x18, 0x02, 0xd3, 0xb1, 0x71, 0xa8, 0x13, 0xac, 0x85, 0xd3, 0xdf, 0x20, 0x70,
+	0x1b, 0xa8, 0xcd, 0xbe, 0xb7, 0x2e, 0x6c, 0x2e, 0x20, 0x48, 0x5c, 0x8a, 0xb6, 0xbc, 0x51, 0xe7,
+	0x20, 0xcb, 0xfd, 0x2d, 0xfe, 0x88, 0x02, 0xd5, 0x3b, 0xcb, 0xf7, 0xe9, 0x03, 0x7e, 0xfa, 0xa7,
+	0x07, 0x97, 0xdd, 0xb9, 0xcc, 0x4a, 0x15, 0x6a, 0xe5, 0xbc, 0x11, 0x52, 0x07, 0x8c, 0x37, 0xb7,
+	0x2c, 0x2d, 0x64, 0x9e, 0xbf, 0x96, 0x25, 0x4f, 0x8f, 0x71, 0xaf, 0xf1, 0xe6, 0xaa, 0xcb, 0xd2,
+	0xf3, 0x22, 0x32, 0x85, 0xcb, 0x86, 0xb8, 0xc1, 0x92, 0x1d, 0xed, 0x54, 0x7d, 0x7a, 0xc6, 0x99,
+	0xa1, 0x35, 0xbf, 0x45, 0xb9, 0xd7, 0xf6, 0xd2, 0xfa, 0xb4, 0x85, 0xd3, 0xbf, 0x3d, 0x18, 0x3a,
+	0x2b, 0x93, 0x6f, 0x1e, 0xb9, 0xfc, 0xf7, 0x1b, 0x9f, 0xff, 0xeb, 0xe1, 0xcf, 0x20, 0xc8, 0x38,
+	0x2b, 0x31, 0xd5, 0xa7, 0x8e, 0xdd, 0x57, 0x37, 0x8e, 0xa5, 0x6d, 0xba, 0x7b, 0x47, 0xfe, 0xff,
+	0x72, 0x47, 0xf3, 0xcf, 0x21, 0x3c, 0x53, 0x8b, 0x5c, 0xc3, 0x78, 0x7d, 0x57, 0x49, 0x81, 0x42,
+	0x73, 0x56, 0x46, 0x4f, 0x08, 0xc0, 0xf0, 0x25, 0x17, 0xc8, 0x54, 0xe4, 0xcd, 0x3f, 0x83, 0xf0,
+	0xec, 0xee, 0x49, 0x08, 0x17, 0xbf, 0x0a, 0x69, 0x42, 0xcc, 0xa2, 0x27, 0x64, 0x0c, 0xc1, 0xab,
+	0x06, 0x78, 0xf3, 0x9f, 0x20, 0x3c, 0x1b, 0x8b, 0x5c, 0xc0, 0x60, 0x7d, 0xc7, 0x52, 0xed, 0x1e,
+	0x7d, 0xad, 0x30, 0xe7, 0x77, 0x91, 0x67, 0xe2, 0xcd, 0x3e, 0x37, 0x71, 0x8f, 0x04, 0xe0, 0xbf,
+	0x28, 0xcb, 0xc8, 0xb7, 0x81, 0x38, 0x46, 0x7d, 0x13, 0xfc, 0x2c, 0x75, 0x34, 0x58, 0xbd, 0x81,
+	0x67, 0x19, 0x1e, 0x92, 0xc2, 0xfc, 0x1b, 0x0f, 0x98, 0xe0, 0xc1, 0x74, 0x28, 0x76, 0x49, 0xc1,
+	0xf2, 0x82, 0x25, 0xce, 0x66, 0x49, 0x2a, 0x15, 0x26, 0xa9, 0x35, 0xcd, 0x2a, 0x6c, 0xdc, 0xe9,
+	0x3c, 0xf4, 0xfb, 0x47, 0xa9, 0x14, 0x5a, 0xc9, 0xf2, 0x59, 0x55, 0x32, 0x81, 0x8b, 0xaa, 0xd8,
+	0x2d, 0x4c, 0xf5, 0xc2, 0x55, 0x6f, 0x87, 0xf6, 0xd7, 0xfe, 0xd5, 0x3f, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0xb9, 0x76, 0x86, 0x42, 0xf6, 0x05, 0x00, 0x00,
 }
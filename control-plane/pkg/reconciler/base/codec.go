@@ -0,0 +1,135 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/proto"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+const (
+	// CodecGzip identifies gzip compressed data plane config.
+	CodecGzip byte = 1
+)
+
+// compressedHeader prefixes compressed data plane config, it's followed by a byte identifying the codec and by the
+// compressed protobuf encoded data plane config.
+// The first byte is 0, which can't be the first byte of JSON or protobuf encoded data, so readers can recognize
+// compressed data regardless of the configured format.
+var compressedHeader = []byte{0x00, 'k', 'b'}
+
+// ErrUnknownCodec is returned when compressed data plane config uses an unknown codec.
+var ErrUnknownCodec = errors.New("unknown data plane config codec")
+
+// MarshalDataPlaneConfig encodes the given brokers and triggers using the given format.
+func MarshalDataPlaneConfig(brokersTriggers *coreconfig.Brokers, format string) ([]byte, error) {
+	switch format {
+	case Json:
+		return json.Marshal(brokersTriggers)
+	case Protobuf:
+		return proto.Marshal(brokersTriggers)
+	case ProtobufGzip:
+		data, err := proto.Marshal(brokersTriggers)
+		if err != nil {
+			return nil, err
+		}
+		return compress(data, CodecGzip)
+	}
+	return nil, nil
+}
+
+// UnmarshalDataPlaneConfig decodes the given data into brokersTriggers.
+//
+// The encoding is recognized from the data itself, so that switching format doesn't break reading data written with
+// the previous one: compressed data starts with its header, JSON data with '{', and anything else is protobuf.
+func UnmarshalDataPlaneConfig(data []byte, brokersTriggers *coreconfig.Brokers) error {
+	if IsCompressed(data) {
+		decompressed, err := decompress(data)
+		if err != nil {
+			return err
+		}
+		return proto.Unmarshal(decompressed, brokersTriggers)
+	}
+
+	if IsJSON(data) {
+		return json.Unmarshal(data, brokersTriggers)
+	}
+	return proto.Unmarshal(data, brokersTriggers)
+}
+
+// IsCompressed returns true when the given data plane config is compressed.
+func IsCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, compressedHeader) && len(data) > len(compressedHeader)
+}
+
+// IsJSON returns true when the given data plane config is JSON encoded.
+// A protobuf encoded data plane config can't start with '{', since it would be a group start of field 15.
+// Leading white spaces aren't skipped, since '\n' is the tag of the first field of a protobuf encoded data plane config.
+func IsJSON(data []byte) bool {
+	return bytes.HasPrefix(data, []byte{'{'})
+}
+
+func compress(data []byte, codec byte) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)/4))
+	buf.Write(compressedHeader)
+	buf.WriteByte(codec)
+
+	switch codec {
+	case CodecGzip:
+		w := gzip.NewWriter(buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to compress data plane config: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("failed to compress data plane config: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownCodec, codec)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	codec := data[len(compressedHeader)]
+	compressed := data[len(compressedHeader)+1:]
+
+	switch codec {
+	case CodecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress data plane config: %w", err)
+		}
+		defer r.Close()
+
+		decompressed, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress data plane config: %w", err)
+		}
+		return decompressed, nil
+	}
+
+	return nil, fmt.Errorf("%w: %d", ErrUnknownCodec, codec)
+}
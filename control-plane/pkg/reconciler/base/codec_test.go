@@ -0,0 +1,181 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+func TestDataPlaneConfigRoundTrip(t *testing.T) {
+
+	brokers := &coreconfig.Brokers{
+		Brokers: []*coreconfig.Broker{
+			{
+				Id:               "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+				Topic:            "topic-1",
+				DeadLetterSink:   "http://localhost:8080",
+				Path:             "/ns/name",
+				BootstrapServers: "kafka-1:9092",
+				Triggers: []*coreconfig.Trigger{
+					{
+						Attributes:  map[string]string{"type": "dev.knative"},
+						Destination: "http://localhost:8081",
+						Id:          "b8a3c0c5-5e6b-4b7b-9a8b-3c2b4f5e6d7c",
+					},
+				},
+			},
+		},
+		VolumeGeneration: 42,
+	}
+
+	for _, format := range []string{Json, Protobuf, ProtobufGzip} {
+		t.Run(format, func(t *testing.T) {
+
+			client := fake.NewSimpleClientset()
+			r := &Reconciler{
+				KubeClient:                  client,
+				DataPlaneConfigMapNamespace: "knative-eventing",
+				DataPlaneConfigMapName:      "kafka-broker-brokers-triggers",
+				DataPlaneConfigFormat:       format,
+			}
+
+			cm, err := r.GetOrCreateDataPlaneConfigMap(0)
+			assert.Nil(t, err)
+
+			err = r.UpdateDataPlaneConfigMap(brokers, cm)
+			assert.Nil(t, err)
+
+			cm, err = client.CoreV1().ConfigMaps(cm.Namespace).Get(cm.Name, metav1.GetOptions{})
+			assert.Nil(t, err)
+
+			assert.Equal(t, format == ProtobufGzip, IsCompressed(cm.BinaryData[ConfigMapDataKey]))
+
+			got, err := r.GetDataPlaneConfigMapData(zap.NewNop(), cm)
+			assert.Nil(t, err)
+			assert.True(t, proto.Equal(brokers, got), "want %v got %v", brokers, got)
+		})
+	}
+}
+
+func TestDataPlaneConfigCompressed(t *testing.T) {
+
+	brokers := &coreconfig.Brokers{VolumeGeneration: 1}
+	for i := 0; i < 100; i++ {
+		brokers.Brokers = append(brokers.Brokers, &coreconfig.Broker{
+			Id:               fmt.Sprintf("broker-%d", i),
+			Topic:            fmt.Sprintf("knative-broker-namespace-broker-%d", i),
+			BootstrapServers: "kafka-1:9092,kafka-2:9092",
+			Path:             fmt.Sprintf("/namespace/broker-%d", i),
+		})
+	}
+
+	raw, err := MarshalDataPlaneConfig(brokers, Protobuf)
+	assert.Nil(t, err)
+
+	compressed, err := MarshalDataPlaneConfig(brokers, ProtobufGzip)
+	assert.Nil(t, err)
+
+	assert.Less(t, len(compressed), len(raw))
+	assert.Equal(t, []byte{0x00, 'k', 'b', CodecGzip}, compressed[:4])
+
+	// The header identifies compressed data.
+	got := &coreconfig.Brokers{}
+	assert.Nil(t, UnmarshalDataPlaneConfig(compressed, got))
+	assert.True(t, proto.Equal(brokers, got))
+
+	// Data written before switching to the compressed format is still readable.
+	got = &coreconfig.Brokers{}
+	assert.Nil(t, UnmarshalDataPlaneConfig(raw, got))
+	assert.True(t, proto.Equal(brokers, got))
+}
+
+func TestDataPlaneConfigUnknownCodec(t *testing.T) {
+
+	data := append([]byte{0x00, 'k', 'b', 42}, []byte("data")...)
+
+	err := UnmarshalDataPlaneConfig(data, &coreconfig.Brokers{})
+	assert.True(t, errors.Is(err, ErrUnknownCodec), "got %v", err)
+
+	_, err = GetDataPlaneConfigMapData(zap.NewNop(), &corev1.ConfigMap{
+		BinaryData: map[string][]byte{ConfigMapDataKey: data},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestDataPlaneConfigFormatSwitch(t *testing.T) {
+
+	existing := &coreconfig.Broker{
+		Id:               "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+		Topic:            "topic-1",
+		Path:             "/ns/name-1",
+		BootstrapServers: "kafka-1:9092",
+	}
+
+	for _, formats := range [][2]string{
+		{Json, ProtobufGzip},
+		{Json, Protobuf},
+		{Protobuf, Json},
+		{ProtobufGzip, Json},
+	} {
+		t.Run(fmt.Sprintf("%s to %s", formats[0], formats[1]), func(t *testing.T) {
+
+			r, _ := newContractWriterTestReconciler()
+			r.DataPlaneConfigFormat = formats[0]
+
+			cm, err := r.GetOrCreateDataPlaneConfigMap(0)
+			assert.Nil(t, err)
+
+			err = r.UpdateDataPlaneConfigMap(&coreconfig.Brokers{
+				Brokers:          []*coreconfig.Broker{existing},
+				VolumeGeneration: 1,
+			}, cm)
+			assert.Nil(t, err)
+
+			// Brokers written with the previous format are kept when the format changes.
+			r.DataPlaneConfigFormat = formats[1]
+			w := NewContractWriter(r, time.Millisecond)
+
+			_, err = w.Update(zap.NewNop(), 0, ContractUpdate{
+				Mutate: func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error) {
+					if readErr != nil {
+						return false, readErr
+					}
+					brokersTriggers.Brokers = append(brokersTriggers.Brokers, &coreconfig.Broker{Id: "broker-2"})
+					return true, nil
+				},
+			})
+			assert.Nil(t, err)
+
+			brokers := getBrokers(t, r)
+			assert.Len(t, brokers.Brokers, 2)
+			assert.True(t, proto.Equal(existing, brokers.Brokers[0]), "want %v got %v", existing, brokers.Brokers[0])
+			assert.Equal(t, "broker-2", brokers.Brokers[1].Id)
+		})
+	}
+}
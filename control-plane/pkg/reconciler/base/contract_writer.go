@@ -0,0 +1,316 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/client-go/util/retry"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/log"
+)
+
+const (
+	// DefaultContractWriteWindow is the time the shared ContractWriter waits for other updates before committing a
+	// batch of updates.
+	DefaultContractWriteWindow = 100 * time.Millisecond
+)
+
+// Mutation mutates the data plane config of a shard.
+//
+// It's called with the data plane config read from the config map and with the error of reading it, if any, in which
+// case the data plane config is nil and it's never written back.
+// It returns whether it changed the data plane config, and it must not change it when returning an error.
+// A Mutation might be called more than once, when the config map update conflicts with other updates.
+type Mutation func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error)
+
+// ContractUpdate is an update of the data plane config.
+type ContractUpdate struct {
+	Mutate Mutation
+
+	// NotifyReceivers and NotifyDispatchers signal that receiver or dispatcher pods need to be notified of the update.
+	// The volume generation is incremented only when pods are notified.
+	NotifyReceivers   bool
+	NotifyDispatchers bool
+}
+
+// ContractUpdateResult is the result of a ContractUpdate.
+type ContractUpdateResult struct {
+	// VolumeGeneration is the volume generation of the committed data plane config, or of the current one when the
+	// update didn't change it.
+	VolumeGeneration uint64
+
+	// ReceiversErr and DispatchersErr are errors notifying receiver and dispatcher pods.
+	ReceiversErr   error
+	DispatchersErr error
+}
+
+// ContractWriter coalesces updates of the data plane config.
+//
+// Updates of a shard requested during the write window are committed together, with a single config map update, a
+// single volume generation increment and a single notification of data plane pods.
+// ContractWriter is shared by the Broker and the Trigger reconcilers, so that their updates don't conflict.
+type ContractWriter struct {
+	// Reconciler is used to read and update config maps and to notify data plane pods.
+	Reconciler *Reconciler
+
+	// Window is the time to wait for other updates before committing a batch.
+	Window time.Duration
+
+	// Rebuild returns the whole data plane config of the given shard.
+	// When the config map of a shard can't be decoded, its data is backed up to the config map named
+	// DataPlaneConfigMapBackupName, and updates are applied to the rebuilt data plane config. When Rebuild is nil,
+	// updates of the shard fail until the config map is fixed.
+	Rebuild func(logger *zap.Logger, shard int) (*coreconfig.Brokers, error)
+
+	mutex   sync.Mutex
+	pending map[int]*contractBatch
+	// commits serializes commits of the same shard.
+	commits map[int]*sync.Mutex
+}
+
+type contractBatch struct {
+	requests []*contractRequest
+	done     chan struct{}
+}
+
+type contractRequest struct {
+	update  ContractUpdate
+	changed bool
+	err     error
+	result  ContractUpdateResult
+}
+
+// NewContractWriter creates a ContractWriter using the given reconciler and write window.
+func NewContractWriter(r *Reconciler, window time.Duration) *ContractWriter {
+	return &ContractWriter{
+		Reconciler: r,
+		Window:     window,
+	}
+}
+
+// getConfigMapError is a failure to get a data plane config map.
+type getConfigMapError struct {
+	error
+}
+
+func (e getConfigMapError) Unwrap() error {
+	return e.error
+}
+
+// IsGetConfigMapError returns true when the given ContractWriter error is a failure to get a data plane config map.
+func IsGetConfigMapError(err error) bool {
+	var e getConfigMapError
+	return errors.As(err, &e)
+}
+
+// Update applies the given update to the data plane config of the given shard, and it returns when the update is
+// committed.
+//
+// It returns the error returned by the update Mutation, or the error committing the batch containing the update.
+func (w *ContractWriter) Update(logger *zap.Logger, shard int, update ContractUpdate) (ContractUpdateResult, error) {
+
+	request := &contractRequest{update: update}
+
+	w.mutex.Lock()
+	if w.pending == nil {
+		w.pending = make(map[int]*contractBatch)
+		w.commits = make(map[int]*sync.Mutex)
+	}
+	batch, ok := w.pending[shard]
+	if !ok {
+		batch = &contractBatch{done: make(chan struct{})}
+		w.pending[shard] = batch
+	}
+	batch.requests = append(batch.requests, request)
+	w.mutex.Unlock()
+
+	if ok {
+		// Another caller commits the batch.
+		<-batch.done
+		return request.result, request.err
+	}
+
+	if w.Window > 0 {
+		time.Sleep(w.Window)
+	}
+
+	w.mutex.Lock()
+	delete(w.pending, shard)
+	commit, ok := w.commits[shard]
+	if !ok {
+		commit = &sync.Mutex{}
+		w.commits[shard] = commit
+	}
+	w.mutex.Unlock()
+
+	commit.Lock()
+	w.commit(logger, shard, batch.requests)
+	commit.Unlock()
+
+	close(batch.done)
+
+	return request.result, request.err
+}
+
+func (w *ContractWriter) commit(logger *zap.Logger, shard int, requests []*contractRequest) {
+
+	r := w.Reconciler
+	logger = logger.With(zap.Int("shard", shard), zap.Int("updates", len(requests)))
+
+	var brokersTriggers *coreconfig.Brokers
+	changed := false
+	recovered := false
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+
+		configMap, err := r.GetOrCreateDataPlaneConfigMap(shard)
+		if err != nil {
+			return getConfigMapError{err}
+		}
+
+		var readErr error
+		brokersTriggers, readErr = r.GetDataPlaneConfigMapData(logger, configMap)
+
+		recovered = false
+		if IsContractCorruptedError(readErr) && w.Rebuild != nil {
+			brokersTriggers, readErr = w.recover(logger, shard, readErr)
+			recovered = readErr == nil
+		}
+
+		// A recovered data plane config is written even when no request changes it.
+		changed = recovered
+		for _, request := range requests {
+			request.changed, request.err = request.update.Mutate(brokersTriggers, readErr)
+			changed = changed || request.changed
+		}
+
+		if readErr != nil || !changed {
+			// Never write a data plane config that hasn't been read, since it would wipe out every other resource.
+			changed = false
+			return nil
+		}
+
+		if recovered || notifyReceivers(requests) || notifyDispatchers(requests) {
+			brokersTriggers.VolumeGeneration = incrementVolumeGeneration(brokersTriggers.VolumeGeneration)
+		}
+
+		logger.Debug("Update data plane config map",
+			zap.Any(BrokersTriggersDataLogKey, log.BrokersMarshaller{Brokers: brokersTriggers}),
+		)
+
+		// Return the same error, so that we can handle conflicting updates.
+		return r.UpdateDataPlaneConfigMap(brokersTriggers, configMap)
+	})
+	if err != nil {
+		for _, request := range requests {
+			if request.err == nil {
+				request.err = err
+			}
+		}
+		return
+	}
+
+	// brokersTriggers is nil when the data plane config hasn't been read.
+	result := ContractUpdateResult{VolumeGeneration: brokersTriggers.GetVolumeGeneration()}
+
+	if !changed {
+		for _, request := range requests {
+			request.result = result
+		}
+		return
+	}
+
+	// Every data plane pod needs the recovered data plane config.
+	if recovered || notifyReceivers(requests) {
+		result.ReceiversErr = r.UpdateReceiverPodsAnnotation(logger, shard, result.VolumeGeneration)
+	}
+	if recovered || notifyDispatchers(requests) {
+		result.DispatchersErr = r.UpdateDispatcherPodsAnnotation(logger, shard, result.VolumeGeneration)
+	}
+
+	for _, request := range requests {
+		request.result = result
+	}
+}
+
+// recover backs up the undecodable data of the config map of the given shard and rebuilds its data plane config.
+//
+// The rebuilt data plane config has the highest volume generation known by data plane pods, so that the incremented
+// volume generation of the commit is new to every pod.
+func (w *ContractWriter) recover(logger *zap.Logger, shard int, readErr error) (*coreconfig.Brokers, error) {
+
+	r := w.Reconciler
+
+	logger.Error("Data plane config map corrupted, rebuilding it", zap.Error(readErr))
+
+	var corrupted contractCorruptedError
+	errors.As(readErr, &corrupted)
+
+	if err := r.backupDataPlaneConfigMap(shard, corrupted.data); err != nil {
+		return nil, fmt.Errorf("%w - %v", readErr, err)
+	}
+
+	brokersTriggers, err := w.Rebuild(logger, shard)
+	if err != nil {
+		return nil, fmt.Errorf("%w - failed to rebuild data plane config: %v", readErr, err)
+	}
+
+	generation, err := r.dataPlanePodsVolumeGeneration(shard)
+	if err != nil {
+		return nil, fmt.Errorf("%w - failed to get volume generation: %v", readErr, err)
+	}
+	brokersTriggers.VolumeGeneration = generation
+
+	logger.Info("Data plane config rebuilt",
+		zap.String("backup", r.DataPlaneConfigMapBackupName(shard)),
+		zap.Int("brokers", len(brokersTriggers.Brokers)),
+		zap.Uint64("volumeGeneration", generation),
+	)
+
+	return brokersTriggers, nil
+}
+
+// notifyReceivers returns true when a request that changed the data plane config needs to notify receiver pods.
+func notifyReceivers(requests []*contractRequest) bool {
+	for _, request := range requests {
+		if request.changed && request.update.NotifyReceivers {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyDispatchers returns true when a request that changed the data plane config needs to notify dispatcher pods.
+func notifyDispatchers(requests []*contractRequest) bool {
+	for _, request := range requests {
+		if request.changed && request.update.NotifyDispatchers {
+			return true
+		}
+	}
+	return false
+}
+
+func incrementVolumeGeneration(generation uint64) uint64 {
+	return (generation + 1) % (math.MaxUint64 - 1)
+}
@@ -0,0 +1,273 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+func newContractWriterTestReconciler() (*Reconciler, *fake.Clientset) {
+	client := fake.NewSimpleClientset()
+
+	return &Reconciler{
+		KubeClient:                  client,
+		PodLister:                   corelisters.NewPodLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		DataPlaneConfigMapNamespace: "knative-eventing",
+		DataPlaneConfigMapName:      "kafka-broker-brokers-triggers",
+		DataPlaneConfigFormat:       Protobuf,
+	}, client
+}
+
+func countConfigMapUpdates(client *fake.Clientset) int {
+	updates := 0
+	for _, action := range client.Actions() {
+		if action.Matches("update", "configmaps") {
+			updates++
+		}
+	}
+	return updates
+}
+
+func getBrokers(t *testing.T, r *Reconciler) *coreconfig.Brokers {
+	cm, err := r.KubeClient.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Get(r.DataPlaneConfigMapName, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	brokers, err := r.GetDataPlaneConfigMapData(zap.NewNop(), cm)
+	assert.Nil(t, err)
+	return brokers
+}
+
+func addBroker(id string) Mutation {
+	return func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error) {
+		brokersTriggers.Brokers = append(brokersTriggers.Brokers, &coreconfig.Broker{Id: id})
+		return true, nil
+	}
+}
+
+func TestContractWriterCoalescesUpdates(t *testing.T) {
+	r, client := newContractWriterTestReconciler()
+	w := NewContractWriter(r, 100*time.Millisecond)
+
+	const updates = 10
+
+	wg := sync.WaitGroup{}
+	wg.Add(updates)
+	results := make([]ContractUpdateResult, updates)
+	for i := 0; i < updates; i++ {
+		go func(i int) {
+			defer wg.Done()
+
+			var err error
+			results[i], err = w.Update(zap.NewNop(), 0, ContractUpdate{
+				Mutate:            addBroker(fmt.Sprintf("broker-%d", i)),
+				NotifyDispatchers: true,
+			})
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	brokers := getBrokers(t, r)
+	assert.Len(t, brokers.Brokers, updates)
+	assert.Equal(t, uint64(1), brokers.VolumeGeneration)
+	assert.Equal(t, 1, countConfigMapUpdates(client))
+	for _, result := range results {
+		assert.Equal(t, uint64(1), result.VolumeGeneration)
+	}
+}
+
+func TestContractWriterMutationError(t *testing.T) {
+	r, _ := newContractWriterTestReconciler()
+	w := NewContractWriter(r, 100*time.Millisecond)
+
+	mutationErr := errors.New("broker not found")
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+
+		_, err := w.Update(zap.NewNop(), 0, ContractUpdate{
+			Mutate: func(*coreconfig.Brokers, error) (bool, error) {
+				return false, mutationErr
+			},
+			NotifyDispatchers: true,
+		})
+		assert.Equal(t, mutationErr, err)
+	}()
+	go func() {
+		defer wg.Done()
+
+		_, err := w.Update(zap.NewNop(), 0, ContractUpdate{
+			Mutate:            addBroker("broker"),
+			NotifyDispatchers: true,
+		})
+		assert.Nil(t, err)
+	}()
+	wg.Wait()
+
+	brokers := getBrokers(t, r)
+	assert.Len(t, brokers.Brokers, 1)
+	assert.Equal(t, uint64(1), brokers.VolumeGeneration)
+}
+
+func TestContractWriterNoChanges(t *testing.T) {
+	r, client := newContractWriterTestReconciler()
+	w := NewContractWriter(r, 0)
+
+	result, err := w.Update(zap.NewNop(), 0, ContractUpdate{
+		Mutate: func(*coreconfig.Brokers, error) (bool, error) {
+			return false, nil
+		},
+		NotifyReceivers:   true,
+		NotifyDispatchers: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), result.VolumeGeneration)
+	assert.Equal(t, 0, countConfigMapUpdates(client))
+}
+
+func TestContractWriterNoNotifications(t *testing.T) {
+	r, client := newContractWriterTestReconciler()
+	w := NewContractWriter(r, 0)
+
+	_, err := w.Update(zap.NewNop(), 0, ContractUpdate{Mutate: addBroker("broker")})
+	assert.Nil(t, err)
+
+	brokers := getBrokers(t, r)
+	assert.Len(t, brokers.Brokers, 1)
+	assert.Equal(t, uint64(0), brokers.VolumeGeneration)
+	assert.Equal(t, 1, countConfigMapUpdates(client))
+}
+
+func TestContractWriterGetConfigMapError(t *testing.T) {
+	r, client := newContractWriterTestReconciler()
+	w := NewContractWriter(r, 0)
+
+	client.PrependReactor("get", "configmaps", func(clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("failed")
+	})
+
+	_, err := w.Update(zap.NewNop(), 0, ContractUpdate{Mutate: addBroker("broker")})
+	assert.NotNil(t, err)
+	assert.True(t, IsGetConfigMapError(err))
+}
+
+func newCorruptedConfigMap(r *Reconciler) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.DataPlaneConfigMapNamespace,
+			Name:      r.DataPlaneConfigMapName,
+		},
+		BinaryData: map[string][]byte{
+			ConfigMapDataKey: []byte(`{"hello"-- "world"}`),
+		},
+	}
+}
+
+func TestContractWriterCorruptedConfigMap(t *testing.T) {
+	r, client := newContractWriterTestReconciler()
+	w := NewContractWriter(r, 0)
+
+	_, err := client.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Create(newCorruptedConfigMap(r))
+	assert.Nil(t, err)
+
+	// A Mutation ignoring the read error must not wipe out the data plane config.
+	_, err = w.Update(zap.NewNop(), 0, ContractUpdate{
+		Mutate: func(_ *coreconfig.Brokers, readErr error) (bool, error) {
+			assert.True(t, IsContractCorruptedError(readErr))
+			return true, nil
+		},
+		NotifyReceivers: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, countConfigMapUpdates(client))
+}
+
+func TestContractWriterRebuildCorruptedConfigMap(t *testing.T) {
+	r, client := newContractWriterTestReconciler()
+	r.SystemNamespace = "knative-eventing"
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	assert.Nil(t, indexer.Add(newDataPlanePod("receiver", corev1.PodRunning, map[string]string{
+		VolumeGenerationAnnotationKey:    "7",
+		VolumeGenerationAckAnnotationKey: "6",
+	})))
+	r.PodLister = corelisters.NewPodLister(indexer)
+
+	w := NewContractWriter(r, 0)
+	w.Rebuild = func(_ *zap.Logger, shard int) (*coreconfig.Brokers, error) {
+		assert.Equal(t, 0, shard)
+		return &coreconfig.Brokers{
+			Brokers: []*coreconfig.Broker{{Id: "rebuilt"}},
+		}, nil
+	}
+
+	corrupted := newCorruptedConfigMap(r)
+	_, err := client.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Create(corrupted)
+	assert.Nil(t, err)
+
+	result, err := w.Update(zap.NewNop(), 0, ContractUpdate{Mutate: addBroker("broker")})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(8), result.VolumeGeneration)
+
+	brokers := getBrokers(t, r)
+	assert.Len(t, brokers.Brokers, 2)
+	assert.Equal(t, "rebuilt", brokers.Brokers[0].Id)
+	assert.Equal(t, "broker", brokers.Brokers[1].Id)
+	assert.Equal(t, uint64(8), brokers.VolumeGeneration)
+
+	backup, err := client.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Get(r.DataPlaneConfigMapBackupName(0), metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, corrupted.BinaryData, backup.BinaryData)
+}
+
+func TestContractWriterRebuildError(t *testing.T) {
+	r, client := newContractWriterTestReconciler()
+	w := NewContractWriter(r, 0)
+	w.Rebuild = func(*zap.Logger, int) (*coreconfig.Brokers, error) {
+		return nil, errors.New("failed")
+	}
+
+	_, err := client.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Create(newCorruptedConfigMap(r))
+	assert.Nil(t, err)
+
+	_, err = w.Update(zap.NewNop(), 0, ContractUpdate{
+		Mutate: func(_ *coreconfig.Brokers, readErr error) (bool, error) {
+			return false, readErr
+		},
+	})
+	assert.NotNil(t, err)
+	assert.True(t, IsContractCorruptedError(err))
+	assert.Equal(t, 0, countConfigMapUpdates(client))
+}
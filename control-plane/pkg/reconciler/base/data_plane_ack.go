@@ -0,0 +1,146 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+)
+
+// DataPlanePodsAcked returns the number of data plane pods with the given label that need to apply the given volume
+// generation of the given shard, and the number of them that applied it.
+//
+// Pods that aren't running, or that are terminating, aren't considered, since pods read the whole data plane config
+// when they start. When there are no pods to consider (total is 0), resources aren't ready, since no pod applied them.
+func (r *Reconciler) DataPlanePodsAcked(label string, shard int, volumeGeneration uint64) (acked int, total int, err error) {
+
+	labelSelector := labels.SelectorFromSet(map[string]string{"app": label})
+	pods, err := r.PodLister.Pods(r.SystemNamespace).List(labelSelector)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list %s pods in namespace %s: %w", label, r.SystemNamespace, err)
+	}
+
+	annotationKey := ShardName(VolumeGenerationAckAnnotationKey, shard)
+
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodRunning || !pod.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		total++
+
+		ack, err := strconv.ParseUint(pod.GetAnnotations()[annotationKey], 10, 64)
+		if err == nil && ack >= volumeGeneration {
+			acked++
+		}
+	}
+
+	return acked, total, nil
+}
+
+// dataPlanePodsVolumeGeneration returns the highest volume generation of the given shard that data plane pods have
+// been notified of, or have applied.
+func (r *Reconciler) dataPlanePodsVolumeGeneration(shard int) (uint64, error) {
+
+	annotationKeys := []string{
+		ShardName(VolumeGenerationAnnotationKey, shard),
+		ShardName(VolumeGenerationAckAnnotationKey, shard),
+	}
+
+	var generation uint64
+	for _, label := range []string{ReceiverLabel, DispatcherLabel} {
+
+		labelSelector := labels.SelectorFromSet(map[string]string{"app": label})
+		pods, err := r.PodLister.Pods(r.SystemNamespace).List(labelSelector)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list %s pods in namespace %s: %w", label, r.SystemNamespace, err)
+		}
+
+		for _, pod := range pods {
+			for _, key := range annotationKeys {
+				g, err := strconv.ParseUint(pod.GetAnnotations()[key], 10, 64)
+				if err == nil && g > generation {
+					generation = g
+				}
+			}
+		}
+	}
+
+	return generation, nil
+}
+
+// DataPlaneWaiters tracks resources waiting for data plane pods to apply the volume generation carrying them.
+//
+// The zero value is ready to use.
+type DataPlaneWaiters struct {
+	mutex   sync.Mutex
+	waiting map[types.NamespacedName]struct{}
+}
+
+// Wait tracks the given resource as waiting for data plane pods.
+// Resources must be tracked before checking data plane pods, so that pod changes aren't missed.
+func (w *DataPlaneWaiters) Wait(key types.NamespacedName) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.waiting == nil {
+		w.waiting = make(map[types.NamespacedName]struct{})
+	}
+	w.waiting[key] = struct{}{}
+}
+
+// Done stops tracking the given resource.
+func (w *DataPlaneWaiters) Done(key types.NamespacedName) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	delete(w.waiting, key)
+}
+
+// Release enqueues and stops tracking every waiting resource, resources that are still waiting are tracked again when
+// they're reconciled.
+func (w *DataPlaneWaiters) Release(enqueue func(key types.NamespacedName)) {
+	w.mutex.Lock()
+	waiting := w.waiting
+	w.waiting = nil
+	w.mutex.Unlock()
+
+	for key := range waiting {
+		enqueue(key)
+	}
+}
+
+// DataPlanePodsHandler returns a handler of data plane pods with the given label, that releases resources waiting for
+// data plane pods when pods change.
+func (r *Reconciler) DataPlanePodsHandler(label string, enqueue func(key types.NamespacedName)) cache.ResourceEventHandler {
+	return cache.FilteringResourceEventHandler{
+		FilterFunc: reconciler.ChainFilterFuncs(
+			reconciler.NamespaceFilterFunc(r.SystemNamespace),
+			reconciler.LabelFilterFunc("app", label, false),
+		),
+		Handler: controller.HandleAll(func(interface{}) {
+			r.Waiting.Release(enqueue)
+		}),
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newDataPlanePod(name string, phase corev1.PodPhase, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "knative-eventing",
+			Labels:      map[string]string{"app": ReceiverLabel},
+			Annotations: annotations,
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestDataPlanePodsAcked(t *testing.T) {
+
+	deleted := newDataPlanePod("deleted", corev1.PodRunning, nil)
+	now := metav1.Now()
+	deleted.DeletionTimestamp = &now
+
+	pods := []*corev1.Pod{
+		newDataPlanePod("acked", corev1.PodRunning, map[string]string{
+			VolumeGenerationAckAnnotationKey:        "3",
+			VolumeGenerationAckAnnotationKey + "-1": "1",
+		}),
+		newDataPlanePod("ahead", corev1.PodRunning, map[string]string{
+			VolumeGenerationAckAnnotationKey:        "4",
+			VolumeGenerationAckAnnotationKey + "-1": "2",
+		}),
+		newDataPlanePod("behind", corev1.PodRunning, map[string]string{
+			VolumeGenerationAckAnnotationKey: "2",
+		}),
+		newDataPlanePod("invalid", corev1.PodRunning, map[string]string{
+			VolumeGenerationAckAnnotationKey: "invalid",
+		}),
+		newDataPlanePod("pending", corev1.PodPending, nil),
+		deleted,
+	}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, pod := range pods {
+		assert.Nil(t, indexer.Add(pod))
+	}
+
+	r := &Reconciler{
+		PodLister:       corelisters.NewPodLister(indexer),
+		SystemNamespace: "knative-eventing",
+	}
+
+	acked, total, err := r.DataPlanePodsAcked(ReceiverLabel, 0, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, acked)
+	assert.Equal(t, 4, total)
+
+	acked, total, err = r.DataPlanePodsAcked(ReceiverLabel, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, acked)
+	assert.Equal(t, 4, total)
+
+	acked, total, err = r.DataPlanePodsAcked(DispatcherLabel, 0, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, acked)
+	assert.Equal(t, 0, total)
+}
+
+func TestDataPlaneWaiters(t *testing.T) {
+	waiters := DataPlaneWaiters{}
+
+	a := types.NamespacedName{Namespace: "ns", Name: "a"}
+	b := types.NamespacedName{Namespace: "ns", Name: "b"}
+
+	waiters.Wait(a)
+	waiters.Wait(b)
+	waiters.Done(b)
+
+	var enqueued []types.NamespacedName
+	enqueue := func(key types.NamespacedName) {
+		enqueued = append(enqueued, key)
+	}
+
+	waiters.Release(enqueue)
+	assert.Equal(t, []types.NamespacedName{a}, enqueued)
+
+	// Released resources aren't waiting anymore, until they're reconciled.
+	enqueued = nil
+	waiters.Release(enqueue)
+	assert.Empty(t, enqueued)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rickb777/date/period"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+// EgressConfig returns the egress config of the data plane config equivalent to the given delivery spec, nil when
+// the delivery spec doesn't ask for retries.
+//
+// Delivery fields the data plane can't honor are rejected, rather than silently ignored.
+func EgressConfig(delivery *eventingduck.DeliverySpec) (*coreconfig.EgressConfig, error) {
+
+	if delivery == nil {
+		return nil, nil
+	}
+
+	if delivery.Retry == nil {
+		if delivery.BackoffPolicy != nil || delivery.BackoffDelay != nil {
+			return nil, fmt.Errorf("unsupported delivery spec: backoffPolicy and backoffDelay require retry")
+		}
+		return nil, nil
+	}
+
+	if *delivery.Retry < 0 {
+		return nil, fmt.Errorf("unsupported delivery spec: retry must be non-negative - got %d", *delivery.Retry)
+	}
+
+	egressConfig := &coreconfig.EgressConfig{
+		Retry: uint32(*delivery.Retry),
+	}
+
+	if delivery.BackoffPolicy != nil {
+		switch *delivery.BackoffPolicy {
+		case eventingduck.BackoffPolicyExponential:
+			egressConfig.BackoffPolicy = coreconfig.BackoffPolicy_Exponential
+		case eventingduck.BackoffPolicyLinear:
+			egressConfig.BackoffPolicy = coreconfig.BackoffPolicy_Linear
+		default:
+			return nil, fmt.Errorf(
+				"unsupported delivery spec: unknown backoffPolicy %s - supported policies: %s, %s",
+				*delivery.BackoffPolicy,
+				eventingduck.BackoffPolicyExponential,
+				eventingduck.BackoffPolicyLinear,
+			)
+		}
+	}
+
+	if delivery.BackoffDelay != nil {
+		p, err := period.Parse(*delivery.BackoffDelay)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported delivery spec: failed to parse backoffDelay: %w", err)
+		}
+		delay, _ := p.Duration()
+		if delay < 0 {
+			return nil, fmt.Errorf("unsupported delivery spec: backoffDelay must be non-negative - got %s", *delivery.BackoffDelay)
+		}
+		egressConfig.BackoffDelay = uint64(delay / time.Millisecond)
+	}
+
+	return egressConfig, nil
+}
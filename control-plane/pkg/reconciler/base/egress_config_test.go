@@ -0,0 +1,127 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+func TestEgressConfig(t *testing.T) {
+
+	exponential := eventingduck.BackoffPolicyExponential
+	linear := eventingduck.BackoffPolicyLinear
+	unknown := eventingduck.BackoffPolicyType("random")
+
+	tests := []struct {
+		name     string
+		delivery *eventingduck.DeliverySpec
+		want     *coreconfig.EgressConfig
+		wantErr  bool
+	}{
+		{
+			name: "no delivery",
+		},
+		{
+			name:     "no retry",
+			delivery: &eventingduck.DeliverySpec{},
+		},
+		{
+			name:     "retry",
+			delivery: &eventingduck.DeliverySpec{Retry: pointer.Int32Ptr(3)},
+			want:     &coreconfig.EgressConfig{Retry: 3},
+		},
+		{
+			name: "exponential backoff",
+			delivery: &eventingduck.DeliverySpec{
+				Retry:         pointer.Int32Ptr(3),
+				BackoffPolicy: &exponential,
+				BackoffDelay:  pointer.StringPtr("PT0.5S"),
+			},
+			want: &coreconfig.EgressConfig{
+				Retry:         3,
+				BackoffPolicy: coreconfig.BackoffPolicy_Exponential,
+				BackoffDelay:  500,
+			},
+		},
+		{
+			name: "linear backoff",
+			delivery: &eventingduck.DeliverySpec{
+				Retry:         pointer.Int32Ptr(5),
+				BackoffPolicy: &linear,
+				BackoffDelay:  pointer.StringPtr("PT1M"),
+			},
+			want: &coreconfig.EgressConfig{
+				Retry:         5,
+				BackoffPolicy: coreconfig.BackoffPolicy_Linear,
+				BackoffDelay:  60000,
+			},
+		},
+		{
+			name: "backoff without retry",
+			delivery: &eventingduck.DeliverySpec{
+				BackoffPolicy: &linear,
+				BackoffDelay:  pointer.StringPtr("PT1S"),
+			},
+			wantErr: true,
+		},
+		{
+			name:     "negative retry",
+			delivery: &eventingduck.DeliverySpec{Retry: pointer.Int32Ptr(-1)},
+			wantErr:  true,
+		},
+		{
+			name: "unknown backoff policy",
+			delivery: &eventingduck.DeliverySpec{
+				Retry:         pointer.Int32Ptr(3),
+				BackoffPolicy: &unknown,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid backoff delay",
+			delivery: &eventingduck.DeliverySpec{
+				Retry:        pointer.Int32Ptr(3),
+				BackoffDelay: pointer.StringPtr("1s"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative backoff delay",
+			delivery: &eventingduck.DeliverySpec{
+				Retry:        pointer.Int32Ptr(3),
+				BackoffDelay: pointer.StringPtr("-PT1S"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EgressConfig(tt.delivery)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EgressConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
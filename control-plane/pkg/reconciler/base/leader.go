@@ -0,0 +1,48 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/controller"
+)
+
+// leaderAware is implemented by generated reconcilers, see reconciler.LeaderAwareFuncs.
+type leaderAware interface {
+	IsLeaderFor(key types.NamespacedName) bool
+}
+
+// IsLeaderFunc returns a function reporting whether the given controller is the leader for the given key.
+//
+// It's used by background tasks that must run in a single replica, which use as key the name of the resource they
+// work on.
+func IsLeaderFunc(impl *controller.Impl, key types.NamespacedName) func() bool {
+	isLeaderFor := IsLeaderForFunc(impl)
+	return func() bool {
+		return isLeaderFor(key)
+	}
+}
+
+// IsLeaderForFunc returns a function reporting whether the given controller is the leader for a given key.
+//
+// It's used by background tasks that work on resources reconciled by the controller.
+func IsLeaderForFunc(impl *controller.Impl) func(key types.NamespacedName) bool {
+	return func(key types.NamespacedName) bool {
+		la, ok := impl.Reconciler.(leaderAware)
+		return ok && la.IsLeaderFor(key)
+	}
+}
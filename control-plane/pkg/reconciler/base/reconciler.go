@@ -1,15 +1,17 @@
 package base
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"sync"
 
-	"github.com/gogo/protobuf/proto"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/retry"
@@ -24,16 +26,25 @@ const (
 	// config map key of the brokers and triggers config map.
 	ConfigMapDataKey = "data"
 
+	// DataPlaneConfigMapBackupSuffix is the suffix of the config maps backing up undecodable data plane config maps.
+	DataPlaneConfigMapBackupSuffix = "-backup"
+
 	// label for selecting dispatcher pods.
 	DispatcherLabel = "kafka-broker-dispatcher"
 	// label for selecting receiver pods.
 	ReceiverLabel = "kafka-broker-receiver"
 
 	// volume generation annotation data plane pods.
+	// Shards other than the first one use VolumeGenerationAnnotationKey-<shard>.
 	VolumeGenerationAnnotationKey = "volumeGeneration"
+	// volume generation data plane pods have applied, set by data plane pods.
+	// Shards other than the first one use VolumeGenerationAckAnnotationKey-<shard>.
+	VolumeGenerationAckAnnotationKey = "volumeGenerationAck"
 
 	Protobuf = "protobuf"
 	Json     = "json"
+	// ProtobufGzip is the protobuf encoding compressed with gzip, prefixed with a header identifying the codec.
+	ProtobufGzip = "protobuf-gzip"
 )
 
 // Base reconciler for broker and trigger reconciler.
@@ -46,26 +57,90 @@ type Reconciler struct {
 	DataPlaneConfigMapName      string
 	DataPlaneConfigFormat       string
 	SystemNamespace             string
+
+	// DataPlaneConfigMapShards is the number of config maps the data plane config is split across.
+	// Brokers (and their Triggers) are assigned to a shard by hashing the Broker UID.
+	// Values lower than 2 mean that there is a single config map.
+	DataPlaneConfigMapShards int
+
+	// ContractWriter commits updates of the data plane config, and it's shared by the Broker and the Trigger
+	// reconcilers. When nil, the reconciler uses its own ContractWriter with no write window, see GetContractWriter.
+	ContractWriter     *ContractWriter
+	contractWriterOnce sync.Once
+
+	// Waiting tracks resources waiting for data plane pods to apply the volume generation carrying them.
+	Waiting DataPlaneWaiters
+}
+
+// GetContractWriter returns the ContractWriter used by the reconciler.
+func (r *Reconciler) GetContractWriter() *ContractWriter {
+	r.contractWriterOnce.Do(func() {
+		if r.ContractWriter == nil {
+			r.ContractWriter = NewContractWriter(r, 0)
+		}
+	})
+	return r.ContractWriter
+}
+
+// NumShards returns the number of data plane config map shards.
+func (r *Reconciler) NumShards() int {
+	if r.DataPlaneConfigMapShards < 1 {
+		return 1
+	}
+	return r.DataPlaneConfigMapShards
+}
+
+// Shard returns the data plane config map shard of the Broker with the given UID.
+func (r *Reconciler) Shard(brokerUID types.UID) int {
+	shards := r.NumShards()
+	if shards == 1 {
+		return 0
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(brokerUID))
+	return int(h.Sum32() % uint32(shards))
 }
 
-func (r *Reconciler) GetOrCreateDataPlaneConfigMap() (*corev1.ConfigMap, error) {
+// DataPlaneConfigMapShardName returns the name of the config map of the given shard.
+// The first shard is DataPlaneConfigMapName, so that a single shard is backward compatible.
+func (r *Reconciler) DataPlaneConfigMapShardName(shard int) string {
+	return ShardName(r.DataPlaneConfigMapName, shard)
+}
+
+// DataPlaneConfigMapShardAsString returns the namespace/name of the config map of the given shard.
+func (r *Reconciler) DataPlaneConfigMapShardAsString(shard int) string {
+	return fmt.Sprintf("%s/%s", r.DataPlaneConfigMapNamespace, r.DataPlaneConfigMapShardName(shard))
+}
+
+// ShardName returns the name of the given shard, name for the first shard and name-<shard> for the others.
+// Data plane pods use the same convention for the file names of the mounted shards and for volume generation
+// annotations.
+func ShardName(name string, shard int) string {
+	if shard == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s-%d", name, shard)
+}
+
+func (r *Reconciler) GetOrCreateDataPlaneConfigMap(shard int) (*corev1.ConfigMap, error) {
 
 	cm, err := r.KubeClient.CoreV1().
 		ConfigMaps(r.DataPlaneConfigMapNamespace).
-		Get(r.DataPlaneConfigMapName, metav1.GetOptions{})
+		Get(r.DataPlaneConfigMapShardName(shard), metav1.GetOptions{})
 
 	if apierrors.IsNotFound(err) {
-		cm, err = r.createDataPlaneConfigMap()
+		cm, err = r.createDataPlaneConfigMap(shard)
 	}
 
 	return cm, err
 }
 
-func (r *Reconciler) createDataPlaneConfigMap() (*corev1.ConfigMap, error) {
+func (r *Reconciler) createDataPlaneConfigMap(shard int) (*corev1.ConfigMap, error) {
 	return r.KubeClient.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Create(&corev1.ConfigMap{
 		TypeMeta: metav1.TypeMeta{},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.DataPlaneConfigMapName,
+			Name:      r.DataPlaneConfigMapShardName(shard),
 			Namespace: r.DataPlaneConfigMapNamespace,
 		},
 		BinaryData: map[string][]byte{
@@ -76,10 +151,10 @@ func (r *Reconciler) createDataPlaneConfigMap() (*corev1.ConfigMap, error) {
 
 // GetDataPlaneConfigMapData extracts brokers and triggers data from the given config map.
 func (r *Reconciler) GetDataPlaneConfigMapData(logger *zap.Logger, dataPlaneConfigMap *corev1.ConfigMap) (*coreconfig.Brokers, error) {
-	return GetDataPlaneConfigMapData(logger, dataPlaneConfigMap, r.DataPlaneConfigFormat)
+	return GetDataPlaneConfigMapData(logger, dataPlaneConfigMap)
 }
 
-func GetDataPlaneConfigMapData(logger *zap.Logger, dataPlaneConfigMap *corev1.ConfigMap, format string) (*coreconfig.Brokers, error) {
+func GetDataPlaneConfigMapData(logger *zap.Logger, dataPlaneConfigMap *corev1.ConfigMap) (*coreconfig.Brokers, error) {
 
 	dataPlaneDataRaw, hasData := dataPlaneConfigMap.BinaryData[ConfigMapDataKey]
 	if !hasData || dataPlaneDataRaw == nil {
@@ -96,41 +171,77 @@ func GetDataPlaneConfigMapData(logger *zap.Logger, dataPlaneConfigMap *corev1.Co
 	}
 
 	brokersTriggers := &coreconfig.Brokers{}
-	var err error
-
-	logger.Debug(
-		"Unmarshalling configmap",
-		zap.String("format", format),
-	)
-
-	// determine unmarshalling strategy
-	switch format {
-	case Protobuf:
-		err = proto.Unmarshal(dataPlaneDataRaw, brokersTriggers)
-	case Json:
-		err = json.Unmarshal(dataPlaneDataRaw, brokersTriggers)
-	}
+
+	logger.Debug("Unmarshalling configmap")
+
+	err := UnmarshalDataPlaneConfig(dataPlaneDataRaw, brokersTriggers)
 	if err != nil {
 
 		logger.Warn("Failed to unmarshal config map", zap.Error(err))
 
-		// let the caller decide if it want to continue or fail on an error.
-		return &coreconfig.Brokers{}, fmt.Errorf("failed to unmarshal brokers and triggers: '%s' - %w", dataPlaneDataRaw, err)
+		// Don't return an empty data plane config, since writing it back would wipe out every resource.
+		return nil, contractCorruptedError{
+			error: fmt.Errorf("failed to unmarshal brokers and triggers: '%s' - %w", dataPlaneDataRaw, err),
+			data:  dataPlaneDataRaw,
+		}
 	}
 
 	return brokersTriggers, nil
 }
 
-func (r *Reconciler) UpdateDataPlaneConfigMap(brokersTriggers *coreconfig.Brokers, configMap *corev1.ConfigMap) error {
+// contractCorruptedError is a failure to decode the data of a data plane config map.
+type contractCorruptedError struct {
+	error
+	// data is the undecodable data.
+	data []byte
+}
+
+func (e contractCorruptedError) Unwrap() error {
+	return e.error
+}
 
-	var data []byte
-	var err error
-	switch r.DataPlaneConfigFormat {
-	case Json:
-		data, err = json.Marshal(brokersTriggers)
-	case Protobuf:
-		data, err = proto.Marshal(brokersTriggers)
+// IsContractCorruptedError returns true when the given error is a failure to decode the data of a data plane config
+// map.
+func IsContractCorruptedError(err error) bool {
+	var e contractCorruptedError
+	return errors.As(err, &e)
+}
+
+// DataPlaneConfigMapBackupName returns the name of the config map backing up the undecodable data of the config map
+// of the given shard.
+func (r *Reconciler) DataPlaneConfigMapBackupName(shard int) string {
+	return r.DataPlaneConfigMapShardName(shard) + DataPlaneConfigMapBackupSuffix
+}
+
+// backupDataPlaneConfigMap copies the given data of the config map of the given shard to its backup config map,
+// replacing the previous backup, if any.
+func (r *Reconciler) backupDataPlaneConfigMap(shard int, data []byte) error {
+
+	backup := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.DataPlaneConfigMapBackupName(shard),
+			Namespace: r.DataPlaneConfigMapNamespace,
+		},
+		BinaryData: map[string][]byte{
+			ConfigMapDataKey: data,
+		},
+	}
+
+	configMaps := r.KubeClient.CoreV1().ConfigMaps(backup.Namespace)
+
+	_, err := configMaps.Create(backup)
+	if apierrors.IsAlreadyExists(err) {
+		_, err = configMaps.Update(backup)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to back up config map %s to %s: %w", r.DataPlaneConfigMapShardAsString(shard), backup.Name, err)
 	}
+	return nil
+}
+
+func (r *Reconciler) UpdateDataPlaneConfigMap(brokersTriggers *coreconfig.Brokers, configMap *corev1.ConfigMap) error {
+
+	data, err := MarshalDataPlaneConfig(brokersTriggers, r.DataPlaneConfigFormat)
 	if err != nil {
 		return fmt.Errorf("failed to marshal brokers and triggers: %w", err)
 	}
@@ -147,7 +258,7 @@ func (r *Reconciler) UpdateDataPlaneConfigMap(brokersTriggers *coreconfig.Broker
 	return nil
 }
 
-func (r *Reconciler) UpdateDispatcherPodsAnnotation(logger *zap.Logger, volumeGeneration uint64) error {
+func (r *Reconciler) UpdateDispatcherPodsAnnotation(logger *zap.Logger, shard int, volumeGeneration uint64) error {
 
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 
@@ -157,11 +268,11 @@ func (r *Reconciler) UpdateDispatcherPodsAnnotation(logger *zap.Logger, volumeGe
 			return fmt.Errorf("failed to list dispatcher pods in namespace %s: %w", r.SystemNamespace, errors)
 		}
 
-		return r.updatePodsAnnotation(logger, "dispatcher", volumeGeneration, pods)
+		return r.updatePodsAnnotation(logger, "dispatcher", shard, volumeGeneration, pods)
 	})
 }
 
-func (r *Reconciler) UpdateReceiverPodsAnnotation(logger *zap.Logger, volumeGeneration uint64) error {
+func (r *Reconciler) UpdateReceiverPodsAnnotation(logger *zap.Logger, shard int, volumeGeneration uint64) error {
 
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 
@@ -171,19 +282,22 @@ func (r *Reconciler) UpdateReceiverPodsAnnotation(logger *zap.Logger, volumeGene
 			return fmt.Errorf("failed to list receiver pods in namespace %s: %w", r.SystemNamespace, errors)
 		}
 
-		return r.updatePodsAnnotation(logger, "receiver", volumeGeneration, pods)
+		return r.updatePodsAnnotation(logger, "receiver", shard, volumeGeneration, pods)
 	})
 }
 
-func (r *Reconciler) updatePodsAnnotation(logger *zap.Logger, component string, volumeGeneration uint64, pods []*corev1.Pod) error {
+func (r *Reconciler) updatePodsAnnotation(logger *zap.Logger, component string, shard int, volumeGeneration uint64, pods []*corev1.Pod) error {
 
 	var errors error
 
+	annotationKey := ShardName(VolumeGenerationAnnotationKey, shard)
+
 	for _, pod := range pods {
 
 		logger.Debug(
 			"Update "+component+" pod annotation",
 			zap.String("pod", fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)),
+			zap.Int("shard", shard),
 			zap.Uint64("volumeGeneration", volumeGeneration),
 		)
 
@@ -195,7 +309,7 @@ func (r *Reconciler) updatePodsAnnotation(logger *zap.Logger, component string,
 			annotations = make(map[string]string, 1)
 		}
 
-		annotations[VolumeGenerationAnnotationKey] = fmt.Sprint(volumeGeneration)
+		annotations[annotationKey] = fmt.Sprint(volumeGeneration)
 		pod.SetAnnotations(annotations)
 
 		if _, err := r.KubeClient.CoreV1().Pods(pod.Namespace).Update(pod); err != nil {
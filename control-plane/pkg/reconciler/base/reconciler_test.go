@@ -0,0 +1,92 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestShard(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		shards int
+	}{
+		{name: "not set", shards: 0},
+		{name: "single shard", shards: 1},
+		{name: "multiple shards", shards: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{DataPlaneConfigMapShards: tt.shards}
+
+			used := make(map[int]bool)
+			for i := 0; i < 100; i++ {
+				uid := types.UID(fmt.Sprintf("broker-%d", i))
+
+				shard := r.Shard(uid)
+				assert.GreaterOrEqual(t, shard, 0)
+				assert.Less(t, shard, r.NumShards())
+				assert.Equal(t, shard, r.Shard(uid), "shard must be stable")
+
+				used[shard] = true
+			}
+			assert.Len(t, used, r.NumShards())
+		})
+	}
+}
+
+func TestDataPlaneConfigMapShardName(t *testing.T) {
+	r := &Reconciler{
+		DataPlaneConfigMapNamespace: "knative-eventing",
+		DataPlaneConfigMapName:      "kafka-broker-brokers-triggers",
+		DataPlaneConfigMapShards:    3,
+	}
+
+	assert.Equal(t, "kafka-broker-brokers-triggers", r.DataPlaneConfigMapShardName(0))
+	assert.Equal(t, "kafka-broker-brokers-triggers-2", r.DataPlaneConfigMapShardName(2))
+	assert.Equal(t, "knative-eventing/kafka-broker-brokers-triggers-1", r.DataPlaneConfigMapShardAsString(1))
+	assert.Equal(t, "volumeGeneration-1", ShardName(VolumeGenerationAnnotationKey, 1))
+}
+
+func TestGetOrCreateDataPlaneConfigMapShard(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	r := &Reconciler{
+		KubeClient:                  client,
+		DataPlaneConfigMapNamespace: "knative-eventing",
+		DataPlaneConfigMapName:      "kafka-broker-brokers-triggers",
+		DataPlaneConfigMapShards:    2,
+	}
+
+	cm, err := r.GetOrCreateDataPlaneConfigMap(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "kafka-broker-brokers-triggers-1", cm.Name)
+
+	_, err = client.CoreV1().ConfigMaps("knative-eventing").Get("kafka-broker-brokers-triggers-1", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	_, err = client.CoreV1().ConfigMaps("knative-eventing").Get("kafka-broker-brokers-triggers", metav1.GetOptions{})
+	assert.NotNil(t, err, "only the requested shard must be created")
+}
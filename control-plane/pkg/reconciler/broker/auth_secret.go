@@ -0,0 +1,133 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+const (
+	// AuthSecretStatusAnnotation records the copy of the auth secret used by a Broker, so that the copy is deleted
+	// when the Broker stops using it.
+	AuthSecretStatusAnnotation = "kafka.eventing.knative.dev/auth.secret"
+
+	// AuthSecretBrokerLabel labels copies of auth secrets with the UID of the Broker using them.
+	AuthSecretBrokerLabel = "kafka.eventing.knative.dev/broker.uid"
+
+	authSecretCopyPrefix = "kafka-broker-auth-"
+)
+
+// AuthSecretCopyName returns the name of the copy of the auth secret of the Broker with the given UID.
+func AuthSecretCopyName(uid types.UID) string {
+	return authSecretCopyPrefix + string(uid)
+}
+
+// reconcileAuthSecret copies the auth secret referenced by a Broker into the system namespace and returns a reference
+// to the copy.
+//
+// Data plane pods are allowed to read secrets only in the system namespace. The reference carries a version of the
+// credentials, so that a rotation changes the contract and data plane pods re-create their Kafka clients.
+func (r *Reconciler) reconcileAuthSecret(broker *eventing.Broker, secretRef *corev1.SecretReference) (*coreconfig.SecretReference, error) {
+	if secretRef == nil {
+		if err := r.deleteAuthSecret(broker); err != nil {
+			return nil, err
+		}
+		delete(broker.Status.Annotations, AuthSecretStatusAnnotation)
+		return nil, nil
+	}
+
+	secret, err := r.KubeClient.CoreV1().Secrets(secretRef.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.SystemNamespace,
+			Name:      AuthSecretCopyName(broker.UID),
+			Labels: map[string]string{
+				AuthSecretBrokerLabel: string(broker.UID),
+			},
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}
+
+	secrets := r.KubeClient.CoreV1().Secrets(desired.Namespace)
+	current, err := secrets.Get(desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = secrets.Create(desired)
+	} else if err == nil && !equality.Semantic.DeepEqual(current.Data, desired.Data) {
+		current.Data = desired.Data
+		_, err = secrets.Update(current)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy secret %s/%s to %s/%s: %w", secret.Namespace, secret.Name, desired.Namespace, desired.Name, err)
+	}
+
+	if broker.Status.Annotations == nil {
+		broker.Status.Annotations = make(map[string]string, 1)
+	}
+	broker.Status.Annotations[AuthSecretStatusAnnotation] = desired.Name
+
+	return &coreconfig.SecretReference{
+		Namespace: desired.Namespace,
+		Name:      desired.Name,
+		Version:   SecretDataVersion(desired.Data),
+	}, nil
+}
+
+// deleteAuthSecret deletes the copy of the auth secret recorded in the Broker status, if any.
+func (r *Reconciler) deleteAuthSecret(broker *eventing.Broker) error {
+	name, ok := broker.Status.Annotations[AuthSecretStatusAnnotation]
+	if !ok {
+		return nil
+	}
+
+	err := r.KubeClient.CoreV1().Secrets(r.SystemNamespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete secret %s/%s: %w", r.SystemNamespace, name, err)
+	}
+	return nil
+}
+
+// SecretDataVersion returns a hash of the given secret data, which changes only when credentials change.
+func SecretDataVersion(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(h, "%s=%x;", k, data[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
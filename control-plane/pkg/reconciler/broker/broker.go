@@ -18,22 +18,31 @@ package broker
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Shopify/sarama"
+	"github.com/gogo/protobuf/proto"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/retry"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
 	"knative.dev/eventing/pkg/logging"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/reconciler"
 	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
 
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/clusteradmin"
 	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/log"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
@@ -56,12 +65,26 @@ type Reconciler struct {
 	KafkaDefaultTopicDetailsLock sync.RWMutex
 	bootstrapServers             []string
 	bootstrapServersLock         sync.RWMutex
+	topicDeletionPolicy          TopicDeletionPolicy
+	topicDeletionGracePeriod     time.Duration
+	topicDeletionPolicyLock      sync.RWMutex
+	authSecretRef                *corev1.SecretReference
+	authSecretRefLock            sync.RWMutex
+	BrokerLister                 eventinglisters.BrokerLister
 	ConfigMapLister              corelisters.ConfigMapLister
 
+	// ConfigMapTracker tracks the config maps referenced by Brokers, so that Brokers are reconciled when their config
+	// map changes.
+	ConfigMapTracker tracker.Interface
+
 	// NewClusterAdmin creates new sarama ClusterAdmin. It's convenient to add this as Reconciler field so that we can
 	// mock the function used during the reconciliation loop.
 	NewClusterAdmin func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error)
 
+	// clusterAdmins caches ClusterAdmin created with NewClusterAdmin, see ClusterAdmins.
+	clusterAdmins     *clusteradmin.Cache
+	clusterAdminsOnce sync.Once
+
 	Configs *Configs
 }
 
@@ -81,71 +104,104 @@ func (r *Reconciler) reconcileKind(ctx context.Context, broker *eventing.Broker)
 		recorder: controller.GetEventRecorder(ctx),
 	}
 
+	// Track the config map before resolving it, so that the Broker is reconciled when a missing config map is created.
+	if err := r.trackBrokerConfig(broker); err != nil {
+		return statusConditionManager.failedToResolveBrokerConfig(err)
+	}
+
 	config, err := r.resolveBrokerConfig(logger, broker)
 	if err != nil {
 		return statusConditionManager.failedToResolveBrokerConfig(err)
 	}
+	// Reject invalid topic deletion policies now, since they're used only when the Broker is deleted.
+	if _, _, err := topicDeletionPolicy(broker, config); err != nil {
+		return statusConditionManager.failedToResolveBrokerConfig(err)
+	}
+	// Reject delivery specs the data plane can't honor, rather than dropping some of their fields.
+	if _, err := base.EgressConfig(broker.Spec.Delivery); err != nil {
+		return statusConditionManager.unsupportedDelivery(err)
+	}
+	// The topic of the Broker lives in the Kafka cluster it has been reconciled with, so reject config changes that
+	// the validation webhook didn't see, like updates of the config map.
+	if recorded, ok := recordedConfig(broker); ok && recorded.getBootstrapServers() != config.getBootstrapServers() {
+		return statusConditionManager.bootstrapServersChangeRejected(
+			bootstrapServersChanged(recorded.getBootstrapServers(), config.getBootstrapServers()),
+		)
+	}
 	statusConditionManager.brokerConfigResolved()
 
 	logger.Debug("config resolved", zap.Any("config", config))
 
-	topic, err := r.CreateTopic(logger, Topic(broker), config)
+	topic, err := r.reconcileTopic(logger, broker, config, &statusConditionManager)
 	if err != nil {
-		return statusConditionManager.failedToCreateTopic(topic, err)
-	}
-	statusConditionManager.topicCreated(topic)
-
-	logger.Debug("Topic created", zap.Any("topic", topic))
-
-	// Get brokers and triggers config map.
-	brokersTriggersConfigMap, err := r.GetOrCreateDataPlaneConfigMap()
-	if err != nil {
-		return statusConditionManager.failedToGetBrokersTriggersConfigMap(err)
+		return err
 	}
 
-	logger.Debug("Got brokers and triggers config map")
-
-	// Get brokersTriggers data.
-	brokersTriggers, err := r.GetDataPlaneConfigMapData(logger, brokersTriggersConfigMap)
-	if err != nil && brokersTriggers == nil {
-		return statusConditionManager.failedToGetBrokersTriggersDataFromConfigMap(err)
-	}
+	logger.Debug("Topic reconciled", zap.Any("topic", topic))
 
-	logger.Debug(
-		"Got brokers and triggers data from config map",
-		zap.Any(base.BrokersTriggersDataLogKey, log.BrokersMarshaller{Brokers: brokersTriggers}),
-	)
+	// The Broker is finalized with the config used to reconcile the topic, since the config map might be gone by
+	// then.
+	recordConfig(broker, config)
 
-	brokerIndex := FindBroker(brokersTriggers, broker)
+	shard := r.Shard(broker.UID)
 
 	// Get broker configuration.
 	brokerConfig, err := r.getBrokerConfig(topic, broker, config)
 	if err != nil {
 		return statusConditionManager.failedToGetBrokerConfig(err)
 	}
-	// Update brokersTriggers data with the new broker configuration
-	if brokerIndex != NoBroker {
-		brokerConfig.Triggers = brokersTriggers.Brokers[brokerIndex].Triggers
-		brokersTriggers.Brokers[brokerIndex] = brokerConfig
-
-		logger.Debug("Broker exists", zap.Int("index", brokerIndex))
-
-	} else {
-		brokersTriggers.Brokers = append(brokersTriggers.Brokers, brokerConfig)
 
-		logger.Debug("Broker doesn't exist")
+	// The number of shards might have changed, so the Broker might be in a different shard too.
+	previousShards, previous, err := r.findBrokerInOtherShards(logger, broker, shard)
+	if err != nil {
+		return statusConditionManager.failedToGetBrokersTriggersConfigMap(err)
 	}
 
-	// Increment volumeGeneration
-	brokersTriggers.VolumeGeneration = incrementVolumeGeneration(brokersTriggers.VolumeGeneration)
-
-	// Update the configuration map with the new brokersTriggers data.
-	if err := r.UpdateDataPlaneConfigMap(brokersTriggers, brokersTriggersConfigMap); err != nil {
+	// Update brokersTriggers data with the new broker configuration, the update is committed together with other
+	// updates of the same shard.
+	result, err := r.GetContractWriter().Update(logger, shard, base.ContractUpdate{
+		NotifyReceivers:   true,
+		NotifyDispatchers: true,
+		Mutate: func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error) {
+			if readErr != nil {
+				return false, statusConditionManager.failedToGetBrokersTriggersDataFromConfigMap(readErr)
+			}
+
+			brokerIndex := FindBroker(brokersTriggers, broker)
+			if brokerIndex != NoBroker {
+				brokerConfig.Triggers = brokersTriggers.Brokers[brokerIndex].Triggers
+				if proto.Equal(brokersTriggers.Brokers[brokerIndex], brokerConfig) {
+					// Don't bump the volume generation, data plane pods have the Broker already.
+					return false, nil
+				}
+				brokersTriggers.Brokers[brokerIndex] = brokerConfig
+
+				logger.Debug("Broker exists", zap.Int("index", brokerIndex))
+
+			} else {
+				// Triggers of a Broker moving from another shard move with it.
+				brokerConfig.Triggers = previous.GetTriggers()
+				brokersTriggers.Brokers = append(brokersTriggers.Brokers, brokerConfig)
+
+				logger.Debug("Broker doesn't exist")
+			}
+
+			return true, nil
+		},
+	})
+	if base.IsGetConfigMapError(err) {
+		return statusConditionManager.failedToGetBrokersTriggersConfigMap(err)
+	}
+	if err != nil {
 		return err
 	}
 	statusConditionManager.brokersTriggersConfigMapUpdated()
 
-	logger.Debug("Brokers and triggers config map updated")
+	logger.Debug("Brokers and triggers config map updated", zap.Int("shard", shard))
+
+	if err := r.deleteBrokerFromShards(logger, broker, previousShards); err != nil {
+		return statusConditionManager.failedToUpdateBrokersTriggersConfigMap(err)
+	}
 
 	// After #37 we reject events to a non-existing Broker, which means that we cannot consider a Broker Ready if all
 	// receivers haven't got the Broker, so update failures to receiver pods is a hard failure.
@@ -153,15 +209,15 @@ func (r *Reconciler) reconcileKind(ctx context.Context, broker *eventing.Broker)
 	// prototype for all associated Triggers, so we consider that it's fine on the dispatcher side to receive eventually
 	// the update even if here eventually means seconds or minutes after the actual update.
 
-	// Update volume generation annotation of receiver pods
-	if err := r.UpdateReceiverPodsAnnotation(logger, brokersTriggers.VolumeGeneration); err != nil {
-		return err
+	// Volume generation annotation of receiver pods
+	if result.ReceiversErr != nil {
+		return result.ReceiversErr
 	}
 
 	logger.Debug("Updated receiver pod annotation")
 
-	// Update volume generation annotation of dispatcher pods
-	if err := r.UpdateDispatcherPodsAnnotation(logger, brokersTriggers.VolumeGeneration); err != nil {
+	// Volume generation annotation of dispatcher pods
+	if err := result.DispatchersErr; err != nil {
 		// Failing to update dispatcher pods annotation leads to config map refresh delayed by several seconds.
 		// Since the dispatcher side is the consumer side, we don't lose availability, and we can consider the Broker
 		// ready. So, log out the error and move on to the next step.
@@ -175,9 +231,88 @@ func (r *Reconciler) reconcileKind(ctx context.Context, broker *eventing.Broker)
 		logger.Debug("Updated dispatcher pod annotation")
 	}
 
+	// The Broker is ready when every receiver pod applied the volume generation carrying it.
+	key := types.NamespacedName{Namespace: broker.Namespace, Name: broker.Name}
+	r.Waiting.Wait(key)
+
+	acked, total, err := r.DataPlanePodsAcked(base.ReceiverLabel, shard, result.VolumeGeneration)
+	if err != nil {
+		return statusConditionManager.failedToGetDataPlanePods(err)
+	}
+	if total == 0 {
+		// Nobody applied the volume generation, so events sent to the Broker would be rejected.
+		logger.Debug("No running receiver pods", zap.Uint64("volumeGeneration", result.VolumeGeneration))
+
+		statusConditionManager.noDataPlanePods()
+	} else if acked < total {
+		logger.Debug("Waiting for receiver pods",
+			zap.Uint64("volumeGeneration", result.VolumeGeneration),
+			zap.Int("acked", acked),
+			zap.Int("total", total),
+		)
+
+		statusConditionManager.waitingForDataPlane(result.VolumeGeneration, acked, total)
+	} else {
+		r.Waiting.Done(key)
+		statusConditionManager.dataPlaneReady()
+	}
+
 	return statusConditionManager.reconciled()
 }
 
+func (r *Reconciler) reconcileTopic(logger *zap.Logger, broker *eventing.Broker, config *Config, statusConditionManager *statusConditionManager) (string, reconciler.Event) {
+
+	// Adding or removing the external topic annotation would orphan the topic the Broker uses, so reject it.
+	if err := checkTopicChange(broker); err != nil {
+		return "", statusConditionManager.topicChangeRejected(err)
+	}
+
+	if externalTopic, ok := ExternalTopic(broker); ok {
+		if err := r.checkExternalTopic(broker, externalTopic); err != nil {
+			if errors.Is(err, ErrExternalTopicRejected) {
+				return "", statusConditionManager.externalTopicRejected(externalTopic, err)
+			}
+			return "", statusConditionManager.failedToGetExternalTopic(externalTopic, err)
+		}
+
+		// The topic is managed externally, so we only check that it exists.
+		topic, err := r.CheckExternalTopic(logger, externalTopic, config)
+		if err != nil {
+			return "", statusConditionManager.failedToGetExternalTopic(externalTopic, err)
+		}
+		statusConditionManager.externalTopicReady(topic)
+		recordTopic(broker, topic, true)
+
+		return topic, nil
+	}
+
+	topic, err := r.CreateTopic(logger, Topic(broker), config)
+	switch {
+	case errors.Is(err, ErrDecreasePartitions):
+		// The topic is usable, so don't block the reconciliation, but let the user know that the number of
+		// partitions can't be lowered.
+		logger.Warn("Topic partitions can't be decreased", zap.String("topic", topic), zap.Error(err))
+		statusConditionManager.partitionsDecreaseUnsupported(err)
+	case errors.Is(err, ErrTopicDrift):
+		// The topic is usable, so don't block the reconciliation, but let the user know that the Broker is running
+		// on a topic that doesn't match the desired configuration.
+		logger.Warn("Topic drift detected", zap.String("topic", topic), zap.Error(err))
+		statusConditionManager.topicDriftDetected(topic, err)
+	case err != nil:
+		return "", statusConditionManager.failedToCreateTopic(topic, err)
+	default:
+		statusConditionManager.topicCreated(topic)
+	}
+
+	// The topic might be retained by a deleted Broker with the same name, make sure it won't be deleted.
+	if err := r.releaseRetainedTopic(logger, topic); err != nil {
+		return "", statusConditionManager.failedToCreateTopic(topic, err)
+	}
+	recordTopic(broker, topic, false)
+
+	return topic, nil
+}
+
 func (r *Reconciler) FinalizeKind(ctx context.Context, broker *eventing.Broker) reconciler.Event {
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		return r.finalizeKind(ctx, broker)
@@ -188,51 +323,123 @@ func (r *Reconciler) finalizeKind(ctx context.Context, broker *eventing.Broker)
 
 	logger := log.Logger(ctx, "finalize", broker)
 
-	// Get brokers and triggers config map.
-	brokersTriggersConfigMap, err := r.GetOrCreateDataPlaneConfigMap()
+	r.Waiting.Done(types.NamespacedName{Namespace: broker.Namespace, Name: broker.Name})
+
+	shard := r.Shard(broker.UID)
+
+	// There is no need to update volume generation and dispatcher pod annotation, updates to the config map will
+	// eventually be seen by the dispatcher pod and resources will be deleted accordingly.
+	_, err := r.GetContractWriter().Update(logger, shard, base.ContractUpdate{
+		Mutate: func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error) {
+			if readErr != nil {
+				return false, fmt.Errorf("failed to get brokers and triggers: %w", readErr)
+			}
+
+			brokerIndex := FindBroker(brokersTriggers, broker)
+			if brokerIndex == NoBroker {
+				return false, nil
+			}
+			deleteBroker(brokersTriggers, brokerIndex)
+
+			logger.Debug("Broker deleted", zap.Int("index", brokerIndex))
+
+			return true, nil
+		},
+	})
+	if base.IsGetConfigMapError(err) {
+		return fmt.Errorf("failed to get brokers and triggers config map %s: %w", r.DataPlaneConfigMapShardAsString(shard), err)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to get brokers and triggers config map %s: %w", r.Configs.DataPlaneConfigMapAsString(), err)
+		return err
 	}
 
-	logger.Debug("Got brokers and triggers config map")
+	logger.Debug("Brokers and triggers config map updated")
 
-	// Get brokersTriggers data.
-	brokersTriggers, err := r.GetDataPlaneConfigMapData(logger, brokersTriggersConfigMap)
-	if err != nil {
-		return fmt.Errorf("failed to get brokers and triggers: %w", err)
+	if err := r.deleteAuthSecret(broker); err != nil {
+		return err
 	}
 
-	logger.Debug(
-		"Got brokers and triggers data from config map",
-		zap.Any(base.BrokersTriggersDataLogKey, log.BrokersMarshaller{Brokers: brokersTriggers}),
-	)
+	// Use the topic recorded in the status, since annotations might have changed, and fall back to the desired
+	// topic for Brokers that have never been reconciled.
+	topic, external, ok := recordedTopic(broker)
+	if !ok {
+		topic, external = brokerTopic(broker)
+	}
 
-	brokerIndex := FindBroker(brokersTriggers, broker)
-	if brokerIndex != NoBroker {
-		deleteBroker(brokersTriggers, brokerIndex)
+	if external {
+		// The topic is managed externally, so never delete it.
+		logger.Debug("External topic not deleted", zap.String("topic", topic))
 
-		logger.Debug("Broker deleted", zap.Int("index", brokerIndex))
+		return nil
+	}
 
-		// Update the configuration map with the new brokersTriggers data.
-		if err := r.UpdateDataPlaneConfigMap(brokersTriggers, brokersTriggersConfigMap); err != nil {
-			return err
-		}
+	// Retaining the topic doesn't require the Broker config, nor Kafka, so it's the way out for Brokers that can't
+	// be finalized otherwise.
+	if topicRetained(broker) {
+		logger.Debug("Topic retained by annotation", zap.String("topic", topic))
 
-		logger.Debug("Brokers and triggers config map updated")
+		return nil
+	}
 
-		// There is no need to update volume generation and dispatcher pod annotation, updates to the config map will
-		// eventually be seen by the dispatcher pod and resources will be deleted accordingly.
+	// Use the config recorded in the status, since the config map might have been deleted together with the Broker,
+	// and fall back to the current config for Brokers that have never been reconciled.
+	config, ok := recordedConfig(broker)
+	if !ok {
+		config, err = r.resolveBrokerConfig(logger, broker)
+		if err != nil {
+			return fmt.Errorf("failed to resolve broker config: %w - %s", err, retainTopicHint(topic))
+		}
 	}
 
-	config, err := r.resolveBrokerConfig(logger, broker)
+	policy, gracePeriod, err := topicDeletionPolicy(broker, config)
 	if err != nil {
-		return fmt.Errorf("failed to resolve broker config: %w", err)
+		// Invalid policies are rejected while the Broker is alive, so we get here only when they're changed right
+		// before the Broker is deleted. Don't block the Broker deletion and don't delete data we might not want to.
+		logger.Warn("Invalid topic deletion policy, retain topic", zap.String("topic", topic), zap.Error(err))
+
+		controller.GetEventRecorder(ctx).Eventf(
+			broker,
+			corev1.EventTypeWarning,
+			"InvalidTopicDeletionPolicy",
+			"Topic %s retained: %v",
+			topic,
+			err,
+		)
+
+		policy = TopicDeletionPolicyRetain
 	}
 
-	bootstrapServers := config.BootstrapServers
-	topic, err := r.deleteTopic(Topic(broker), bootstrapServers)
-	if err != nil {
-		return fmt.Errorf("failed to delete topic %s: %w", topic, err)
+	logger.Debug("Topic deletion policy",
+		zap.String("policy", string(policy)),
+		zap.Duration("gracePeriod", gracePeriod),
+	)
+
+	switch policy {
+	case TopicDeletionPolicyRetain:
+		logger.Debug("Topic retained", zap.String("topic", topic))
+
+		return nil
+
+	case TopicDeletionPolicyDeleteAfterGracePeriod:
+		deleteAt := broker.GetDeletionTimestamp().Add(gracePeriod)
+		if time.Now().Before(deleteAt) {
+
+			// Record the topic to delete, so that the Broker doesn't stay around for the whole grace period.
+			if err := r.retainTopic(topic, config, deleteAt); err != nil {
+				return fmt.Errorf("failed to retain topic %s: %w", topic, err)
+			}
+
+			logger.Debug("Topic retained for grace period",
+				zap.String("topic", topic),
+				zap.Time("deleteAt", deleteAt),
+			)
+
+			return nil
+		}
+	}
+
+	if _, err := r.deleteTopic(topic, config.BootstrapServers, config.AuthSecretRef); err != nil {
+		return fmt.Errorf("failed to delete topic %s: %w - %s", topic, err, retainTopicHint(topic))
 	}
 
 	logger.Debug("Topic deleted", zap.String("topic", topic))
@@ -240,10 +447,6 @@ func (r *Reconciler) finalizeKind(ctx context.Context, broker *eventing.Broker)
 	return nil
 }
 
-func incrementVolumeGeneration(generation uint64) uint64 {
-	return (generation + 1) % (math.MaxUint64 - 1)
-}
-
 func (r *Reconciler) resolveBrokerConfig(logger *zap.Logger, broker *eventing.Broker) (*Config, error) {
 
 	logger.Debug("broker config", zap.Any("broker.spec.config", broker.Spec.Config))
@@ -252,26 +455,68 @@ func (r *Reconciler) resolveBrokerConfig(logger *zap.Logger, broker *eventing.Br
 		return r.defaultConfig()
 	}
 
+	cm, err := brokerConfigMap(r.ConfigMapLister, broker)
+	if err != nil {
+		return nil, err
+	}
+
+	brokerConfig, err := configFromConfigMap(logger, cm)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTopicDeletionPolicy, defaultTopicDeletionGracePeriod := r.defaultTopicDeletionPolicy()
+	if brokerConfig.TopicDeletionPolicy == "" {
+		brokerConfig.TopicDeletionPolicy = defaultTopicDeletionPolicy
+	}
+	if brokerConfig.TopicDeletionGracePeriod == 0 {
+		brokerConfig.TopicDeletionGracePeriod = defaultTopicDeletionGracePeriod
+	}
+
+	return brokerConfig, nil
+}
+
+// brokerConfigMap returns the config map referenced by spec.config of the given Broker.
+func brokerConfigMap(lister corelisters.ConfigMapLister, broker *eventing.Broker) (*corev1.ConfigMap, error) {
+
 	if strings.ToLower(broker.Spec.Config.Kind) != "configmap" { // TODO: is there any constant?
 		return nil, fmt.Errorf("supported config Kind: ConfigMap - got %s", broker.Spec.Config.Kind)
 	}
 
-	namespace := broker.Spec.Config.Namespace
-	if namespace == "" {
-		// Namespace not specified, use broker namespace.
-		namespace = broker.Namespace
-	}
-	cm, err := r.ConfigMapLister.ConfigMaps(namespace).Get(broker.Spec.Config.Name)
+	namespace := brokerConfigNamespace(broker)
+	cm, err := lister.ConfigMaps(namespace).Get(broker.Spec.Config.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get configmap %s/%s: %w", namespace, broker.Spec.Config.Name, err)
 	}
 
-	brokerConfig, err := configFromConfigMap(logger, cm)
-	if err != nil {
-		return nil, err
+	return cm, nil
+}
+
+func brokerConfigNamespace(broker *eventing.Broker) string {
+	if broker.Spec.Config.Namespace == "" {
+		// Namespace not specified, use broker namespace.
+		return broker.Namespace
 	}
+	return broker.Spec.Config.Namespace
+}
 
-	return brokerConfig, nil
+// trackBrokerConfig tracks the config map referenced by spec.config of the given Broker.
+// Unsupported config kinds aren't tracked, since they're rejected when the config is resolved.
+func (r *Reconciler) trackBrokerConfig(broker *eventing.Broker) error {
+	if broker.Spec.Config == nil || strings.ToLower(broker.Spec.Config.Kind) != "configmap" {
+		return nil
+	}
+
+	err := r.ConfigMapTracker.TrackReference(tracker.Reference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  brokerConfigNamespace(broker),
+		Name:       broker.Spec.Config.Name,
+	}, broker)
+	if err != nil {
+		return fmt.Errorf("failed to track configmap %s/%s: %w", brokerConfigNamespace(broker), broker.Spec.Config.Name, err)
+	}
+	return nil
 }
 
 func (r *Reconciler) defaultTopicDetail() sarama.TopicDetail {
@@ -289,26 +534,99 @@ func (r *Reconciler) defaultConfig() (*Config, error) {
 		return nil, err
 	}
 
+	topicDeletionPolicy, topicDeletionGracePeriod := r.defaultTopicDeletionPolicy()
+
 	return &Config{
-		TopicDetail:      r.defaultTopicDetail(),
-		BootstrapServers: bootstrapServers,
+		TopicDetail:              r.defaultTopicDetail(),
+		BootstrapServers:         bootstrapServers,
+		TopicDeletionPolicy:      topicDeletionPolicy,
+		TopicDeletionGracePeriod: topicDeletionGracePeriod,
+		// The default auth secret belongs to the default bootstrap servers, so it's used only with them, Brokers
+		// with a specific config map must reference their own secret.
+		AuthSecretRef: r.defaultAuthSecretRef(),
 	}, nil
 }
 
+func (r *Reconciler) defaultTopicDeletionPolicy() (TopicDeletionPolicy, time.Duration) {
+	r.topicDeletionPolicyLock.RLock()
+	defer r.topicDeletionPolicyLock.RUnlock()
+
+	return r.topicDeletionPolicy, r.topicDeletionGracePeriod
+}
+
+func (r *Reconciler) defaultAuthSecretRef() *corev1.SecretReference {
+	r.authSecretRefLock.RLock()
+	defer r.authSecretRefLock.RUnlock()
+
+	return r.authSecretRef
+}
+
 func (r *Reconciler) getBrokerConfig(topic string, broker *eventing.Broker, config *Config) (*coreconfig.Broker, error) {
 
+	// Only the secret reference goes into the contract, the data plane loads the secret itself.
+	authSecret, err := r.reconcileAuthSecret(broker, config.AuthSecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBrokerConfig(r.Resolver, topic, broker, config.getBootstrapServers(), authSecret)
+}
+
+// RecordedBrokerConfig returns the data plane config of the given Broker, without Triggers, built from the topic and
+// the config recorded in its status, so it doesn't connect to Kafka.
+// It returns false when the Broker hasn't been reconciled yet.
+func RecordedBrokerConfig(kubeClient kubernetes.Interface, systemNamespace string, uriResolver *resolver.URIResolver, broker *eventing.Broker) (*coreconfig.Broker, bool, error) {
+
+	topic, _, ok := recordedTopic(broker)
+	if !ok {
+		return nil, false, nil
+	}
+	config, ok := recordedConfig(broker)
+	if !ok {
+		return nil, false, nil
+	}
+
+	var authSecret *coreconfig.SecretReference
+	if name, ok := broker.Status.Annotations[AuthSecretStatusAnnotation]; ok {
+		secret, err := kubeClient.CoreV1().Secrets(systemNamespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get secret %s/%s: %w", systemNamespace, name, err)
+		}
+		authSecret = &coreconfig.SecretReference{
+			Namespace: secret.Namespace,
+			Name:      secret.Name,
+			Version:   SecretDataVersion(secret.Data),
+		}
+	}
+
+	brokerConfig, err := newBrokerConfig(uriResolver, topic, broker, config.getBootstrapServers(), authSecret)
+	if err != nil {
+		return nil, false, err
+	}
+	return brokerConfig, true, nil
+}
+
+func newBrokerConfig(uriResolver *resolver.URIResolver, topic string, broker *eventing.Broker, bootstrapServers string, authSecret *coreconfig.SecretReference) (*coreconfig.Broker, error) {
+
 	brokerConfig := &coreconfig.Broker{
 		Id:               string(broker.UID),
 		Topic:            topic,
 		Path:             Path(broker.Namespace, broker.Name),
-		BootstrapServers: config.getBootstrapServers(),
+		BootstrapServers: bootstrapServers,
+		AuthSecret:       authSecret,
+	}
+
+	egressConfig, err := base.EgressConfig(broker.Spec.Delivery)
+	if err != nil {
+		return nil, err
 	}
+	brokerConfig.EgressConfig = egressConfig
 
 	if broker.Spec.Delivery == nil || broker.Spec.Delivery.DeadLetterSink == nil {
 		return brokerConfig, nil
 	}
 
-	deadLetterSinkURL, err := r.Resolver.URIFromDestinationV1(*broker.Spec.Delivery.DeadLetterSink, broker)
+	deadLetterSinkURL, err := uriResolver.URIFromDestinationV1(*broker.Spec.Delivery.DeadLetterSink, broker)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve broker.Spec.Deliver.DeadLetterSink: %w", err)
 	}
@@ -336,6 +654,8 @@ func (r *Reconciler) ConfigMapUpdated(ctx context.Context) func(configMap *corev
 
 		r.SetDefaultTopicDetails(config.TopicDetail)
 		r.SetBootstrapServers(config.getBootstrapServers())
+		r.SetDefaultTopicDeletionPolicy(config.TopicDeletionPolicy, config.TopicDeletionGracePeriod)
+		r.SetDefaultAuthSecretRef(config.AuthSecretRef)
 	}
 }
 
@@ -353,16 +673,31 @@ func (r *Reconciler) SetBootstrapServers(servers string) {
 	r.bootstrapServersLock.Unlock()
 }
 
-func (r *Reconciler) getKafkaClusterAdmin(bootstrapServers []string) (sarama.ClusterAdmin, error) {
-	config := sarama.NewConfig()
-	config.Version = sarama.MaxVersion
+// ClusterAdmins returns the cache of ClusterAdmin used by the reconciler.
+func (r *Reconciler) ClusterAdmins() *clusteradmin.Cache {
+	r.clusterAdminsOnce.Do(func() {
+		r.clusterAdmins = clusteradmin.NewCache(r.NewClusterAdmin)
+	})
+	return r.clusterAdmins
+}
 
-	kafkaClusterAdmin, err := r.NewClusterAdmin(bootstrapServers, config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create cluster admin: %w", err)
+// getKafkaClusterAdmin returns a sarama ClusterAdmin connecting to the given bootstrap servers using TLS and SASL
+// settings of the given secret, when not nil.
+//
+// The returned ClusterAdmin is shared and it must not be closed, callers must call the returned release function
+// with the result of the operations done with it.
+func (r *Reconciler) getKafkaClusterAdmin(bootstrapServers []string, secretRef *corev1.SecretReference) (sarama.ClusterAdmin, clusteradmin.ReleaseFunc, error) {
+	var secret *corev1.Secret
+	if secretRef != nil {
+		var err error
+		// Secrets are read directly instead of being watched to not cache every Secret of the cluster.
+		secret, err = r.KubeClient.CoreV1().Secrets(secretRef.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+		}
 	}
 
-	return kafkaClusterAdmin, nil
+	return r.ClusterAdmins().Get(bootstrapServers, secret)
 }
 
 func (r *Reconciler) SetDefaultTopicDetails(topicDetail sarama.TopicDetail) {
@@ -372,6 +707,23 @@ func (r *Reconciler) SetDefaultTopicDetails(topicDetail sarama.TopicDetail) {
 	r.KafkaDefaultTopicDetails = topicDetail
 }
 
+// SetDefaultTopicDeletionPolicy change the topic deletion policy used by Brokers that don't specify one.
+func (r *Reconciler) SetDefaultTopicDeletionPolicy(policy TopicDeletionPolicy, gracePeriod time.Duration) {
+	r.topicDeletionPolicyLock.Lock()
+	defer r.topicDeletionPolicyLock.Unlock()
+
+	r.topicDeletionPolicy = policy
+	r.topicDeletionGracePeriod = gracePeriod
+}
+
+// SetDefaultAuthSecretRef change the secret used by Brokers that don't reference one.
+func (r *Reconciler) SetDefaultAuthSecretRef(secretRef *corev1.SecretReference) {
+	r.authSecretRefLock.Lock()
+	defer r.authSecretRefLock.Unlock()
+
+	r.authSecretRef = secretRef
+}
+
 func FindBroker(brokersTriggers *coreconfig.Brokers, broker *eventing.Broker) int {
 	// Find broker in brokersTriggers.
 	brokerIndex := NoBroker
@@ -384,6 +736,81 @@ func FindBroker(brokersTriggers *coreconfig.Brokers, broker *eventing.Broker) in
 	return brokerIndex
 }
 
+// findBrokerInOtherShards returns the data plane config map shards, except the given one, having the given Broker,
+// and the Broker entry of the last of them.
+// It's a no-op when the data plane config isn't sharded.
+func (r *Reconciler) findBrokerInOtherShards(logger *zap.Logger, broker *eventing.Broker, shard int) ([]int, *coreconfig.Broker, error) {
+	if r.NumShards() == 1 {
+		return nil, nil, nil
+	}
+
+	var shards []int
+	var entry *coreconfig.Broker
+	for other := 0; other < r.NumShards(); other++ {
+		if other == shard {
+			continue
+		}
+
+		cm, err := r.ConfigMapLister.ConfigMaps(r.DataPlaneConfigMapNamespace).Get(r.DataPlaneConfigMapShardName(other))
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get config map %s: %w", r.DataPlaneConfigMapShardAsString(other), err)
+		}
+
+		brokersTriggers, err := r.GetDataPlaneConfigMapData(logger, cm)
+		if base.IsContractCorruptedError(err) {
+			// The shard might have the Broker, deleting it from the shard goes through the ContractWriter, which
+			// recovers the shard.
+			shards = append(shards, other)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		brokerIndex := FindBroker(brokersTriggers, broker)
+		if brokerIndex == NoBroker {
+			continue
+		}
+
+		shards = append(shards, other)
+		entry = brokersTriggers.Brokers[brokerIndex]
+	}
+
+	return shards, entry, nil
+}
+
+// deleteBrokerFromShards deletes the given Broker from the given data plane config map shards.
+func (r *Reconciler) deleteBrokerFromShards(logger *zap.Logger, broker *eventing.Broker, shards []int) error {
+	for _, other := range shards {
+		_, err := r.GetContractWriter().Update(logger, other, base.ContractUpdate{
+			NotifyReceivers:   true,
+			NotifyDispatchers: true,
+			Mutate: func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error) {
+				if readErr != nil {
+					return false, readErr
+				}
+
+				brokerIndex := FindBroker(brokersTriggers, broker)
+				if brokerIndex == NoBroker {
+					return false, nil
+				}
+				deleteBroker(brokersTriggers, brokerIndex)
+
+				return true, nil
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.Debug("Broker deleted from previous shard", zap.Int("shard", other))
+	}
+
+	return nil
+}
+
 func deleteBroker(brokersTriggers *coreconfig.Brokers, index int) {
 	if len(brokersTriggers.Brokers) == 1 {
 		*brokersTriggers = coreconfig.Brokers{
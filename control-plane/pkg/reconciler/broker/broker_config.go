@@ -19,16 +19,36 @@ package broker
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/pkg/configmap"
 )
 
+const (
+	// Broker status annotations recording the config a Broker has been reconciled with, so that the Broker is
+	// finalized even when the config map it references has been deleted.
+	BootstrapServersStatusAnnotation         = "kafka.eventing.knative.dev/bootstrap.servers"
+	AuthSecretRefStatusAnnotation            = "kafka.eventing.knative.dev/auth.secret.ref"
+	TopicDeletionPolicyStatusAnnotation      = TopicDeletionPolicyAnnotation
+	TopicDeletionGracePeriodStatusAnnotation = TopicDeletionGracePeriodAnnotation
+)
+
 type Config struct {
 	TopicDetail      sarama.TopicDetail
 	BootstrapServers []string
+
+	// TopicDeletionPolicy is empty when the config map doesn't specify a topic deletion policy.
+	TopicDeletionPolicy      TopicDeletionPolicy
+	TopicDeletionGracePeriod time.Duration
+
+	// AuthSecretRef references the secret holding TLS and SASL settings to connect to Kafka, it's nil when the config
+	// map doesn't reference any secret.
+	AuthSecretRef *corev1.SecretReference
 }
 
 func configFromConfigMap(logger *zap.Logger, cm *corev1.ConfigMap) (*Config, error) {
@@ -37,11 +57,17 @@ func configFromConfigMap(logger *zap.Logger, cm *corev1.ConfigMap) (*Config, err
 
 	var replicationFactor int32
 	var bootstrapServers string
+	var topicDeletionPolicy string
+	var topicDeletionGracePeriod time.Duration
+	var authSecretName string
 
 	err := configmap.Parse(cm.Data,
 		configmap.AsInt32(DefaultTopicNumPartitionConfigMapKey, &topicDetail.NumPartitions),
 		configmap.AsInt32(DefaultTopicReplicationFactorConfigMapKey, &replicationFactor),
 		configmap.AsString(BootstrapServersConfigMapKey, &bootstrapServers),
+		configmap.AsString(DefaultTopicDeletionPolicyConfigMapKey, &topicDeletionPolicy),
+		configmap.AsDuration(DefaultTopicDeletionGracePeriodConfigMapKey, &topicDeletionGracePeriod),
+		configmap.AsString(AuthSecretNameConfigMapKey, &authSecretName),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config map %s/%s: %w", cm.Namespace, cm.Name, err)
@@ -55,11 +81,31 @@ func configFromConfigMap(logger *zap.Logger, cm *corev1.ConfigMap) (*Config, err
 			bootstrapServers)
 	}
 
+	// The grace period might come from the defaults, so it's validated with the Broker topic deletion policy.
+	if err := validateTopicDeletionPolicy(TopicDeletionPolicy(topicDeletionPolicy)); err != nil {
+		return nil, fmt.Errorf("invalid configuration - %w", err)
+	}
+	if topicDeletionGracePeriod < 0 {
+		return nil, fmt.Errorf("invalid configuration - negative topic deletion grace period %v", topicDeletionGracePeriod)
+	}
+
 	topicDetail.ReplicationFactor = int16(replicationFactor)
+	topicDetail.ConfigEntries = topicConfigEntries(cm.Data)
 
 	config := &Config{
-		TopicDetail:      topicDetail,
-		BootstrapServers: bootstrapServersArray(bootstrapServers),
+		TopicDetail:              topicDetail,
+		BootstrapServers:         bootstrapServersArray(bootstrapServers),
+		TopicDeletionPolicy:      TopicDeletionPolicy(topicDeletionPolicy),
+		TopicDeletionGracePeriod: topicDeletionGracePeriod,
+	}
+
+	// The secret is in the same namespace as the config map, so that a config map can't reference secrets of
+	// other namespaces.
+	if authSecretName != "" {
+		config.AuthSecretRef = &corev1.SecretReference{
+			Namespace: cm.Namespace,
+			Name:      authSecretName,
+		}
 	}
 
 	logger.Debug("got broker config from config map", zap.Any("config", config))
@@ -70,3 +116,93 @@ func configFromConfigMap(logger *zap.Logger, cm *corev1.ConfigMap) (*Config, err
 func (c Config) getBootstrapServers() string {
 	return strings.Join(c.BootstrapServers, ",")
 }
+
+// recordConfig records in the status of the given Broker the parts of the given config needed to finalize the Broker.
+func recordConfig(broker *eventing.Broker, config *Config) {
+	if broker.Status.Annotations == nil {
+		broker.Status.Annotations = make(map[string]string, 4)
+	}
+	annotations := broker.Status.Annotations
+
+	annotations[BootstrapServersStatusAnnotation] = config.getBootstrapServers()
+
+	delete(annotations, AuthSecretRefStatusAnnotation)
+	if config.AuthSecretRef != nil {
+		annotations[AuthSecretRefStatusAnnotation] = types.NamespacedName{
+			Namespace: config.AuthSecretRef.Namespace,
+			Name:      config.AuthSecretRef.Name,
+		}.String()
+	}
+
+	delete(annotations, TopicDeletionPolicyStatusAnnotation)
+	if config.TopicDeletionPolicy != "" {
+		annotations[TopicDeletionPolicyStatusAnnotation] = string(config.TopicDeletionPolicy)
+	}
+
+	delete(annotations, TopicDeletionGracePeriodStatusAnnotation)
+	if config.TopicDeletionGracePeriod != 0 {
+		annotations[TopicDeletionGracePeriodStatusAnnotation] = config.TopicDeletionGracePeriod.String()
+	}
+}
+
+// recordedConfig returns the config recorded in the status of the given Broker, if any.
+// The topic details aren't recorded, so they're empty.
+func recordedConfig(broker *eventing.Broker) (*Config, bool) {
+	annotations := broker.Status.Annotations
+
+	bootstrapServers, ok := annotations[BootstrapServersStatusAnnotation]
+	if !ok || bootstrapServers == "" {
+		return nil, false
+	}
+
+	config := &Config{
+		BootstrapServers:    bootstrapServersArray(bootstrapServers),
+		TopicDeletionPolicy: TopicDeletionPolicy(annotations[TopicDeletionPolicyStatusAnnotation]),
+	}
+
+	if ref, ok := annotations[AuthSecretRefStatusAnnotation]; ok {
+		parts := strings.SplitN(ref, string(types.Separator), 2)
+		if len(parts) != 2 {
+			return nil, false
+		}
+		config.AuthSecretRef = &corev1.SecretReference{Namespace: parts[0], Name: parts[1]}
+	}
+
+	if gp, ok := annotations[TopicDeletionGracePeriodStatusAnnotation]; ok {
+		d, err := time.ParseDuration(gp)
+		if err != nil {
+			return nil, false
+		}
+		config.TopicDeletionGracePeriod = d
+	}
+
+	return config, true
+}
+
+// topicConfigEntries extracts Kafka topic level configurations (retention.ms, cleanup.policy, etc) from the given
+// config map data.
+// Topic configurations are specified as keys with the DefaultTopicConfigConfigMapKeyPrefix prefix, for example:
+// default.topic.config.retention.ms: "86400000"
+func topicConfigEntries(data map[string]string) map[string]*string {
+
+	var entries map[string]*string
+	for k, v := range data {
+		if !strings.HasPrefix(k, DefaultTopicConfigConfigMapKeyPrefix) {
+			continue
+		}
+
+		name := strings.TrimPrefix(k, DefaultTopicConfigConfigMapKeyPrefix)
+		if name == "" {
+			continue
+		}
+
+		if entries == nil {
+			entries = make(map[string]*string)
+		}
+
+		value := v
+		entries[name] = &value
+	}
+
+	return entries
+}
@@ -24,6 +24,7 @@ import (
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/reconciler/names"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/reconciler"
 )
 
@@ -32,6 +33,7 @@ const (
 	ConditionTopicReady       apis.ConditionType = "TopicReady"
 	ConditionConfigMapUpdated apis.ConditionType = "ConfigMapUpdated"
 	ConditionConfigParsed     apis.ConditionType = "ConfigParsed"
+	ConditionDataPlaneReady   apis.ConditionType = "DataPlaneReady"
 )
 
 var ConditionSet = apis.NewLivingConditionSet(
@@ -39,6 +41,7 @@ var ConditionSet = apis.NewLivingConditionSet(
 	ConditionTopicReady,
 	ConditionConfigMapUpdated,
 	ConditionConfigParsed,
+	ConditionDataPlaneReady,
 )
 
 const (
@@ -46,6 +49,10 @@ const (
 	Reconciled = Broker + "Reconciled"
 )
 
+// PartitionsDecreaseUnsupportedReason is the TopicReady reason, and the event reason, of Brokers whose topic has more
+// partitions than desired, since Kafka doesn't support decreasing the number of partitions of a topic.
+const PartitionsDecreaseUnsupportedReason = "PartitionsDecreaseUnsupported"
+
 type statusConditionManager struct {
 	Broker *eventing.Broker
 
@@ -117,6 +124,53 @@ func (manager *statusConditionManager) failedToCreateTopic(topic string, err err
 	return fmt.Errorf("failed to create topic: %s: %w", topic, err)
 }
 
+func (manager *statusConditionManager) failedToGetExternalTopic(topic string, err error) reconciler.Event {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkFalse(
+		ConditionTopicReady,
+		fmt.Sprintf("Failed to get external topic: %s", topic),
+		"%v",
+		err,
+	)
+
+	return fmt.Errorf("failed to get external topic: %s: %w", topic, err)
+}
+
+func (manager *statusConditionManager) externalTopicReady(topic string) {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkTrueWithReason(
+		ConditionTopicReady,
+		fmt.Sprintf("External topic %s found", topic),
+		"",
+	)
+}
+
+func (manager *statusConditionManager) topicChangeRejected(err error) reconciler.Event {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkFalse(
+		ConditionTopicReady,
+		"Topic change rejected",
+		"%v",
+		err,
+	)
+
+	// Nothing changes until the Broker is updated again.
+	return controller.NewPermanentError(err)
+}
+
+func (manager *statusConditionManager) externalTopicRejected(topic string, err error) reconciler.Event {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkFalse(
+		ConditionTopicReady,
+		fmt.Sprintf("External topic %s rejected", topic),
+		"%v",
+		err,
+	)
+
+	// Nothing changes until the Broker is updated again.
+	return controller.NewPermanentError(err)
+}
+
 func (manager *statusConditionManager) topicCreated(topic string) {
 
 	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkTrueWithReason(
@@ -126,6 +180,41 @@ func (manager *statusConditionManager) topicCreated(topic string) {
 	)
 }
 
+func (manager *statusConditionManager) topicDriftDetected(topic string, err error) {
+	manager.topicMismatch(fmt.Sprintf("Topic %s doesn't match the desired configuration", topic), "TopicDriftDetected", err)
+}
+
+func (manager *statusConditionManager) partitionsDecreaseUnsupported(err error) {
+	manager.topicMismatch(PartitionsDecreaseUnsupportedReason, PartitionsDecreaseUnsupportedReason, err)
+}
+
+// topicMismatch marks the topic ready, since a topic that doesn't match the desired configuration is still usable,
+// and it lets the user know about the mismatch.
+func (manager *statusConditionManager) topicMismatch(reason, eventReason string, err error) {
+
+	previous := manager.Broker.Status.GetCondition(ConditionTopicReady)
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkTrueWithReason(
+		ConditionTopicReady,
+		reason,
+		"%v",
+		err,
+	)
+
+	// Record the event only when the mismatch is detected for the first time, not on every resync.
+	if previous != nil && previous.Reason == reason && previous.Message == err.Error() {
+		return
+	}
+
+	manager.recorder.Eventf(
+		manager.Broker,
+		corev1.EventTypeWarning,
+		eventReason,
+		"%v",
+		err,
+	)
+}
+
 func (manager *statusConditionManager) reconciled() reconciler.Event {
 
 	broker := manager.Broker
@@ -140,6 +229,43 @@ func (manager *statusConditionManager) reconciled() reconciler.Event {
 	return nil
 }
 
+func (manager *statusConditionManager) dataPlaneReady() {
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkTrue(ConditionDataPlaneReady)
+}
+
+func (manager *statusConditionManager) waitingForDataPlane(volumeGeneration uint64, acked, total int) {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkUnknown(
+		ConditionDataPlaneReady,
+		"Waiting for receiver pods",
+		"%d of %d receiver pods applied volume generation %d",
+		acked,
+		total,
+		volumeGeneration,
+	)
+}
+
+func (manager *statusConditionManager) noDataPlanePods() {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkUnknown(
+		ConditionDataPlaneReady,
+		"Waiting for receiver pods",
+		"no running receiver pods",
+	)
+}
+
+func (manager *statusConditionManager) failedToGetDataPlanePods(err error) reconciler.Event {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkFalse(
+		ConditionDataPlaneReady,
+		"Failed to get receiver pods",
+		"%v",
+		err,
+	)
+
+	return fmt.Errorf("failed to get receiver pods: %w", err)
+}
+
 func (manager *statusConditionManager) failedToUpdateDispatcherPodsAnnotation(err error) {
 
 	// We don't set status conditions for dispatcher pods updates.
@@ -175,6 +301,32 @@ func (manager *statusConditionManager) failedToResolveBrokerConfig(err error) re
 	return fmt.Errorf("failed to get broker configuration: %w", err)
 }
 
+func (manager *statusConditionManager) unsupportedDelivery(err error) reconciler.Event {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkFalse(
+		ConditionConfigParsed,
+		"Unsupported delivery spec",
+		"%v",
+		err,
+	)
+
+	// Nothing changes until the Broker is updated again.
+	return controller.NewPermanentError(err)
+}
+
+func (manager *statusConditionManager) bootstrapServersChangeRejected(err error) reconciler.Event {
+
+	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkFalse(
+		ConditionConfigParsed,
+		"Bootstrap servers change rejected",
+		"%v",
+		err,
+	)
+
+	// Nothing changes until the Broker config is updated again.
+	return controller.NewPermanentError(err)
+}
+
 func (manager *statusConditionManager) brokerConfigResolved() {
 	manager.Broker.GetConditionSet().Manage(&manager.Broker.Status).MarkTrue(ConditionConfigParsed)
 }
@@ -18,6 +18,7 @@ package broker_test // different package name due to import cycles. (broker -> t
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"sync"
@@ -26,11 +27,16 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/pkg/apis"
 	kubeclient "knative.dev/pkg/client/injection/kube/client/fake"
@@ -38,6 +44,7 @@ import (
 	"knative.dev/pkg/logging"
 	. "knative.dev/pkg/reconciler/testing"
 	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
 
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
@@ -50,12 +57,22 @@ import (
 	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/kafka"
 	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/security"
 )
 
 const (
-	wantErrorOnCreateTopic = "wantErrorOnCreateTopic"
-	wantErrorOnDeleteTopic = "wantErrorOnDeleteTopic"
-	ExpectedTopicDetail    = "expectedTopicDetail"
+	wantErrorOnCreateTopic   = "wantErrorOnCreateTopic"
+	wantErrorOnDeleteTopic   = "wantErrorOnDeleteTopic"
+	ExpectedTopicDetail      = "expectedTopicDetail"
+	topicMetadata            = "topicMetadata"
+	expectedTopicName        = "expectedTopicName"
+	expectedSASLUser         = "expectedSASLUser"
+	defaultAuthSecret        = "defaultAuthSecret"
+	expectedBootstrapServers = "expectedBootstrapServers"
+
+	externalTopic = "my-external-topic"
+
+	authSecretName = "kafka-auth"
 )
 
 const (
@@ -72,7 +89,42 @@ var (
 	)
 
 	createTopicError = fmt.Errorf("failed to create topic")
+
+	topicAlreadyExistsErrMsg = "topic already exists"
+	topicAlreadyExistsError  = &sarama.TopicError{
+		Err:    sarama.ErrTopicAlreadyExists,
+		ErrMsg: &topicAlreadyExistsErrMsg,
+	}
 	deleteTopicError = fmt.Errorf("failed to delete topic")
+
+	unreadableConfigMapError = `failed to unmarshal brokers and triggers: '{"hello"-- "world"}' - invalid character '-' after object key`
+
+	authSecretData = map[string][]byte{
+		security.ProtocolKey:      []byte(security.ProtocolSASLPlaintext),
+		security.SaslMechanismKey: []byte(security.SaslScramSha512),
+		security.SaslUserKey:      []byte("user"),
+		security.SaslPasswordKey:  []byte("password"),
+	}
+
+	authSecretNotFoundError = fmt.Errorf(
+		"failed to get secret %s/%s: secrets %q not found",
+		ConfigMapNamespace, authSecretName, authSecretName,
+	)
+
+	invalidTopicDeletionPolicyErrMsg = fmt.Sprintf(
+		"unknown topic deletion policy Unknown - supported policies: %s, %s, %s",
+		TopicDeletionPolicyDelete, TopicDeletionPolicyRetain, TopicDeletionPolicyDeleteAfterGracePeriod,
+	)
+
+	unsupportedDeliveryErrMsg = fmt.Sprintf(
+		"unsupported delivery spec: unknown backoffPolicy random - supported policies: %s, %s",
+		eventingduck.BackoffPolicyExponential, eventingduck.BackoffPolicyLinear,
+	)
+
+	bootstrapServersChangedErrMsg = fmt.Sprintf(
+		"%v: the Broker uses the bootstrap servers kafka-old:9092, desired bootstrap servers %s - recreate the Broker to use different bootstrap servers",
+		ErrBootstrapServersChanged, bootstrapServers,
+	)
 )
 
 func TestBrokerReconciler(t *testing.T) {
@@ -91,6 +143,8 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 
 	configs.DataPlaneConfigFormat = format
 
+	retainedUntil := time.Now().Add(time.Hour)
+
 	table := TableTest{
 		{
 			Name: "Reconciled normal - no DLS",
@@ -142,7 +196,10 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
 						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
 						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
@@ -197,8 +254,11 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 						reconcilertesting.WithInitBrokerConditions,
 						ConfigMapUpdatedReady(&configs),
 						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
 						ConfigParsed,
 						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
@@ -240,28 +300,26 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			},
 		},
 		{
-			Name: "Config map not found - create config map",
+			Name: "Reconciled normal - topic drift",
 			Objects: []runtime.Object{
-				NewBroker(
-					WithDelivery(),
-				),
-				NewService(),
-				NewReceiverPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
-				NewDispatcherPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
-				&corev1.ConfigMap{
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace: configs.DataPlaneConfigMapNamespace,
-						Name:      configs.DataPlaneConfigMapName + "a", // Use a different name
-					},
-				},
+				NewBroker(),
+				NewConfigMap(&configs, nil),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
 			},
 			Key: testKey,
 			WantEvents: []string{
 				finalizerUpdatedEvent,
-			},
-			SkipNamespaceValidation: true, // WantCreates compare the broker namespace with configmap namespace, so skip it
-			WantCreates: []runtime.Object{
-				NewConfigMap(&configs, nil),
+				Eventf(
+					corev1.EventTypeWarning,
+					"TopicDriftDetected",
+					"%v: partition 0 of topic %s has replication factor %d, desired %d",
+					ErrTopicDrift, GetTopic(), DefaultReplicationFactor+2, DefaultReplicationFactor,
+				),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{
 				ConfigMapUpdate(&configs, &coreconfig.Brokers{
@@ -269,7 +327,6 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 						{
 							Id:               BrokerUUID,
 							Topic:            GetTopic(),
-							DeadLetterSink:   "http://test-service.test-service-namespace.svc.cluster.local/",
 							Path:             Path(BrokerNamespace, BrokerName),
 							BootstrapServers: bootstrapServers,
 						},
@@ -289,28 +346,36 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
-						WithDelivery(),
-						ConfigParsed,
 						reconcilertesting.WithInitBrokerConditions,
 						ConfigMapUpdatedReady(&configs),
-						TopicReady,
+						ConfigParsed,
+						TopicDrift(DefaultReplicationFactor+2, DefaultReplicationFactor),
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
 						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       topicAlreadyExistsError,
+				topicMetadata:                TopicMetadata(GetTopic(), DefaultNumPartitions, DefaultReplicationFactor+2),
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Reconciled normal - config map not readable",
+			Name: "Reconciled normal - topic drift already reported",
 			Objects: []runtime.Object{
-				NewBroker(),
-				NewConfigMap(&configs, []byte(`{"hello": "world"}`)),
-				NewService(),
-				NewReceiverPod(configs.SystemNamespace, nil),
+				NewBroker(
+					TopicDrift(DefaultReplicationFactor+2, DefaultReplicationFactor),
+					ManagedTopicStatus(GetTopic()),
+				),
+				NewConfigMap(&configs, nil),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
 				NewDispatcherPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
+					base.VolumeGenerationAnnotationKey: "1",
 				}),
 			},
 			Key: testKey,
@@ -345,40 +410,32 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 						reconcilertesting.WithInitBrokerConditions,
 						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
-						TopicReady,
+						TopicDrift(DefaultReplicationFactor+2, DefaultReplicationFactor),
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
 						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       topicAlreadyExistsError,
+				topicMetadata:                TopicMetadata(GetTopic(), DefaultNumPartitions, DefaultReplicationFactor+2),
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Reconciled normal - preserve config map previous state",
+			Name: "Reconciled normal - external topic",
 			Objects: []runtime.Object{
-				NewBroker(),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44a",
-							Topic:          "my-existing-topic-b",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-					},
-				}, &configs),
-				NewService(),
+				NewBroker(
+					WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
+				),
+				NewConfigMap(&configs, nil),
 				NewReceiverPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
+					base.VolumeGenerationAnnotationKey: "1",
 				}),
 				NewDispatcherPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
+					base.VolumeGenerationAnnotationKey: "1",
 				}),
 			},
 			Key: testKey,
@@ -388,20 +445,9 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantUpdates: []clientgotesting.UpdateActionImpl{
 				ConfigMapUpdate(&configs, &coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44a",
-							Topic:          "my-existing-topic-b",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
 						{
 							Id:               BrokerUUID,
-							Topic:            GetTopic(),
+							Topic:            externalTopic,
 							Path:             Path(BrokerNamespace, BrokerName),
 							BootstrapServers: bootstrapServers,
 						},
@@ -421,84 +467,42 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
+						WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
 						reconcilertesting.WithInitBrokerConditions,
 						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
-						TopicReady,
+						ExternalTopicReady(externalTopic),
+						ExternalTopicStatus(externalTopic),
+						BootstrapServersStatus(bootstrapServers),
 						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
+				expectedTopicName:            externalTopic,
+				topicMetadata:                TopicMetadata(externalTopic, DefaultNumPartitions, DefaultReplicationFactor),
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Reconciled normal - update existing broker while preserving others",
+			Name: "External topic not found",
 			Objects: []runtime.Object{
 				NewBroker(
-					func(broker *eventing.Broker) {
-						broker.Spec.Delivery = &eventingduck.DeliverySpec{
-							DeadLetterSink: &duckv1.Destination{
-								URI: &apis.URL{
-									Scheme: "http",
-									Host:   "www.my-sink.com",
-									Path:   "/api",
-								},
-							},
-						}
-					},
+					WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
 				),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-						{
-							Id:             BrokerUUID,
-							Topic:          GetTopic(),
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-					},
-				}, &configs),
-				NewService(),
-				NewReceiverPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
-				NewDispatcherPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
 			},
-			Key: testKey,
+			Key:     testKey,
+			WantErr: true,
 			WantEvents: []string{
 				finalizerUpdatedEvent,
-			},
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-						{
-							Id:               BrokerUUID,
-							Topic:            GetTopic(),
-							DeadLetterSink:   "http://www.my-sink.com/api",
-							Path:             Path(BrokerNamespace, BrokerName),
-							BootstrapServers: bootstrapServers,
-						},
-					},
-					VolumeGeneration: 1,
-				}),
-				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "1",
-				}),
-				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "1",
-				}),
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to get external topic: %s: failed to describe topic %s: %v",
+					externalTopic, externalTopic, sarama.ErrUnknownTopicOrPartition,
+				),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
@@ -506,88 +510,39 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
-						func(broker *eventing.Broker) {
-							broker.Spec.Delivery = &eventingduck.DeliverySpec{
-								DeadLetterSink: &duckv1.Destination{
-									URI: func() *apis.URL {
-										URL, _ := url.Parse("http://www.my-sink.com/api")
-										return (*apis.URL)(URL)
-									}(),
-								},
-							}
-						},
+						WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
 						reconcilertesting.WithInitBrokerConditions,
-						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
-						TopicReady,
-						Addressable(&configs),
+						FailedToGetExternalTopic(externalTopic, fmt.Errorf("failed to describe topic %s: %w", externalTopic, sarama.ErrUnknownTopicOrPartition)),
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				expectedTopicName: externalTopic,
+				topicMetadata: &sarama.TopicMetadata{
+					Name: externalTopic,
+					Err:  sarama.ErrUnknownTopicOrPartition,
+				},
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Reconciled normal - remove existing broker DLS while preserving others",
+			Name: "External topic rejected - managed topic prefix",
 			Objects: []runtime.Object{
 				NewBroker(
-					func(broker *eventing.Broker) {
-						broker.Spec.Delivery = &eventingduck.DeliverySpec{}
-					},
+					WithBrokerAnnotation(ExternalTopicAnnotation, TopicPrefix+"other-namespace-other"),
 				),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-						{
-							Id:             BrokerUUID,
-							Topic:          GetTopic(),
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-					},
-				}, &configs),
-				NewService(),
-				NewReceiverPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
-				NewDispatcherPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
 			},
-			Key: testKey,
+			Key:     testKey,
+			WantErr: true,
 			WantEvents: []string{
 				finalizerUpdatedEvent,
-			},
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-						{
-							Id:               BrokerUUID,
-							Topic:            GetTopic(),
-							Path:             Path(BrokerNamespace, BrokerName),
-							BootstrapServers: bootstrapServers,
-						},
-					},
-					VolumeGeneration: 1,
-				}),
-				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "1",
-				}),
-				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "1",
-				}),
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"%v: topics with prefix %s are reserved to managed topics",
+					ErrExternalTopicRejected, TopicPrefix,
+				),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
@@ -595,77 +550,44 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
-						func(broker *eventing.Broker) {
-							broker.Spec.Delivery = &eventingduck.DeliverySpec{}
-						},
+						WithBrokerAnnotation(ExternalTopicAnnotation, TopicPrefix+"other-namespace-other"),
 						reconcilertesting.WithInitBrokerConditions,
-						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
-						TopicReady,
-						Addressable(&configs),
+						ExternalTopicRejected(
+							TopicPrefix+"other-namespace-other",
+							fmt.Errorf("%w: topics with prefix %s are reserved to managed topics", ErrExternalTopicRejected, TopicPrefix),
+						),
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Reconciled normal - increment volume generation",
+			Name: "External topic rejected - used by another Broker",
 			Objects: []runtime.Object{
-				NewBroker(),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-						{
-							Id:    BrokerUUID,
-							Topic: GetTopic(),
-							Path:  Path(BrokerNamespace, BrokerName),
-						},
-					},
-					VolumeGeneration: 1,
-				}, &configs),
-				NewService(),
-				NewReceiverPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
-				NewDispatcherPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
+				NewBroker(
+					WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
+				),
+				reconcilertesting.NewBroker(
+					"other",
+					"other-namespace",
+					reconcilertesting.WithBrokerClass(kafka.BrokerClass),
+					ExternalTopicStatus(externalTopic),
+				),
 			},
-			Key: testKey,
+			Key:     testKey,
+			WantErr: true,
 			WantEvents: []string{
 				finalizerUpdatedEvent,
-			},
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-						{
-							Id:               BrokerUUID,
-							Topic:            GetTopic(),
-							Path:             Path(BrokerNamespace, BrokerName),
-							BootstrapServers: bootstrapServers,
-						},
-					},
-					VolumeGeneration: 2,
-				}),
-				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
-				}),
-				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
-				}),
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"%v: topic %s is used by Broker other-namespace/other",
+					ErrExternalTopicRejected, externalTopic,
+				),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
@@ -673,51 +595,28 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
+						WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
 						reconcilertesting.WithInitBrokerConditions,
-						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
-						TopicReady,
-						Addressable(&configs),
+						ExternalTopicRejected(
+							externalTopic,
+							fmt.Errorf("%w: topic %s is used by Broker other-namespace/other", ErrExternalTopicRejected, externalTopic),
+						),
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Failed to resolve DLS",
+			Name: "Topic change rejected - external topic annotation added",
 			Objects: []runtime.Object{
 				NewBroker(
-					func(broker *eventing.Broker) {
-						broker.Spec.Delivery = &eventingduck.DeliverySpec{
-							DeadLetterSink: &duckv1.Destination{},
-						}
-					},
+					WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
+					ManagedTopicStatus(GetTopic()),
 				),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-							Path:           Path(BrokerNamespace, BrokerName),
-						},
-						{
-							Id:               BrokerUUID,
-							Topic:            GetTopic(),
-							Path:             Path(BrokerNamespace, BrokerName),
-							BootstrapServers: bootstrapServers,
-						},
-					},
-					VolumeGeneration: 1,
-				}, &configs),
-				NewReceiverPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
-				NewDispatcherPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "5",
-				}),
 			},
 			Key:     testKey,
 			WantErr: true,
@@ -726,8 +625,8 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 				Eventf(
 					corev1.EventTypeWarning,
 					"InternalError",
-					"failed to get broker configuration: failed to resolve broker.Spec.Deliver.DeadLetterSink: %v",
-					"destination missing Ref and URI, expected at least one",
+					"%v: the Broker uses the topic %s, desired topic %s (annotation %s) - recreate the Broker to use a different topic",
+					ErrTopicChanged, GetTopic(), externalTopic, ExternalTopicAnnotation,
 				),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
@@ -736,29 +635,25 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
-						func(broker *eventing.Broker) {
-							broker.Spec.Delivery = &eventingduck.DeliverySpec{
-								DeadLetterSink: &duckv1.Destination{},
-							}
-						},
+						WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
+						ManagedTopicStatus(GetTopic()),
 						reconcilertesting.WithInitBrokerConditions,
 						ConfigParsed,
-						TopicReady,
+						TopicChangeRejected(GetTopic(), externalTopic),
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "No bootstrap.servers provided",
+			Name: "Topic change rejected - external topic annotation removed",
 			Objects: []runtime.Object{
-				NewBroker(),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers:          []*coreconfig.Broker{},
-					VolumeGeneration: 1,
-				}, &configs),
+				NewBroker(
+					ExternalTopicStatus(externalTopic),
+				),
 			},
 			Key:     testKey,
 			WantErr: true,
@@ -767,7 +662,8 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 				Eventf(
 					corev1.EventTypeWarning,
 					"InternalError",
-					"failed to get broker configuration: no bootstrap.servers provided",
+					"%v: the Broker uses the topic %s, desired topic %s (annotation %s) - recreate the Broker to use a different topic",
+					ErrTopicChanged, externalTopic, GetTopic(), ExternalTopicAnnotation,
 				),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
@@ -776,35 +672,39 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
+						ExternalTopicStatus(externalTopic),
 						reconcilertesting.WithInitBrokerConditions,
-						ConfigNotParsed("no bootstrap.servers provided"),
+						ConfigParsed,
+						TopicChangeRejected(externalTopic, GetTopic()),
 					),
 				},
 			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
 		},
 		{
-			Name: "Reconciled normal - with broker config",
+			Name: "Reconciled normal - topic has more partitions than desired",
 			Objects: []runtime.Object{
-				NewBroker(
-					WithBrokerConfig(
-						KReference(BrokerConfig(bootstrapServers, 20, 5)),
-					),
-				),
-				BrokerConfig(bootstrapServers, 20, 5),
+				NewBroker(),
 				NewConfigMap(&configs, nil),
-				NewService(),
 				NewReceiverPod(configs.SystemNamespace, map[string]string{
 					base.VolumeGenerationAnnotationKey: "1",
-					"annotation_to_preserve":           "value_to_preserve",
 				}),
 				NewDispatcherPod(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
-					"annotation_to_preserve":           "value_to_preserve",
+					base.VolumeGenerationAnnotationKey: "1",
 				}),
 			},
 			Key: testKey,
 			WantEvents: []string{
 				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					PartitionsDecreaseUnsupportedReason,
+					"%v: topic %s has %d partitions, desired %d",
+					ErrDecreasePartitions, GetTopic(), DefaultNumPartitions+1, DefaultNumPartitions,
+				),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{
 				ConfigMapUpdate(&configs, &coreconfig.Brokers{
@@ -820,11 +720,9 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 				}),
 				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
 					base.VolumeGenerationAnnotationKey: "1",
-					"annotation_to_preserve":           "value_to_preserve",
 				}),
 				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
 					base.VolumeGenerationAnnotationKey: "1",
-					"annotation_to_preserve":           "value_to_preserve",
 				}),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
@@ -833,43 +731,66 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
-						WithBrokerConfig(
-							KReference(BrokerConfig(bootstrapServers, 20, 5)),
-						),
 						reconcilertesting.WithInitBrokerConditions,
 						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
-						TopicReady,
+						TopicPartitionsDecreaseUnsupported(DefaultNumPartitions+1, DefaultNumPartitions),
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
 						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       topicAlreadyExistsError,
+				topicMetadata:                TopicMetadata(GetTopic(), DefaultNumPartitions+1, DefaultReplicationFactor),
 				BootstrapServersConfigMapKey: bootstrapServers,
-				ExpectedTopicDetail: sarama.TopicDetail{
-					NumPartitions:     20,
-					ReplicationFactor: 5,
-				},
 			},
 		},
 		{
-			Name: "Failed to parse broker config - not found",
+			Name: "Config map not found - create config map",
 			Objects: []runtime.Object{
 				NewBroker(
-					WithBrokerConfig(
-						KReference(BrokerConfig(bootstrapServers, 20, 5)),
-					),
+					WithDelivery(),
 				),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: configs.DataPlaneConfigMapNamespace,
+						Name:      configs.DataPlaneConfigMapName + "a", // Use a different name
+					},
+				},
 			},
-			Key:     testKey,
-			WantErr: true,
+			Key: testKey,
 			WantEvents: []string{
 				finalizerUpdatedEvent,
-				Eventf(
-					corev1.EventTypeWarning,
-					"InternalError",
-					fmt.Sprintf(`failed to get broker configuration: failed to get configmap %s/%s: configmap %q not found`, ConfigMapNamespace, ConfigMapName, ConfigMapName),
-				),
+			},
+			SkipNamespaceValidation: true, // WantCreates compare the broker namespace with configmap namespace, so skip it
+			WantCreates: []runtime.Object{
+				NewConfigMap(&configs, nil),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							DeadLetterSink:   "http://test-service.test-service-namespace.svc.cluster.local/",
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
@@ -877,11 +798,15 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
-						WithBrokerConfig(
-							KReference(BrokerConfig(bootstrapServers, 20, 5)),
-						),
+						WithDelivery(),
+						ConfigParsed,
 						reconcilertesting.WithInitBrokerConditions,
-						ConfigNotParsed(fmt.Sprintf(`failed to get configmap %s/%s: configmap %q not found`, ConfigMapNamespace, ConfigMapName, ConfigMapName)),
+						ConfigMapUpdatedReady(&configs),
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
@@ -890,36 +815,38 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			},
 		},
 		{
-			Name: "Unsupported Kind as config",
+			Name: "Reconciled normal - config map with unknown fields",
 			Objects: []runtime.Object{
-				NewBroker(
-					WithBrokerConfig(&duckv1.KReference{
-						Kind:       "Pod",
-						Namespace:  BrokerNamespace,
-						Name:       BrokerName,
-						APIVersion: "v1",
-					}),
-				),
-				&corev1.Pod{
-					TypeMeta: metav1.TypeMeta{
-						Kind:       "Pod",
-						APIVersion: "v1",
-					},
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace: BrokerNamespace,
-						Name:      BrokerName,
-					},
-				},
+				NewBroker(),
+				NewConfigMap(&configs, []byte(`{"hello": "world"}`)),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, nil),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+				}),
 			},
-			Key:     testKey,
-			WantErr: true,
+			Key: testKey,
 			WantEvents: []string{
 				finalizerUpdatedEvent,
-				Eventf(
-					corev1.EventTypeWarning,
-					"InternalError",
-					fmt.Sprintf(`failed to get broker configuration: supported config Kind: ConfigMap - got Pod`),
-				),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
@@ -927,14 +854,14 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: NewBroker(
-						WithBrokerConfig(&duckv1.KReference{
-							Kind:       "Pod",
-							Namespace:  BrokerNamespace,
-							Name:       BrokerName,
-							APIVersion: "v1",
-						}),
 						reconcilertesting.WithInitBrokerConditions,
-						ConfigNotParsed(`supported config Kind: ConfigMap - got Pod`),
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
@@ -943,46 +870,71 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 			},
 		},
 		{
-			Name: "Reconciled normal - keep all existing triggers",
+			Name: "Config map not readable",
 			Objects: []runtime.Object{
 				NewBroker(),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             BrokerUUID,
-							Topic:          GetTopic(),
-							DeadLetterSink: "http://example.com",
-							Triggers: []*coreconfig.Trigger{
-								{
-									Attributes: map[string]string{
-										"source": "source1",
-									},
-									Destination: "http://example.com",
-									Id:          TriggerUUID,
-								},
-								{
-									Attributes: map[string]string{
-										"source": "source1",
-									},
-									Destination: "http://example.com",
-									Id:          TriggerUUID + "a",
-								},
-								{
-									Attributes: map[string]string{
-										"source": "source1",
-									},
-									Destination: "http://example.com",
-									Id:          TriggerUUID + "b",
-								},
-							},
-							Path: Path(BrokerNamespace, BrokerName),
+				NewConfigMap(&configs, []byte(`{"hello"-- "world"}`)),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, nil),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to get broker and triggers data from config map %s: %s",
+					configs.DataPlaneConfigMapAsString(),
+					unreadableConfigMapError,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						reconcilertesting.WithInitBrokerConditions,
+						FailedToGetConfigMapData(&configs, unreadableConfigMapError),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - preserve config map previous state",
+			Objects: []runtime.Object{
+				NewBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44a",
+							Topic:          "my-existing-topic-b",
+							DeadLetterSink: "http://www.my-sink.com",
 						},
 					},
-					VolumeGeneration: 1,
 				}, &configs),
 				NewService(),
-				NewReceiverPod(configs.SystemNamespace, nil),
-				NewDispatcherPod(configs.SystemNamespace, nil),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+				}),
 			},
 			Key: testKey,
 			WantEvents: []string{
@@ -992,42 +944,30 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 				ConfigMapUpdate(&configs, &coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
 						{
-							Id:    BrokerUUID,
-							Topic: GetTopic(),
-							Triggers: []*coreconfig.Trigger{
-								{
-									Attributes: map[string]string{
-										"source": "source1",
-									},
-									Destination: "http://example.com",
-									Id:          TriggerUUID,
-								},
-								{
-									Attributes: map[string]string{
-										"source": "source1",
-									},
-									Destination: "http://example.com",
-									Id:          TriggerUUID + "a",
-								},
-								{
-									Attributes: map[string]string{
-										"source": "source1",
-									},
-									Destination: "http://example.com",
-									Id:          TriggerUUID + "b",
-								},
-							},
-							BootstrapServers: bootstrapServers,
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44a",
+							Topic:          "my-existing-topic-b",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
 							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
 						},
 					},
-					VolumeGeneration: 2,
+					VolumeGeneration: 1,
 				}),
 				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
+					base.VolumeGenerationAnnotationKey: "1",
 				}),
 				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "2",
+					base.VolumeGenerationAnnotationKey: "1",
 				}),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
@@ -1040,7 +980,10 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 						ConfigMapUpdatedReady(&configs),
 						ConfigParsed,
 						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
 						Addressable(&configs),
+						NoDataPlanePods,
 					),
 				},
 			},
@@ -1048,69 +991,1742 @@ func brokerReconciliation(t *testing.T, format string, configs Configs) {
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
-	}
-
-	for i := range table {
-		table[i].Name = table[i].Name + " - " + format
-	}
-
-	useTable(t, table, &configs)
-}
-
-func TestBrokerFinalizer(t *testing.T) {
-	t.Parallel()
-
-	for _, f := range Formats {
-		brokerFinalization(t, f, *DefaultConfigs)
-	}
-}
-
-func brokerFinalization(t *testing.T, format string, configs Configs) {
-
-	testKey := fmt.Sprintf("%s/%s", BrokerNamespace, BrokerName)
-
-	configs.DataPlaneConfigFormat = format
-
-	table := TableTest{
 		{
-			Name: "Reconciled normal - no DLS",
+			Name: "Reconciled normal - update existing broker while preserving others",
 			Objects: []runtime.Object{
-				NewDeletedBroker(),
+				NewBroker(
+					func(broker *eventing.Broker) {
+						broker.Spec.Delivery = &eventingduck.DeliverySpec{
+							DeadLetterSink: &duckv1.Destination{
+								URI: &apis.URL{
+									Scheme: "http",
+									Host:   "www.my-sink.com",
+									Path:   "/api",
+								},
+							},
+						}
+					},
+				),
 				NewConfigMapFromBrokers(&coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
 						{
-							Id:    BrokerUUID,
-							Topic: GetTopic(),
-							Path:  Path(BrokerNamespace, BrokerName),
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+						{
+							Id:             BrokerUUID,
+							Topic:          GetTopic(),
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+					},
+				}, &configs),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							DeadLetterSink:   "http://www.my-sink.com/api",
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						func(broker *eventing.Broker) {
+							broker.Spec.Delivery = &eventingduck.DeliverySpec{
+								DeadLetterSink: &duckv1.Destination{
+									URI: func() *apis.URL {
+										URL, _ := url.Parse("http://www.my-sink.com/api")
+										return (*apis.URL)(URL)
+									}(),
+								},
+							}
 						},
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - remove existing broker DLS while preserving others",
+			Objects: []runtime.Object{
+				NewBroker(
+					func(broker *eventing.Broker) {
+						broker.Spec.Delivery = &eventingduck.DeliverySpec{}
 					},
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+						{
+							Id:             BrokerUUID,
+							Topic:          GetTopic(),
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						func(broker *eventing.Broker) {
+							broker.Spec.Delivery = &eventingduck.DeliverySpec{}
+						},
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - waiting for receiver pods",
+			Objects: []runtime.Object{
+				NewBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}, &configs),
+				NewService(),
+				NewRunningReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 2,
+				}),
+				RunningReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "2",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						DataPlaneNotReady(2, 0, 1),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - receiver pods applied the broker",
+			Objects: []runtime.Object{
+				NewBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 2,
+				}, &configs),
+				NewService(),
+				NewRunningReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "2",
+					base.VolumeGenerationAckAnnotationKey: "2",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						DataPlaneReady,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - increment volume generation",
+			Objects: []runtime.Object{
+				NewBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  Path(BrokerNamespace, BrokerName),
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 2,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Failed to resolve DLS",
+			Objects: []runtime.Object{
+				NewBroker(
+					func(broker *eventing.Broker) {
+						broker.Spec.Delivery = &eventingduck.DeliverySpec{
+							DeadLetterSink: &duckv1.Destination{},
+						}
+					},
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "5",
+				}),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to get broker configuration: failed to resolve broker.Spec.Deliver.DeadLetterSink: %v",
+					"destination missing Ref and URI, expected at least one",
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						func(broker *eventing.Broker) {
+							broker.Spec.Delivery = &eventingduck.DeliverySpec{
+								DeadLetterSink: &duckv1.Destination{},
+							}
+						},
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "No bootstrap.servers provided",
+			Objects: []runtime.Object{
+				NewBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers:          []*coreconfig.Broker{},
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to get broker configuration: no bootstrap.servers provided",
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigNotParsed("no bootstrap.servers provided"),
+					),
+				},
+			},
+		},
+		{
+			Name: "Invalid topic deletion policy",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerAnnotation(TopicDeletionPolicyAnnotation, "Unknown"),
+				),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to get broker configuration: %s",
+					invalidTopicDeletionPolicyErrMsg,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerAnnotation(TopicDeletionPolicyAnnotation, "Unknown"),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigNotParsed(invalidTopicDeletionPolicyErrMsg),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - with delivery retry and backoff",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithDelivery(),
+					WithRetry(3, eventingduck.BackoffPolicyExponential, "PT0.2S"),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}, &configs),
+				NewService(),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							DeadLetterSink:   "http://test-service.test-service-namespace.svc.cluster.local/",
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+							EgressConfig: &coreconfig.EgressConfig{
+								Retry:         3,
+								BackoffPolicy: coreconfig.BackoffPolicy_Exponential,
+								BackoffDelay:  200,
+							},
+						},
+					},
+					VolumeGeneration: 2,
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithDelivery(),
+						WithRetry(3, eventingduck.BackoffPolicyExponential, "PT0.2S"),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						ConfigParsed,
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Unsupported delivery spec",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithRetry(3, "random", "PT1S"),
+				),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					unsupportedDeliveryErrMsg,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithRetry(3, "random", "PT1S"),
+						reconcilertesting.WithInitBrokerConditions,
+						UnsupportedDelivery(unsupportedDeliveryErrMsg),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Bootstrap servers changed",
+			Objects: []runtime.Object{
+				NewBroker(
+					BootstrapServersStatus("kafka-old:9092"),
+				),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					bootstrapServersChangedErrMsg,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						BootstrapServersStatus("kafka-old:9092"),
+						reconcilertesting.WithInitBrokerConditions,
+						BootstrapServersChangeRejected(bootstrapServersChangedErrMsg),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnCreateTopic:       createTopicError, // fail if the topic is created
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - release retained topic",
+			Objects: []runtime.Object{
+				NewBroker(),
+				NewConfigMap(&configs, nil),
+				RetainedTopicsConfigMap(&configs, map[string]string{
+					GetTopic(): RetainedTopic(bootstrapServers, retainedUntil),
+					"topic-1":  RetainedTopic(bootstrapServers, retainedUntil),
+				}),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				RetainedTopicsConfigMapUpdate(&configs, map[string]string{
+					"topic-1": RetainedTopic(bootstrapServers, retainedUntil),
+				}),
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - with broker config",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(
+						KReference(BrokerConfig(bootstrapServers, 20, 5)),
+					),
+				),
+				BrokerConfig(bootstrapServers, 20, 5),
+				NewConfigMap(&configs, nil),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+					"annotation_to_preserve":           "value_to_preserve",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+					"annotation_to_preserve":           "value_to_preserve",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+					"annotation_to_preserve":           "value_to_preserve",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+					"annotation_to_preserve":           "value_to_preserve",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(
+							KReference(BrokerConfig(bootstrapServers, 20, 5)),
+						),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     20,
+					ReplicationFactor: 5,
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - with broker config and topic config",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(
+						KReference(BrokerConfig(bootstrapServers, 20, 5)),
+					),
+				),
+				BrokerConfig(bootstrapServers, 20, 5,
+					WithTopicConfig("retention.ms", "86400000"),
+					WithTopicConfig("cleanup.policy", "compact"),
+				),
+				NewConfigMap(&configs, nil),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(
+							KReference(BrokerConfig(bootstrapServers, 20, 5)),
+						),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     20,
+					ReplicationFactor: 5,
+					ConfigEntries: map[string]*string{
+						"retention.ms":   pointer.StringPtr("86400000"),
+						"cleanup.policy": pointer.StringPtr("compact"),
+					},
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - with broker config and auth secret",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(
+						KReference(BrokerConfig(bootstrapServers, 20, 5)),
+					),
+				),
+				BrokerConfig(bootstrapServers, 20, 5, WithAuthSecret(authSecretName)),
+				AuthSecret(authSecretName, authSecretData),
+				NewConfigMap(&configs, nil),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			SkipNamespaceValidation: true, // WantCreates compare the broker namespace with the system namespace, so skip it
+			WantCreates: []runtime.Object{
+				AuthSecretCopy(&configs, authSecretData),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+							AuthSecret:       AuthSecretCopyReference(&configs, authSecretData),
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(
+							KReference(BrokerConfig(bootstrapServers, 20, 5)),
+						),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						AuthSecretStatus,
+						AuthSecretRefStatus(ConfigMapNamespace, authSecretName),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     20,
+					ReplicationFactor: 5,
+				},
+				expectedSASLUser: "user",
+			},
+		},
+		{
+			Name: "Reconciled normal - with broker config and rotated auth secret",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(
+						KReference(BrokerConfig(bootstrapServers, 20, 5)),
+					),
+				),
+				BrokerConfig(bootstrapServers, 20, 5, WithAuthSecret(authSecretName)),
+				AuthSecret(authSecretName, authSecretData),
+				AuthSecretCopy(&configs, map[string][]byte{
+					security.ProtocolKey:      []byte(security.ProtocolSASLPlaintext),
+					security.SaslMechanismKey: []byte(security.SaslScramSha512),
+					security.SaslUserKey:      []byte("user"),
+					security.SaslPasswordKey:  []byte("old-password"),
+				}),
+				NewConfigMap(&configs, nil),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{Object: AuthSecretCopy(&configs, authSecretData)},
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+							AuthSecret:       AuthSecretCopyReference(&configs, authSecretData),
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(
+							KReference(BrokerConfig(bootstrapServers, 20, 5)),
+						),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						AuthSecretStatus,
+						AuthSecretRefStatus(ConfigMapNamespace, authSecretName),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     20,
+					ReplicationFactor: 5,
+				},
+				expectedSASLUser: "user",
+			},
+		},
+		{
+			Name: "Reconciled normal - with broker config without auth secret and default auth secret",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(
+						KReference(BrokerConfig(bootstrapServers, 20, 5)),
+					),
+				),
+				BrokerConfig(bootstrapServers, 20, 5),
+				NewConfigMap(&configs, nil),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             Path(BrokerNamespace, BrokerName),
+							BootstrapServers: bootstrapServers,
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(
+							KReference(BrokerConfig(bootstrapServers, 20, 5)),
+						),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     20,
+					ReplicationFactor: 5,
+				},
+				defaultAuthSecret: authSecretName,
+			},
+		},
+		{
+			Name: "Failed to create topic - auth secret not found",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(
+						KReference(BrokerConfig(bootstrapServers, 20, 5)),
+					),
+				),
+				BrokerConfig(bootstrapServers, 20, 5, WithAuthSecret(authSecretName)),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to create topic: %s: %v",
+					GetTopic(), authSecretNotFoundError,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(
+							KReference(BrokerConfig(bootstrapServers, 20, 5)),
+						),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigParsed,
+						FailedToCreateTopicWithError(authSecretNotFoundError),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Failed to parse broker config - not found",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(
+						KReference(BrokerConfig(bootstrapServers, 20, 5)),
+					),
+				),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					fmt.Sprintf(`failed to get broker configuration: failed to get configmap %s/%s: configmap %q not found`, ConfigMapNamespace, ConfigMapName, ConfigMapName),
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(
+							KReference(BrokerConfig(bootstrapServers, 20, 5)),
+						),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigNotParsed(fmt.Sprintf(`failed to get configmap %s/%s: configmap %q not found`, ConfigMapNamespace, ConfigMapName, ConfigMapName)),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Unsupported Kind as config",
+			Objects: []runtime.Object{
+				NewBroker(
+					WithBrokerConfig(&duckv1.KReference{
+						Kind:       "Pod",
+						Namespace:  BrokerNamespace,
+						Name:       BrokerName,
+						APIVersion: "v1",
+					}),
+				),
+				&corev1.Pod{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Pod",
+						APIVersion: "v1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: BrokerNamespace,
+						Name:      BrokerName,
+					},
+				},
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					fmt.Sprintf(`failed to get broker configuration: supported config Kind: ConfigMap - got Pod`),
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						WithBrokerConfig(&duckv1.KReference{
+							Kind:       "Pod",
+							Namespace:  BrokerNamespace,
+							Name:       BrokerName,
+							APIVersion: "v1",
+						}),
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigNotParsed(`supported config Kind: ConfigMap - got Pod`),
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - keep all existing triggers",
+			Objects: []runtime.Object{
+				NewBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             BrokerUUID,
+							Topic:          GetTopic(),
+							DeadLetterSink: "http://example.com",
+							Triggers: []*coreconfig.Trigger{
+								{
+									Attributes: map[string]string{
+										"source": "source1",
+									},
+									Destination: "http://example.com",
+									Id:          TriggerUUID,
+								},
+								{
+									Attributes: map[string]string{
+										"source": "source1",
+									},
+									Destination: "http://example.com",
+									Id:          TriggerUUID + "a",
+								},
+								{
+									Attributes: map[string]string{
+										"source": "source1",
+									},
+									Destination: "http://example.com",
+									Id:          TriggerUUID + "b",
+								},
+							},
+							Path: Path(BrokerNamespace, BrokerName),
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, nil),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Attributes: map[string]string{
+										"source": "source1",
+									},
+									Destination: "http://example.com",
+									Id:          TriggerUUID,
+								},
+								{
+									Attributes: map[string]string{
+										"source": "source1",
+									},
+									Destination: "http://example.com",
+									Id:          TriggerUUID + "a",
+								},
+								{
+									Attributes: map[string]string{
+										"source": "source1",
+									},
+									Destination: "http://example.com",
+									Id:          TriggerUUID + "b",
+								},
+							},
+							BootstrapServers: bootstrapServers,
+							Path:             Path(BrokerNamespace, BrokerName),
+						},
+					},
+					VolumeGeneration: 2,
+				}),
+				ReceiverPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "2",
+				}),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewBroker(
+						reconcilertesting.WithInitBrokerConditions,
+						ConfigMapUpdatedReady(&configs),
+						ConfigParsed,
+						TopicReady,
+						ManagedTopicStatus(GetTopic()),
+						BootstrapServersStatus(bootstrapServers),
+						Addressable(&configs),
+						NoDataPlanePods,
+					),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+	}
+
+	for i := range table {
+		table[i].Name = table[i].Name + " - " + format
+	}
+
+	useTable(t, table, &configs)
+}
+
+func TestBrokerFinalizer(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range Formats {
+		brokerFinalization(t, f, *DefaultConfigs)
+	}
+}
+
+func brokerFinalization(t *testing.T, format string, configs Configs) {
+
+	testKey := fmt.Sprintf("%s/%s", BrokerNamespace, BrokerName)
+
+	configs.DataPlaneConfigFormat = format
+
+	deletionTime := time.Now()
+
+	table := TableTest{
+		{
+			Name: "Reconciled normal - no DLS",
+			Objects: []runtime.Object{
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  Path(BrokerNamespace, BrokerName),
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers:          []*coreconfig.Broker{},
+					VolumeGeneration: 1,
+				}),
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - with auth secret",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					AuthSecretStatus,
+				),
+				AuthSecretCopy(&configs, authSecretData),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:         BrokerUUID,
+							Topic:      GetTopic(),
+							Path:       Path(BrokerNamespace, BrokerName),
+							AuthSecret: AuthSecretCopyReference(&configs, authSecretData),
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers:          []*coreconfig.Broker{},
+					VolumeGeneration: 1,
+				}),
+			},
+			SkipNamespaceValidation: true, // WantDeletes compare the broker namespace with the system namespace, so skip it
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				{
+					ActionImpl: clientgotesting.ActionImpl{
+						Namespace: configs.SystemNamespace,
+						Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+					},
+					Name: AuthSecretCopyName(BrokerUUID),
+				},
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - with DLS",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					WithDelivery(),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             BrokerUUID,
+							Topic:          GetTopic(),
+							DeadLetterSink: "http://test-service.test-service-namespace.svc.cluster.local/",
+							Path:           Path(BrokerNamespace, BrokerName),
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}),
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Failed to delete topic",
+			Objects: []runtime.Object{
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             BrokerUUID,
+							Topic:          GetTopic(),
+							DeadLetterSink: "http://test-service.test-service-namespace.svc.cluster.local/",
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to delete topic %s: %v - annotate the Broker with %s: %s to retain the topic %s and complete the Broker deletion",
+					GetTopic(), deleteTopicError, TopicDeletionPolicyAnnotation, TopicDeletionPolicyRetain, GetTopic(),
+				),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}),
+			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError,
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - config map deleted, use recorded config",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					WithBrokerConfig(&duckv1.KReference{
+						Kind:      "ConfigMap",
+						Namespace: ConfigMapNamespace,
+						Name:      "deleted",
+					}),
+					BootstrapServersStatus("kafka-3:9092"),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  Path(BrokerNamespace, BrokerName),
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers:          []*coreconfig.Broker{},
+					VolumeGeneration: 1,
+				}),
+			},
+			OtherTestData: map[string]interface{}{
+				expectedBootstrapServers: []string{"kafka-3:9092"},
+			},
+		},
+		{
+			Name: "Failed to resolve broker config - config map deleted, no recorded config",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					WithBrokerConfig(&duckv1.KReference{
+						Kind:      "ConfigMap",
+						Namespace: ConfigMapNamespace,
+						Name:      "deleted",
+					}),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to resolve broker config: failed to get configmap %s/deleted: configmap %q not found - annotate the Broker with %s: %s to retain the topic %s and complete the Broker deletion",
+					ConfigMapNamespace, "deleted", TopicDeletionPolicyAnnotation, TopicDeletionPolicyRetain, GetTopic(),
+				),
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - config map deleted, topic retained by annotation",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					WithBrokerConfig(&duckv1.KReference{
+						Kind:      "ConfigMap",
+						Namespace: ConfigMapNamespace,
+						Name:      "deleted",
+					}),
+					WithBrokerAnnotation(TopicDeletionPolicyAnnotation, string(TopicDeletionPolicyRetain)),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key: testKey,
+			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError,
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Config map not found - create config map",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					WithDelivery(),
+				),
+				NewService(),
+				NewReceiverPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
+				NewDispatcherPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
+			},
+			Key: testKey,
+			WantCreates: []runtime.Object{
+				NewConfigMap(&configs, nil),
+			},
+			SkipNamespaceValidation: true, // WantCreates compare the broker namespace with configmap namespace, so skip it
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Config map not readable",
+			Objects: []runtime.Object{
+				NewDeletedBroker(),
+				NewConfigMap(&configs, []byte(`{"hello"-- "world"}`)),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to get brokers and triggers: %s",
+					unreadableConfigMapError,
+				),
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - preserve config map previous state",
+			Objects: []runtime.Object{
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+						{
+							Id:             BrokerUUID,
+							Topic:          "my-existing-topic-b",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+					},
+					VolumeGeneration: 5,
+				}, &configs),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+					},
+					VolumeGeneration: 5,
+				}),
+			},
+			OtherTestData: map[string]interface{}{
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - topic doesn't exist",
+			Objects: []runtime.Object{
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+						{
+							Id:             BrokerUUID,
+							Topic:          "my-existing-topic-b",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+					},
+					VolumeGeneration: 5,
+				}, &configs),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
+							Topic:          "my-existing-topic-a",
+							DeadLetterSink: "http://www.my-sink.com",
+						},
+					},
+					VolumeGeneration: 5,
+				}),
+			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       sarama.ErrUnknownTopicOrPartition,
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - external topic not deleted",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: externalTopic,
+							Path:  Path(BrokerNamespace, BrokerName),
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}),
+			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail if the topic is deleted
+				expectedTopicName:            externalTopic,
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - managed topic deleted when the external topic annotation is added",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					WithBrokerAnnotation(ExternalTopicAnnotation, externalTopic),
+					ManagedTopicStatus(GetTopic()),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}, &configs),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to delete topic %s: %v - annotate the Broker with %s: %s to retain the topic %s and complete the Broker deletion",
+					GetTopic(), deleteTopicError, TopicDeletionPolicyAnnotation, TopicDeletionPolicyRetain, GetTopic(),
+				),
+			},
+			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail when the managed topic is deleted
+				BootstrapServersConfigMapKey: bootstrapServers,
+			},
+		},
+		{
+			Name: "Reconciled normal - recorded external topic not deleted when the annotation is removed",
+			Objects: []runtime.Object{
+				NewDeletedBroker(
+					ExternalTopicStatus(externalTopic),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
 					VolumeGeneration: 1,
 				}, &configs),
 			},
 			Key: testKey,
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
-					Brokers:          []*coreconfig.Broker{},
-					VolumeGeneration: 1,
-				}),
-			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail if the topic is deleted
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Reconciled normal - with DLS",
+			Name: "Reconciled normal - retain topic",
 			Objects: []runtime.Object{
 				NewDeletedBroker(
-					WithDelivery(),
+					WithBrokerAnnotation(TopicDeletionPolicyAnnotation, string(TopicDeletionPolicyRetain)),
 				),
 				NewConfigMapFromBrokers(&coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
 						{
-							Id:             BrokerUUID,
-							Topic:          GetTopic(),
-							DeadLetterSink: "http://test-service.test-service-namespace.svc.cluster.local/",
-							Path:           Path(BrokerNamespace, BrokerName),
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  Path(BrokerNamespace, BrokerName),
 						},
 					},
 					VolumeGeneration: 1,
@@ -1123,68 +2739,84 @@ func brokerFinalization(t *testing.T, format string, configs Configs) {
 				}),
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail if the topic is deleted
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Failed to delete topic",
+			Name: "Reconciled normal - retain topic for grace period",
 			Objects: []runtime.Object{
-				NewDeletedBroker(),
+				NewDeletedBroker(
+					WithBrokerAnnotation(TopicDeletionPolicyAnnotation, string(TopicDeletionPolicyDeleteAfterGracePeriod)),
+					WithBrokerAnnotation(TopicDeletionGracePeriodAnnotation, "1h"),
+					WithDeletionTime(deletionTime),
+				),
 				NewConfigMapFromBrokers(&coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
 						{
-							Id:             BrokerUUID,
-							Topic:          GetTopic(),
-							DeadLetterSink: "http://test-service.test-service-namespace.svc.cluster.local/",
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  Path(BrokerNamespace, BrokerName),
 						},
 					},
 					VolumeGeneration: 1,
 				}, &configs),
 			},
-			Key:     testKey,
-			WantErr: true,
-			WantEvents: []string{
-				Eventf(
-					corev1.EventTypeWarning,
-					"InternalError",
-					"failed to delete topic %s: %v",
-					GetTopic(), deleteTopicError,
-				),
+			Key: testKey,
+			WantCreates: []runtime.Object{
+				RetainedTopicsConfigMap(&configs, map[string]string{
+					GetTopic(): RetainedTopic(bootstrapServers, deletionTime.Add(time.Hour)),
+				}),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{
 				ConfigMapUpdate(&configs, &coreconfig.Brokers{
 					VolumeGeneration: 1,
 				}),
 			},
+			SkipNamespaceValidation: true, // WantCreates compare the broker namespace with configmap namespace, so skip it
 			OtherTestData: map[string]interface{}{
-				wantErrorOnDeleteTopic:       deleteTopicError,
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail if the topic is deleted
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Config map not found - create config map",
+			Name: "Reconciled normal - retain topic for grace period with existing retained topics",
 			Objects: []runtime.Object{
 				NewDeletedBroker(
-					WithDelivery(),
+					WithBrokerAnnotation(TopicDeletionPolicyAnnotation, string(TopicDeletionPolicyDeleteAfterGracePeriod)),
+					WithBrokerAnnotation(TopicDeletionGracePeriodAnnotation, "1h"),
+					WithDeletionTime(deletionTime),
 				),
-				NewService(),
-				NewReceiverPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
-				NewDispatcherPod(configs.SystemNamespace, map[string]string{base.VolumeGenerationAnnotationKey: "2"}),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}, &configs),
+				RetainedTopicsConfigMap(&configs, map[string]string{
+					"topic-1": RetainedTopic(bootstrapServers, deletionTime),
+				}),
 			},
 			Key: testKey,
-			WantCreates: []runtime.Object{
-				NewConfigMap(&configs, nil),
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				RetainedTopicsConfigMapUpdate(&configs, map[string]string{
+					"topic-1":  RetainedTopic(bootstrapServers, deletionTime),
+					GetTopic(): RetainedTopic(bootstrapServers, deletionTime.Add(time.Hour)),
+				}),
 			},
-			SkipNamespaceValidation: true, // WantCreates compare the broker namespace with configmap namespace, so skip it
 			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail if the topic is deleted
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Config map not readable",
+			Name: "Reconciled normal - delete topic after grace period",
 			Objects: []runtime.Object{
-				NewDeletedBroker(),
-				NewConfigMap(&configs, []byte(`{"hello"-- "world"}`)),
+				NewBroker(
+					WithBrokerAnnotation(TopicDeletionPolicyAnnotation, string(TopicDeletionPolicyDeleteAfterGracePeriod)),
+					WithBrokerAnnotation(TopicDeletionGracePeriodAnnotation, "1h"),
+					WithDeletionTime(time.Now().Add(-2*time.Hour)),
+				),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 1,
+				}, &configs),
 			},
 			Key:     testKey,
 			WantErr: true,
@@ -1192,86 +2824,36 @@ func brokerFinalization(t *testing.T, format string, configs Configs) {
 				Eventf(
 					corev1.EventTypeWarning,
 					"InternalError",
-					`failed to get brokers and triggers: failed to unmarshal brokers and triggers: '{"hello"-- "world"}' - %v`,
-					getUnmarshallableError(format),
+					"failed to delete topic %s: %v - annotate the Broker with %s: %s to retain the topic %s and complete the Broker deletion",
+					GetTopic(), deleteTopicError, TopicDeletionPolicyAnnotation, TopicDeletionPolicyRetain, GetTopic(),
 				),
 			},
 			OtherTestData: map[string]interface{}{
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail when the topic is deleted
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
 		{
-			Name: "Reconciled normal - preserve config map previous state",
-			Objects: []runtime.Object{
-				NewDeletedBroker(),
-				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-						{
-							Id:             BrokerUUID,
-							Topic:          "my-existing-topic-b",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-					},
-					VolumeGeneration: 5,
-				}, &configs),
-			},
-			Key: testKey,
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-					},
-					VolumeGeneration: 5,
-				}),
-			},
-			OtherTestData: map[string]interface{}{
-				BootstrapServersConfigMapKey: bootstrapServers,
-			},
-		},
-		{
-			Name: "Reconciled normal - topic doesn't exist",
+			Name: "Reconciled normal - invalid topic deletion policy retains topic",
 			Objects: []runtime.Object{
-				NewDeletedBroker(),
+				NewDeletedBroker(
+					WithBrokerAnnotation(TopicDeletionPolicyAnnotation, "Unknown"),
+				),
 				NewConfigMapFromBrokers(&coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-						{
-							Id:             BrokerUUID,
-							Topic:          "my-existing-topic-b",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-					},
-					VolumeGeneration: 5,
+					VolumeGeneration: 1,
 				}, &configs),
 			},
 			Key: testKey,
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:             "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b",
-							Topic:          "my-existing-topic-a",
-							DeadLetterSink: "http://www.my-sink.com",
-						},
-					},
-					VolumeGeneration: 5,
-				}),
+			WantEvents: []string{
+				Eventf(
+					corev1.EventTypeWarning,
+					"InvalidTopicDeletionPolicy",
+					"Topic %s retained: %s",
+					GetTopic(), invalidTopicDeletionPolicyErrMsg,
+				),
 			},
 			OtherTestData: map[string]interface{}{
-				wantErrorOnDeleteTopic:       sarama.ErrUnknownTopicOrPartition,
+				wantErrorOnDeleteTopic:       deleteTopicError, // fail if the topic is deleted
 				BootstrapServersConfigMapKey: bootstrapServers,
 			},
 		},
@@ -1335,6 +2917,26 @@ func useTable(t *testing.T, table TableTest, configs *Configs) {
 			expectedTopicDetail = td.(sarama.TopicDetail)
 		}
 
+		topicName := fmt.Sprintf("%s%s-%s", TopicPrefix, BrokerNamespace, BrokerName)
+		if tn, ok := row.OtherTestData[expectedTopicName]; ok {
+			topicName = tn.(string)
+		}
+
+		var topicsMetadata []*sarama.TopicMetadata
+		if tm, ok := row.OtherTestData[topicMetadata]; ok {
+			topicsMetadata = []*sarama.TopicMetadata{tm.(*sarama.TopicMetadata)}
+		}
+
+		saslUser := ""
+		if user, ok := row.OtherTestData[expectedSASLUser]; ok {
+			saslUser = user.(string)
+		}
+
+		var expectedAddrs []string
+		if bs, ok := row.OtherTestData[expectedBootstrapServers]; ok {
+			expectedAddrs = bs.([]string)
+		}
+
 		reconciler := &Reconciler{
 			Reconciler: &base.Reconciler{
 				KubeClient:                  kubeclient.Get(ctx),
@@ -1346,19 +2948,34 @@ func useTable(t *testing.T, table TableTest, configs *Configs) {
 			},
 			KafkaDefaultTopicDetails:     defaultTopicDetail,
 			KafkaDefaultTopicDetailsLock: sync.RWMutex{},
+			BrokerLister:                 listers.GetBrokerLister(),
 			ConfigMapLister:              listers.GetConfigMapLister(),
+			ConfigMapTracker:             &FakeTracker{},
 			NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+				assert.Equal(t, saslUser != "", config.Net.SASL.Enable)
+				assert.Equal(t, saslUser, config.Net.SASL.User)
+				if expectedAddrs != nil {
+					assert.Equal(t, expectedAddrs, addrs)
+				}
+
 				return &MockKafkaClusterAdmin{
-					ExpectedTopicName:   fmt.Sprintf("%s%s-%s", TopicPrefix, BrokerNamespace, BrokerName),
+					ExpectedTopicName:   topicName,
 					ExpectedTopicDetail: expectedTopicDetail,
 					ErrorOnCreateTopic:  onCreateTopicError,
 					ErrorOnDeleteTopic:  onDeleteTopicError,
+					TopicsMetadata:      topicsMetadata,
 					T:                   t,
 				}, nil
 			},
 			Configs: configs,
 		}
 		reconciler.SetBootstrapServers(bootstrapServers)
+		if name, ok := row.OtherTestData[defaultAuthSecret]; ok {
+			reconciler.SetDefaultAuthSecretRef(&corev1.SecretReference{
+				Namespace: configs.SystemNamespace,
+				Name:      name.(string),
+			})
+		}
 
 		r := brokerreconciler.NewReconciler(
 			ctx,
@@ -1419,6 +3036,258 @@ func TestConfigMapUpdate(t *testing.T) {
 	})
 }
 
+func TestConfigMapUpdateWithTopicConfig(t *testing.T) {
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cmname",
+			Namespace: "cmnamespace",
+		},
+		Data: map[string]string{
+			DefaultTopicNumPartitionConfigMapKey:                         "42",
+			DefaultTopicReplicationFactorConfigMapKey:                    "3",
+			BootstrapServersConfigMapKey:                                 "server1,server2",
+			DefaultTopicConfigConfigMapKeyPrefix + "retention.ms":        "86400000",
+			DefaultTopicConfigConfigMapKeyPrefix + "min.insync.replicas": "2",
+		},
+	}
+
+	reconciler := Reconciler{}
+
+	ctx, _ := SetupFakeContext(t)
+
+	reconciler.ConfigMapUpdated(ctx)(&cm)
+
+	assert.Equal(t, reconciler.KafkaDefaultTopicDetails, sarama.TopicDetail{
+		NumPartitions:     42,
+		ReplicationFactor: 3,
+		ConfigEntries: map[string]*string{
+			"retention.ms":        pointer.StringPtr("86400000"),
+			"min.insync.replicas": pointer.StringPtr("2"),
+		},
+	})
+}
+
+func TestConfigMapUpdateWithTopicDeletionPolicy(t *testing.T) {
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cmname",
+			Namespace: "cmnamespace",
+		},
+		Data: map[string]string{
+			DefaultTopicNumPartitionConfigMapKey:        "42",
+			DefaultTopicReplicationFactorConfigMapKey:   "3",
+			BootstrapServersConfigMapKey:                "server1,server2",
+			DefaultTopicDeletionPolicyConfigMapKey:      string(TopicDeletionPolicyDeleteAfterGracePeriod),
+			DefaultTopicDeletionGracePeriodConfigMapKey: "72h",
+		},
+	}
+
+	ctx, _ := SetupFakeContext(t)
+
+	deletionTime := time.Now()
+	b := NewDeletedBroker(WithDeletionTime(deletionTime)).(*eventing.Broker)
+
+	reconciler := Reconciler{
+		Reconciler: &base.Reconciler{
+			KubeClient:                  kubeclient.Get(ctx),
+			DataPlaneConfigMapNamespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+			DataPlaneConfigMapName:      DefaultConfigs.DataPlaneConfigMapName,
+			DataPlaneConfigFormat:       DefaultConfigs.DataPlaneConfigFormat,
+		},
+		Configs: DefaultConfigs,
+	}
+
+	reconciler.ConfigMapUpdated(ctx)(&cm)
+
+	err := reconciler.FinalizeKind(ctx, b)
+	assert.Nil(t, err)
+
+	retained, err := kubeclient.Get(ctx).CoreV1().
+		ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).
+		Get(DefaultConfigs.DataPlaneConfigMapName+RetainedTopicsConfigMapSuffix, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, RetainedTopic("server1,server2", deletionTime.Add(72*time.Hour)), retained.Data[GetTopic()])
+}
+
+func TestConfigMapUpdateWithTopicDeletionGracePeriodOnly(t *testing.T) {
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cmname",
+			Namespace: "cmnamespace",
+		},
+		Data: map[string]string{
+			DefaultTopicNumPartitionConfigMapKey:        "42",
+			DefaultTopicReplicationFactorConfigMapKey:   "3",
+			BootstrapServersConfigMapKey:                "server1,server2",
+			DefaultTopicDeletionPolicyConfigMapKey:      string(TopicDeletionPolicyDeleteAfterGracePeriod),
+			DefaultTopicDeletionGracePeriodConfigMapKey: "72h",
+		},
+	}
+
+	brokerConfig := BrokerConfig(bootstrapServers, 10, 1, func(cm *corev1.ConfigMap) {
+		cm.Data[DefaultTopicDeletionGracePeriodConfigMapKey] = "1h"
+	})
+
+	ctx, _ := SetupFakeContext(t)
+
+	deletionTime := time.Now()
+	b := NewDeletedBroker(
+		WithDeletionTime(deletionTime),
+		WithBrokerConfig(KReference(brokerConfig)),
+	).(*eventing.Broker)
+
+	reconciler := Reconciler{
+		Reconciler: &base.Reconciler{
+			KubeClient:                  kubeclient.Get(ctx),
+			DataPlaneConfigMapNamespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+			DataPlaneConfigMapName:      DefaultConfigs.DataPlaneConfigMapName,
+			DataPlaneConfigFormat:       DefaultConfigs.DataPlaneConfigFormat,
+		},
+		ConfigMapLister: newConfigMapLister(t, brokerConfig),
+		Configs:         DefaultConfigs,
+	}
+
+	reconciler.ConfigMapUpdated(ctx)(&cm)
+
+	err := reconciler.FinalizeKind(ctx, b)
+	assert.Nil(t, err)
+
+	retained, err := kubeclient.Get(ctx).CoreV1().
+		ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).
+		Get(DefaultConfigs.DataPlaneConfigMapName+RetainedTopicsConfigMapSuffix, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	// The policy comes from the defaults, while the grace period comes from the Broker config map.
+	assert.Equal(t, RetainedTopic(bootstrapServers, deletionTime.Add(time.Hour)), retained.Data[GetTopic()])
+}
+
+func TestReconcileDeletesBrokerFromPreviousShard(t *testing.T) {
+
+	ctx, _ := SetupFakeContext(t)
+	ctx = controller.WithEventRecorder(ctx, record.NewFakeRecorder(10))
+
+	brokerConfig := BrokerConfig(bootstrapServers, 10, 1)
+	b := NewBroker(WithBrokerConfig(KReference(brokerConfig))).(*eventing.Broker)
+
+	reconciler := Reconciler{
+		Reconciler: &base.Reconciler{
+			KubeClient:                  kubeclient.Get(ctx),
+			PodLister:                   corelisters.NewPodLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+			DataPlaneConfigMapNamespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+			DataPlaneConfigMapName:      DefaultConfigs.DataPlaneConfigMapName,
+			DataPlaneConfigFormat:       DefaultConfigs.DataPlaneConfigFormat,
+			DataPlaneConfigMapShards:    2,
+		},
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return &MockKafkaClusterAdmin{
+				ExpectedTopicName:   GetTopic(),
+				ExpectedTopicDetail: sarama.TopicDetail{NumPartitions: 10, ReplicationFactor: 1},
+				T:                   t,
+			}, nil
+		},
+		Configs: DefaultConfigs,
+	}
+
+	shard := reconciler.Shard(b.UID)
+	previousShard := 1 - shard
+
+	// The Broker is in the previous shard, for example because the number of shards changed.
+	data, err := json.Marshal(&coreconfig.Brokers{
+		Brokers: []*coreconfig.Broker{
+			{
+				Id:    BrokerUUID,
+				Topic: GetTopic(),
+				Triggers: []*coreconfig.Trigger{
+					{Id: TriggerUUID, Destination: "http://example.com"},
+				},
+			},
+			{Id: "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b", Topic: "other-topic"},
+		},
+		VolumeGeneration: 5,
+	})
+	assert.Nil(t, err)
+
+	previous := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+			Name:      reconciler.DataPlaneConfigMapShardName(previousShard),
+		},
+		BinaryData: map[string][]byte{base.ConfigMapDataKey: data},
+	}
+	_, err = kubeclient.Get(ctx).CoreV1().ConfigMaps(previous.Namespace).Create(previous)
+	assert.Nil(t, err)
+
+	reconciler.ConfigMapLister = newConfigMapLister(t, brokerConfig, previous)
+	reconciler.ConfigMapTracker = &FakeTracker{}
+	reconciler.Resolver = resolver.NewURIResolver(ctx, func(name types.NamespacedName) {})
+
+	err = reconciler.ReconcileKind(ctx, b)
+	assert.Nil(t, err)
+
+	getBrokers := func(shard int) *coreconfig.Brokers {
+		cm, err := kubeclient.Get(ctx).CoreV1().
+			ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).
+			Get(reconciler.DataPlaneConfigMapShardName(shard), metav1.GetOptions{})
+		assert.Nil(t, err)
+
+		brokers, err := reconciler.GetDataPlaneConfigMapData(zap.NewNop(), cm)
+		assert.Nil(t, err)
+		return brokers
+	}
+
+	brokers := getBrokers(shard)
+	assert.Len(t, brokers.Brokers, 1)
+	assert.Equal(t, BrokerUUID, brokers.Brokers[0].Id)
+	// Triggers move together with the Broker.
+	assert.Len(t, brokers.Brokers[0].Triggers, 1)
+	assert.Equal(t, TriggerUUID, brokers.Brokers[0].Triggers[0].Id)
+
+	brokers = getBrokers(previousShard)
+	assert.Len(t, brokers.Brokers, 1)
+	assert.Equal(t, "5384faa4-6bdf-428d-b6c2-d6f89ce1d44b", brokers.Brokers[0].Id)
+	assert.Equal(t, uint64(6), brokers.VolumeGeneration)
+}
+
+func TestReconcileTracksBrokerConfig(t *testing.T) {
+
+	ctx, _ := SetupFakeContext(t)
+	ctx = controller.WithEventRecorder(ctx, record.NewFakeRecorder(10))
+
+	// The config map doesn't exist yet, the Broker is reconciled once it's created.
+	brokerConfig := BrokerConfig(bootstrapServers, 10, 1)
+	b := NewBroker(WithBrokerConfig(KReference(brokerConfig))).(*eventing.Broker)
+
+	configMapTracker := &FakeTracker{}
+	reconciler := Reconciler{
+		Reconciler:       &base.Reconciler{},
+		ConfigMapLister:  newConfigMapLister(t),
+		ConfigMapTracker: configMapTracker,
+		Configs:          DefaultConfigs,
+	}
+
+	err := reconciler.ReconcileKind(ctx, b)
+	assert.NotNil(t, err)
+
+	assert.Equal(t, []tracker.Reference{{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  brokerConfig.Namespace,
+		Name:       brokerConfig.Name,
+	}}, configMapTracker.References())
+}
+
+func newConfigMapLister(t *testing.T, cms ...*corev1.ConfigMap) corelisters.ConfigMapLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, cm := range cms {
+		assert.Nil(t, indexer.Add(cm))
+	}
+	return corelisters.NewConfigMapLister(indexer)
+}
+
 func patchFinalizers() clientgotesting.PatchActionImpl {
 	action := clientgotesting.PatchActionImpl{}
 	action.Name = BrokerName
@@ -1428,13 +3297,6 @@ func patchFinalizers() clientgotesting.PatchActionImpl {
 	return action
 }
 
-func getUnmarshallableError(format string) interface{} {
-	if format == base.Protobuf {
-		return "unexpected EOF"
-	}
-	return "invalid character '-' after object key"
-}
-
 func TestPath(t *testing.T) {
 	type args struct {
 		namespace string
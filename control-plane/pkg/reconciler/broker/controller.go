@@ -22,7 +22,9 @@ import (
 
 	"github.com/Shopify/sarama"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/logging"
@@ -30,6 +32,7 @@ import (
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
 
 	brokerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
 	brokerreconciler "knative.dev/eventing/pkg/client/injection/reconciler/eventing/v1/broker"
@@ -45,42 +48,77 @@ const (
 	DefaultTopicReplicationFactorConfigMapKey = "default.topic.replication.factor"
 	BootstrapServersConfigMapKey              = "bootstrap.servers"
 
+	// DefaultTopicConfigConfigMapKeyPrefix is the prefix of the keys specifying Kafka topic configurations, for
+	// example default.topic.config.retention.ms.
+	// The specified configurations replace every dynamic configuration of the topic, so configurations set outside
+	// of the config map are reverted. When no key is specified, topic configurations aren't managed at all.
+	DefaultTopicConfigConfigMapKeyPrefix = "default.topic.config."
+
+	DefaultTopicDeletionPolicyConfigMapKey      = "default.topic.deletion.policy"
+	DefaultTopicDeletionGracePeriodConfigMapKey = "default.topic.deletion.grace.period"
+
+	// AuthSecretNameConfigMapKey is the name of the secret, in the config map namespace, holding TLS and SASL
+	// settings to connect to Kafka (see security.ConfigureSarama).
+	AuthSecretNameConfigMapKey = "auth.secret.ref.name"
+
 	DefaultNumPartitions     = 10
 	DefaultReplicationFactor = 1
 )
 
-func NewController(ctx context.Context, watcher configmap.Watcher, configs *Configs) *controller.Impl {
+// NewContractWriter creates the ContractWriter shared by the Broker and the Trigger reconcilers.
+func NewContractWriter(ctx context.Context, configs *EnvConfigs) *base.ContractWriter {
+	return base.NewContractWriter(newBaseReconciler(ctx, configs), configs.DataPlaneConfigWriteWindow)
+}
+
+func newBaseReconciler(ctx context.Context, configs *EnvConfigs) *base.Reconciler {
+	return &base.Reconciler{
+		KubeClient:                  kubeclient.Get(ctx),
+		PodLister:                   podinformer.Get(ctx).Lister(),
+		DataPlaneConfigMapNamespace: configs.DataPlaneConfigMapNamespace,
+		DataPlaneConfigMapName:      configs.DataPlaneConfigMapName,
+		DataPlaneConfigFormat:       configs.DataPlaneConfigFormat,
+		SystemNamespace:             configs.SystemNamespace,
+		DataPlaneConfigMapShards:    configs.DataPlaneConfigMapShards,
+	}
+}
+
+// NewController creates the Broker controller, updates of the data plane config are committed by the given
+// ContractWriter, or by a ContractWriter owned by the controller when nil.
+func NewController(ctx context.Context, watcher configmap.Watcher, configs *Configs, contractWriter *base.ContractWriter) *controller.Impl {
 
 	eventing.RegisterAlternateBrokerConditionSet(ConditionSet)
 
 	configmapInformer := configmapinformer.Get(ctx)
 
+	if contractWriter == nil {
+		contractWriter = NewContractWriter(ctx, &configs.EnvConfigs)
+	}
+
+	baseReconciler := newBaseReconciler(ctx, &configs.EnvConfigs)
+	baseReconciler.ContractWriter = contractWriter
+
 	reconciler := &Reconciler{
-		Reconciler: &base.Reconciler{
-			KubeClient:                  kubeclient.Get(ctx),
-			PodLister:                   podinformer.Get(ctx).Lister(),
-			DataPlaneConfigMapNamespace: configs.DataPlaneConfigMapNamespace,
-			DataPlaneConfigMapName:      configs.DataPlaneConfigMapName,
-			DataPlaneConfigFormat:       configs.DataPlaneConfigFormat,
-			SystemNamespace:             configs.SystemNamespace,
-		},
+		Reconciler:      baseReconciler,
 		NewClusterAdmin: sarama.NewClusterAdmin,
 		KafkaDefaultTopicDetails: sarama.TopicDetail{
 			NumPartitions:     DefaultNumPartitions,
 			ReplicationFactor: DefaultReplicationFactor,
 		},
+		BrokerLister:    brokerinformer.Get(ctx).Lister(),
 		ConfigMapLister: configmapInformer.Lister(),
 		Configs:         configs,
 	}
 
 	logger := logging.FromContext(ctx)
 
-	_, err := reconciler.GetOrCreateDataPlaneConfigMap()
-	if err != nil {
-		logger.Fatal("Failed to get or create data plane config map",
-			zap.String("configmap", configs.DataPlaneConfigMapAsString()),
-			zap.Error(err),
-		)
+	for shard := 0; shard < reconciler.NumShards(); shard++ {
+		_, err := reconciler.GetOrCreateDataPlaneConfigMap(shard)
+		if err != nil {
+			logger.Fatal("Failed to get or create data plane config map",
+				zap.String("configmap", reconciler.DataPlaneConfigMapShardAsString(shard)),
+				zap.Error(err),
+			)
+		}
 	}
 
 	if configs.BootstrapServers != "" {
@@ -100,6 +138,15 @@ func NewController(ctx context.Context, watcher configmap.Watcher, configs *Conf
 		Handler:    controller.HandleAll(impl.Enqueue),
 	})
 
+	// Brokers are enqueued when the config map they reference changes.
+	reconciler.ConfigMapTracker = tracker.New(impl.EnqueueKey, controller.GetTrackerLease(ctx))
+	configmapInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(reconciler.ConfigMapTracker.OnChanged, corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+	))
+
+	// Brokers waiting for receiver pods to apply the volume generation carrying them are enqueued when pods change.
+	podinformer.Get(ctx).Informer().AddEventHandler(reconciler.DataPlanePodsHandler(base.ReceiverLabel, impl.EnqueueKey))
+
 	cm, err := reconciler.KubeClient.CoreV1().ConfigMaps(configs.SystemNamespace).Get(configs.GeneralConfigMapName, metav1.GetOptions{})
 	if err != nil {
 		panic(fmt.Errorf("failed to get config map %s/%s: %w", configs.SystemNamespace, configs.GeneralConfigMapName, err))
@@ -109,5 +156,12 @@ func NewController(ctx context.Context, watcher configmap.Watcher, configs *Conf
 
 	watcher.Watch(configs.GeneralConfigMapName, reconciler.ConfigMapUpdated(ctx))
 
+	retainedTopicsConfigMap := types.NamespacedName{
+		Namespace: reconciler.DataPlaneConfigMapNamespace,
+		Name:      reconciler.retainedTopicsConfigMapName(),
+	}
+	go reconciler.RunRetainedTopicsCleaner(ctx, logger, base.IsLeaderFunc(impl, retainedTopicsConfigMap))
+	go reconciler.ClusterAdmins().Run(ctx)
+
 	return impl
 }
@@ -66,6 +66,7 @@ func TestNewController(t *testing.T) {
 			},
 		}),
 		configs,
+		nil,
 	)
 	if controller == nil {
 		t.Error("failed to create controller: <nil>")
@@ -18,6 +18,7 @@ package broker
 
 import (
 	"fmt"
+	"time"
 )
 
 type Configs struct {
@@ -33,6 +34,18 @@ type EnvConfigs struct {
 	BrokerIngressName           string `required:"true" split_words:"true"`
 	SystemNamespace             string `required:"true" split_words:"true"`
 	DataPlaneConfigFormat       string `required:"true" split_words:"true"`
+
+	// DataPlaneConfigMapShards is the number of config maps the data plane config is split across, data plane pods
+	// must mount every shard.
+	DataPlaneConfigMapShards int `default:"1" split_words:"true"`
+
+	// DataPlaneConfigWriteWindow is the time updates of the data plane config are collected for, before committing
+	// them with a single config map update.
+	DataPlaneConfigWriteWindow time.Duration `default:"100ms" split_words:"true"`
+
+	// DataPlaneConfigResyncInterval is the time between two repairs of the data plane config, which remove entries
+	// left behind by missed deletions of Brokers and Triggers.
+	DataPlaneConfigResyncInterval time.Duration `default:"10m" split_words:"true"`
 }
 
 func (c *EnvConfigs) DataPlaneConfigMapAsString() string {
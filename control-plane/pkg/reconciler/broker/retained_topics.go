@@ -0,0 +1,242 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// suffix of the name of the config map, in the data plane config map namespace, holding topics of deleted Brokers
+	// that are retained for a grace period.
+	RetainedTopicsConfigMapSuffix = "-retained-topics"
+
+	// RetainedTopicsCheckInterval is the interval between two checks of the retained topics to delete.
+	RetainedTopicsCheckInterval = time.Minute
+)
+
+// retainedTopic is a topic of a deleted Broker that has to be deleted after a grace period.
+// Retained topics are stored in the retained topics config map keyed by topic name, so that the Broker can be
+// finalized without waiting for the grace period to expire.
+type retainedTopic struct {
+	BootstrapServers []string    `json:"bootstrapServers"`
+	DeleteAt         metav1.Time `json:"deleteAt"`
+
+	AuthSecretRef *corev1.SecretReference `json:"authSecretRef,omitempty"`
+}
+
+func (r *Reconciler) retainedTopicsConfigMapName() string {
+	return r.DataPlaneConfigMapName + RetainedTopicsConfigMapSuffix
+}
+
+// retainTopic records the given topic in the retained topics config map, so that it will be deleted at deleteAt.
+func (r *Reconciler) retainTopic(topic string, config *Config, deleteAt time.Time) error {
+
+	value, err := json.Marshal(retainedTopic{
+		BootstrapServers: config.BootstrapServers,
+		DeleteAt:         metav1.NewTime(deleteAt),
+		AuthSecretRef:    config.AuthSecretRef,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal retained topic %s: %w", topic, err)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+
+		cm, err := r.KubeClient.CoreV1().
+			ConfigMaps(r.DataPlaneConfigMapNamespace).
+			Get(r.retainedTopicsConfigMapName(), metav1.GetOptions{})
+
+		if apierrors.IsNotFound(err) {
+			_, err = r.KubeClient.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Create(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      r.retainedTopicsConfigMapName(),
+					Namespace: r.DataPlaneConfigMapNamespace,
+				},
+				Data: map[string]string{
+					topic: string(value),
+				},
+			})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if cm.Data[topic] == string(value) {
+			return nil
+		}
+
+		cm = cm.DeepCopy()
+		if cm.Data == nil {
+			cm.Data = make(map[string]string, 1)
+		}
+		cm.Data[topic] = string(value)
+
+		_, err = r.KubeClient.CoreV1().ConfigMaps(cm.Namespace).Update(cm)
+		return err
+	})
+}
+
+// releaseRetainedTopic removes the given topic from the retained topics config map, if present.
+// It's used when a Broker using a retained topic is created again, so that its topic won't be deleted.
+func (r *Reconciler) releaseRetainedTopic(logger *zap.Logger, topic string) error {
+
+	cm, err := r.ConfigMapLister.ConfigMaps(r.DataPlaneConfigMapNamespace).Get(r.retainedTopicsConfigMapName())
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get retained topics config map: %w", err)
+	}
+
+	if _, ok := cm.Data[topic]; !ok {
+		return nil
+	}
+
+	logger.Debug("Release retained topic", zap.String("topic", topic))
+
+	return r.removeRetainedTopics(topic)
+}
+
+func (r *Reconciler) removeRetainedTopics(topics ...string) error {
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+
+		cm, err := r.KubeClient.CoreV1().
+			ConfigMaps(r.DataPlaneConfigMapNamespace).
+			Get(r.retainedTopicsConfigMapName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		cm = cm.DeepCopy()
+		for _, topic := range topics {
+			delete(cm.Data, topic)
+		}
+
+		_, err = r.KubeClient.CoreV1().ConfigMaps(cm.Namespace).Update(cm)
+		return err
+	})
+}
+
+// DeleteExpiredTopics deletes retained topics whose grace period is expired.
+//
+// A retained topic is removed from the retained topics config map before being deleted, with an update that fails
+// when the config map changed since it was read, so that a topic released by a Broker created again in the meantime
+// is never deleted.
+func (r *Reconciler) DeleteExpiredTopics(logger *zap.Logger) error {
+
+	cm, err := r.KubeClient.CoreV1().
+		ConfigMaps(r.DataPlaneConfigMapNamespace).
+		Get(r.retainedTopicsConfigMapName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get retained topics config map: %w", err)
+	}
+
+	for topic, value := range cm.DeepCopy().Data {
+
+		retained := retainedTopic{}
+		malformed := json.Unmarshal([]byte(value), &retained)
+		if malformed != nil {
+			// Nothing we can do with it, so remove it to not fail on every check.
+			logger.Warn("Failed to unmarshal retained topic", zap.String("topic", topic), zap.Error(malformed))
+		} else if time.Now().Before(retained.DeleteAt.Time) {
+			continue
+		}
+
+		cm, err = r.updateRetainedTopics(cm, func(data map[string]string) { delete(data, topic) })
+		if apierrors.IsConflict(err) {
+			// The config map changed, expired topics are checked again on the next run.
+			logger.Debug("Retained topics changed, skip deletion", zap.String("topic", topic))
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update retained topics config map: %w", err)
+		}
+
+		if malformed != nil {
+			continue
+		}
+
+		if _, err := r.deleteTopic(topic, retained.BootstrapServers, retained.AuthSecretRef); err != nil {
+			logger.Warn("Failed to delete retained topic", zap.String("topic", topic), zap.Error(err))
+
+			// Record the topic again, so that its deletion is retried, unless it has been released in the meantime.
+			cm, err = r.updateRetainedTopics(cm, func(data map[string]string) { data[topic] = value })
+			if err != nil {
+				return fmt.Errorf("failed to record retained topic %s again: %w", topic, err)
+			}
+			continue
+		}
+
+		logger.Debug("Retained topic deleted", zap.String("topic", topic))
+	}
+
+	return nil
+}
+
+// updateRetainedTopics updates the given retained topics config map with the given function.
+// The update fails with a conflict error when the config map changed since it was read.
+func (r *Reconciler) updateRetainedTopics(cm *corev1.ConfigMap, update func(data map[string]string)) (*corev1.ConfigMap, error) {
+
+	cm = cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = make(map[string]string, 1)
+	}
+	update(cm.Data)
+
+	return r.KubeClient.CoreV1().ConfigMaps(cm.Namespace).Update(cm)
+}
+
+// RunRetainedTopicsCleaner periodically deletes retained topics whose grace period is expired, until the given
+// context is done.
+// Topics are deleted only when isLeader returns true, so that a single replica deletes them.
+func (r *Reconciler) RunRetainedTopicsCleaner(ctx context.Context, logger *zap.Logger, isLeader func() bool) {
+
+	ticker := time.NewTicker(RetainedTopicsCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !isLeader() {
+				continue
+			}
+			if err := r.DeleteExpiredTopics(logger); err != nil {
+				logger.Warn("Failed to delete expired topics", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker_test // different package name due to import cycles. (broker -> testing -> broker)
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	kubeclient "knative.dev/pkg/client/injection/kube/client/fake"
+	. "knative.dev/pkg/reconciler/testing"
+
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+)
+
+func TestDeleteExpiredTopics(t *testing.T) {
+
+	now := time.Now()
+
+	ctx, _ := SetupFakeContext(t)
+
+	_, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).Create(
+		RetainedTopicsConfigMap(DefaultConfigs, map[string]string{
+			"expired-topic":   RetainedTopic(bootstrapServers, now.Add(-time.Minute)),
+			"retained-topic":  RetainedTopic(bootstrapServers, now.Add(time.Hour)),
+			"malformed-topic": "{",
+		}),
+	)
+	assert.Nil(t, err)
+
+	var deletedTopicServers []string
+
+	r := Reconciler{
+		Reconciler: &base.Reconciler{
+			KubeClient:                  kubeclient.Get(ctx),
+			DataPlaneConfigMapNamespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+			DataPlaneConfigMapName:      DefaultConfigs.DataPlaneConfigMapName,
+		},
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			deletedTopicServers = addrs
+			return MockKafkaClusterAdmin{
+				ExpectedTopicName: "expired-topic",
+				T:                 t,
+			}, nil
+		},
+	}
+
+	err = r.DeleteExpiredTopics(zap.NewNop())
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"kafka-1:9092", "kafka-2:9093"}, deletedTopicServers)
+
+	cm, err := kubeclient.Get(ctx).CoreV1().
+		ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).
+		Get(DefaultConfigs.DataPlaneConfigMapName+RetainedTopicsConfigMapSuffix, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]string{
+		"retained-topic": RetainedTopic(bootstrapServers, now.Add(time.Hour)),
+	}, cm.Data)
+}
+
+func TestDeleteExpiredTopicsFailedToDeleteTopic(t *testing.T) {
+
+	ctx, _ := SetupFakeContext(t)
+
+	data := map[string]string{
+		"expired-topic": RetainedTopic(bootstrapServers, time.Now().Add(-time.Minute)),
+	}
+
+	_, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).Create(
+		RetainedTopicsConfigMap(DefaultConfigs, data),
+	)
+	assert.Nil(t, err)
+
+	r := Reconciler{
+		Reconciler: &base.Reconciler{
+			KubeClient:                  kubeclient.Get(ctx),
+			DataPlaneConfigMapNamespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+			DataPlaneConfigMapName:      DefaultConfigs.DataPlaneConfigMapName,
+		},
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return MockKafkaClusterAdmin{
+				ExpectedTopicName:  "expired-topic",
+				ErrorOnDeleteTopic: deleteTopicError,
+				T:                  t,
+			}, nil
+		},
+	}
+
+	err = r.DeleteExpiredTopics(zap.NewNop())
+	assert.Nil(t, err)
+
+	cm, err := kubeclient.Get(ctx).CoreV1().
+		ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).
+		Get(DefaultConfigs.DataPlaneConfigMapName+RetainedTopicsConfigMapSuffix, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	// The topic is kept, so that its deletion is retried.
+	assert.Equal(t, data, cm.Data)
+}
+
+func TestDeleteExpiredTopicsRetainedTopicsChanged(t *testing.T) {
+
+	ctx, _ := SetupFakeContext(t)
+
+	data := map[string]string{
+		"expired-topic": RetainedTopic(bootstrapServers, time.Now().Add(-time.Minute)),
+	}
+
+	_, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(DefaultConfigs.DataPlaneConfigMapNamespace).Create(
+		RetainedTopicsConfigMap(DefaultConfigs, data),
+	)
+	assert.Nil(t, err)
+
+	// A Broker using the topic released it after the config map has been read.
+	kubeclient.Get(ctx).PrependReactor("update", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(corev1.Resource("configmaps"), action.(clientgotesting.UpdateAction).GetObject().(*corev1.ConfigMap).Name, nil)
+	})
+
+	r := Reconciler{
+		Reconciler: &base.Reconciler{
+			KubeClient:                  kubeclient.Get(ctx),
+			DataPlaneConfigMapNamespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+			DataPlaneConfigMapName:      DefaultConfigs.DataPlaneConfigMapName,
+		},
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			t.Errorf("unexpected topic deletion")
+			return nil, deleteTopicError
+		},
+	}
+
+	err = r.DeleteExpiredTopics(zap.NewNop())
+	assert.Nil(t, err)
+}
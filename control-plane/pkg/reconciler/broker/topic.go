@@ -17,46 +17,240 @@
 package broker
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/Shopify/sarama"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 )
 
-func (r *Reconciler) CreateTopic(logger *zap.Logger, topic string, config *Config) (string, error) {
+const (
+	// ExternalTopicAnnotation references an existing topic to use for a Broker.
+	// The topic lifecycle isn't managed by the Broker reconciler, so the topic is never created nor deleted.
+	// The annotation can't be added, removed or changed once the Broker uses a topic.
+	ExternalTopicAnnotation = "kafka.eventing.knative.dev/external.topic"
 
-	kafkaClusterAdmin, err := r.getKafkaClusterAdmin(config.BootstrapServers)
+	// Broker status annotations recording the topic used by a Broker, so that the topic of a Broker can't change
+	// during the Broker lifetime, and the Broker is finalized based on the topic it actually used.
+	// Only one of them is set.
+	ManagedTopicStatusAnnotation  = "kafka.eventing.knative.dev/managed.topic"
+	ExternalTopicStatusAnnotation = ExternalTopicAnnotation
+)
+
+// ErrTopicChanged is returned when the topic of a Broker is different from the topic recorded in the Broker status.
+var ErrTopicChanged = errors.New("topic of a Broker cannot be changed")
+
+// ErrExternalTopicRejected is returned when the external topic of a Broker might be used by other Brokers.
+var ErrExternalTopicRejected = errors.New("external topic rejected")
+
+// ErrDecreasePartitions is returned when the desired number of partitions of an existing topic is lower than the
+// current one, since Kafka doesn't support decreasing the number of partitions of a topic.
+// The topic is usable anyway, so it's a kind of topic drift.
+var ErrDecreasePartitions = errors.New("number of partitions of a topic cannot be decreased")
+
+// ErrTopicDrift is returned when an existing topic doesn't match the desired topic detail, and the difference cannot be
+// reconciled automatically.
+var ErrTopicDrift = errors.New("topic doesn't match the desired configuration")
+
+func (r *Reconciler) CreateTopic(logger *zap.Logger, topic string, config *Config) (_ string, err error) {
+
+	kafkaClusterAdmin, release, err := r.getKafkaClusterAdmin(config.BootstrapServers, config.AuthSecretRef)
 	if err != nil {
-		return "", err
+		return topic, err
 	}
-	defer kafkaClusterAdmin.Close()
+	defer func() { release(err) }()
 
 	topicDetail := &sarama.TopicDetail{
 		NumPartitions:     config.TopicDetail.NumPartitions,
 		ReplicationFactor: config.TopicDetail.ReplicationFactor,
+		ConfigEntries:     config.TopicDetail.ConfigEntries,
 	}
 
 	logger.Debug("create topic",
 		zap.String("topic", topic),
 		zap.Int16("replicationFactor", topicDetail.ReplicationFactor),
 		zap.Int32("numPartitions", topicDetail.NumPartitions),
+		zap.Any("configEntries", topicDetail.ConfigEntries),
 	)
 
 	createTopicError := kafkaClusterAdmin.CreateTopic(topic, topicDetail, false)
 	if err, ok := createTopicError.(*sarama.TopicError); ok && err.Err == sarama.ErrTopicAlreadyExists {
-		return topic, nil
+		metadata, err := describeTopic(kafkaClusterAdmin, topic)
+		if err != nil {
+			return topic, err
+		}
+		// A topic with more partitions than desired is still usable, so report it as drift after reconciling the
+		// rest of the topic.
+		partitionsErr := updateTopicPartitions(logger, kafkaClusterAdmin, metadata, topicDetail.NumPartitions)
+		if partitionsErr != nil && !errors.Is(partitionsErr, ErrDecreasePartitions) {
+			return topic, partitionsErr
+		}
+		if err := alterTopicConfig(logger, kafkaClusterAdmin, topic, topicDetail.ConfigEntries); err != nil {
+			return topic, err
+		}
+		if partitionsErr != nil {
+			return topic, partitionsErr
+		}
+		return topic, topicDrift(metadata, topicDetail)
 	}
 
 	return topic, createTopicError
 }
 
-func (r *Reconciler) deleteTopic(topic string, bootstrapServers []string) (string, error) {
-	kafkaClusterAdmin, err := r.getKafkaClusterAdmin(bootstrapServers)
+// updateTopicPartitions increases the number of partitions of an existing topic when the desired number of partitions
+// is higher than the current one.
+func updateTopicPartitions(logger *zap.Logger, kafkaClusterAdmin sarama.ClusterAdmin, metadata *sarama.TopicMetadata, numPartitions int32) error {
+
+	topic := metadata.Name
+	currentNumPartitions := int32(len(metadata.Partitions))
+
+	logger.Debug("topic partitions",
+		zap.String("topic", topic),
+		zap.Int32("currentNumPartitions", currentNumPartitions),
+		zap.Int32("numPartitions", numPartitions),
+	)
+
+	if numPartitions == currentNumPartitions {
+		return nil
+	}
+	if numPartitions < currentNumPartitions {
+		return fmt.Errorf("%w: topic %s has %d partitions, desired %d", ErrDecreasePartitions, topic, currentNumPartitions, numPartitions)
+	}
+
+	if err := kafkaClusterAdmin.CreatePartitions(topic, numPartitions, nil, false); err != nil {
+		return fmt.Errorf("failed to increase topic partitions from %d to %d: %w", currentNumPartitions, numPartitions, err)
+	}
+
+	return nil
+}
+
+func describeTopic(kafkaClusterAdmin sarama.ClusterAdmin, topic string) (*sarama.TopicMetadata, error) {
+
+	metadata, err := kafkaClusterAdmin.DescribeTopics([]string{topic})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe topic %s: %w", topic, err)
+	}
+
+	for _, m := range metadata {
+		if m.Name != topic {
+			continue
+		}
+		if m.Err != sarama.ErrNoError {
+			return nil, fmt.Errorf("failed to describe topic %s: %w", topic, m.Err)
+		}
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("failed to describe topic %s: %w", topic, sarama.ErrUnknownTopicOrPartition)
+}
+
+// topicDrift checks that the replication factor of each partition of an existing topic matches the desired one.
+// The number of partitions isn't checked here, since it's reconciled (or reported as drift) by updateTopicPartitions,
+// and partitions added by updateTopicPartitions get the replication factor of the existing ones, so checking the
+// metadata read before adding partitions is enough.
+func topicDrift(metadata *sarama.TopicMetadata, topicDetail *sarama.TopicDetail) error {
+	if topicDetail.ReplicationFactor <= 0 {
+		return nil
+	}
+
+	for _, p := range metadata.Partitions {
+		if replicationFactor := len(p.Replicas); replicationFactor != int(topicDetail.ReplicationFactor) {
+			return fmt.Errorf(
+				"%w: partition %d of topic %s has replication factor %d, desired %d",
+				ErrTopicDrift,
+				p.ID,
+				metadata.Name,
+				replicationFactor,
+				topicDetail.ReplicationFactor,
+			)
+		}
+	}
+
+	return nil
+}
+
+// alterTopicConfig makes the dynamic configurations of an existing topic match the given topic configurations.
+//
+// AlterConfig replaces the whole set of dynamic configurations of a topic, so it's called only when the current set
+// differs from the desired one.
+// Topic configurations are managed only when at least one is desired, so that overrides set outside of the config map
+// on topics of Brokers that don't specify any topic configuration are left untouched.
+func alterTopicConfig(logger *zap.Logger, kafkaClusterAdmin sarama.ClusterAdmin, topic string, entries map[string]*string) error {
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	current, err := describeTopicConfig(kafkaClusterAdmin, topic)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("topic config",
+		zap.String("topic", topic),
+		zap.Any("currentConfigEntries", current),
+		zap.Any("configEntries", entries),
+	)
+
+	if topicConfigEqual(current, entries) {
+		return nil
+	}
+
+	if err := kafkaClusterAdmin.AlterConfig(sarama.TopicResource, topic, entries, false); err != nil {
+		return fmt.Errorf("failed to alter topic config: %w", err)
+	}
+
+	return nil
+}
+
+// describeTopicConfig returns the dynamic configurations of the given topic, which are the configurations set at the
+// topic level.
+func describeTopicConfig(kafkaClusterAdmin sarama.ClusterAdmin, topic string) (map[string]string, error) {
+
+	entries, err := kafkaClusterAdmin.DescribeConfig(sarama.ConfigResource{
+		Type: sarama.TopicResource,
+		Name: topic,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe topic config %s: %w", topic, err)
+	}
+
+	current := make(map[string]string, len(entries))
+	for _, e := range entries {
+		// Older protocol versions don't report the source, so rely on the default flag.
+		if e.Source == sarama.SourceTopic || (e.Source == sarama.SourceUnknown && !e.Default && !e.ReadOnly) {
+			current[e.Name] = e.Value
+		}
+	}
+
+	return current, nil
+}
+
+func topicConfigEqual(current map[string]string, desired map[string]*string) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	for name, value := range desired {
+		v, ok := current[name]
+		if !ok || value == nil || *value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Reconciler) deleteTopic(topic string, bootstrapServers []string, secretRef *corev1.SecretReference) (_ string, err error) {
+	kafkaClusterAdmin, release, err := r.getKafkaClusterAdmin(bootstrapServers, secretRef)
 	if err != nil {
 		return "", err
 	}
-	defer kafkaClusterAdmin.Close()
+	defer func() { release(err) }()
 
 	err = kafkaClusterAdmin.DeleteTopic(topic)
 	if sarama.ErrUnknownTopicOrPartition == err {
@@ -69,6 +263,112 @@ func (r *Reconciler) deleteTopic(topic string, bootstrapServers []string) (strin
 	return topic, nil
 }
 
+// CheckExternalTopic checks that the given external topic exists.
+func (r *Reconciler) CheckExternalTopic(logger *zap.Logger, topic string, config *Config) (_ string, err error) {
+
+	kafkaClusterAdmin, release, err := r.getKafkaClusterAdmin(config.BootstrapServers, config.AuthSecretRef)
+	if err != nil {
+		return topic, err
+	}
+	defer func() { release(err) }()
+
+	logger.Debug("check external topic", zap.String("topic", topic))
+
+	if _, err := describeTopic(kafkaClusterAdmin, topic); err != nil {
+		return topic, err
+	}
+
+	return topic, nil
+}
+
+// brokerTopic returns the desired topic of the given Broker and whether it's an external topic.
+func brokerTopic(broker *eventing.Broker) (string, bool) {
+	if topic, ok := ExternalTopic(broker); ok {
+		return topic, true
+	}
+	return Topic(broker), false
+}
+
+// recordedTopic returns the topic recorded in the status of the given Broker and whether it's an external topic.
+func recordedTopic(broker *eventing.Broker) (topic string, external bool, ok bool) {
+	annotations := broker.Status.Annotations
+	if topic, ok := annotations[ExternalTopicStatusAnnotation]; ok && topic != "" {
+		return topic, true, true
+	}
+	if topic, ok := annotations[ManagedTopicStatusAnnotation]; ok && topic != "" {
+		return topic, false, true
+	}
+	return "", false, false
+}
+
+func recordTopic(broker *eventing.Broker, topic string, external bool) {
+	if broker.Status.Annotations == nil {
+		broker.Status.Annotations = make(map[string]string, 1)
+	}
+
+	delete(broker.Status.Annotations, ExternalTopicStatusAnnotation)
+	delete(broker.Status.Annotations, ManagedTopicStatusAnnotation)
+
+	if external {
+		broker.Status.Annotations[ExternalTopicStatusAnnotation] = topic
+	} else {
+		broker.Status.Annotations[ManagedTopicStatusAnnotation] = topic
+	}
+}
+
+// checkTopicChange returns an error when the desired topic of the given Broker is different from the recorded one.
+func checkTopicChange(broker *eventing.Broker) error {
+	recorded, recordedExternal, ok := recordedTopic(broker)
+	if !ok {
+		return nil
+	}
+
+	topic, external := brokerTopic(broker)
+	if topic == recorded && external == recordedExternal {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: the Broker uses the topic %s, desired topic %s (annotation %s) - recreate the Broker to use a different topic",
+		ErrTopicChanged,
+		recorded,
+		topic,
+		ExternalTopicAnnotation,
+	)
+}
+
+// checkExternalTopic returns an error when the given external topic of the given Broker might be used by other Brokers,
+// so that a Broker can't read or write events of Brokers of other namespaces.
+// Topics with the prefix of managed topics and external topics recorded by other Brokers are rejected.
+func (r *Reconciler) checkExternalTopic(broker *eventing.Broker, topic string) error {
+
+	if strings.HasPrefix(topic, TopicPrefix) {
+		return fmt.Errorf("%w: topics with prefix %s are reserved to managed topics", ErrExternalTopicRejected, TopicPrefix)
+	}
+
+	brokers, err := r.BrokerLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list brokers: %w", err)
+	}
+
+	for _, b := range brokers {
+		if b.UID == broker.UID {
+			continue
+		}
+		if recorded, external, ok := recordedTopic(b); ok && external && recorded == topic {
+			return fmt.Errorf("%w: topic %s is used by Broker %s/%s", ErrExternalTopicRejected, topic, b.Namespace, b.Name)
+		}
+	}
+
+	return nil
+}
+
+// ExternalTopic returns the external topic referenced by the given Broker, if any.
+func ExternalTopic(broker *eventing.Broker) (string, bool) {
+	topic, ok := broker.GetAnnotations()[ExternalTopicAnnotation]
+	return topic, ok && topic != ""
+}
+
 func Topic(broker *eventing.Broker) string {
 	return fmt.Sprintf("%s%s-%s", TopicPrefix, broker.Namespace, broker.Name)
 }
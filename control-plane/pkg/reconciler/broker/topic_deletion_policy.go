@@ -0,0 +1,112 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker
+
+import (
+	"fmt"
+	"time"
+
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+// TopicDeletionPolicy determines what happens to the topic of a Broker when the Broker is deleted.
+type TopicDeletionPolicy string
+
+const (
+	// TopicDeletionPolicyDelete deletes the topic as soon as the Broker is deleted.
+	TopicDeletionPolicyDelete TopicDeletionPolicy = "Delete"
+	// TopicDeletionPolicyRetain never deletes the topic.
+	TopicDeletionPolicyRetain TopicDeletionPolicy = "Retain"
+	// TopicDeletionPolicyDeleteAfterGracePeriod retains the topic for a grace period after the Broker has been deleted,
+	// and then it deletes the topic.
+	TopicDeletionPolicyDeleteAfterGracePeriod TopicDeletionPolicy = "DeleteAfterGracePeriod"
+
+	// Broker annotations to override the topic deletion policy of a single Broker.
+	// Setting the Retain policy on a deleted Broker completes its deletion without connecting to Kafka, for example
+	// when Kafka is unreachable.
+	TopicDeletionPolicyAnnotation      = "kafka.eventing.knative.dev/topic.deletion.policy"
+	TopicDeletionGracePeriodAnnotation = "kafka.eventing.knative.dev/topic.deletion.grace.period"
+)
+
+func validateTopicDeletionPolicy(policy TopicDeletionPolicy) error {
+	switch policy {
+	case "", TopicDeletionPolicyDelete, TopicDeletionPolicyRetain, TopicDeletionPolicyDeleteAfterGracePeriod:
+		return nil
+	default:
+		return fmt.Errorf(
+			"unknown topic deletion policy %s - supported policies: %s, %s, %s",
+			policy,
+			TopicDeletionPolicyDelete,
+			TopicDeletionPolicyRetain,
+			TopicDeletionPolicyDeleteAfterGracePeriod,
+		)
+	}
+}
+
+func validateTopicDeletionGracePeriod(policy TopicDeletionPolicy, gracePeriod time.Duration) error {
+	if policy == TopicDeletionPolicyDeleteAfterGracePeriod && gracePeriod <= 0 {
+		return fmt.Errorf("topic deletion policy %s requires a positive grace period - got %v", policy, gracePeriod)
+	}
+	return nil
+}
+
+// topicDeletionPolicy returns the topic deletion policy and grace period of the given Broker.
+// Broker annotations take precedence over the given config.
+func topicDeletionPolicy(broker *eventing.Broker, config *Config) (TopicDeletionPolicy, time.Duration, error) {
+
+	policy := config.TopicDeletionPolicy
+	gracePeriod := config.TopicDeletionGracePeriod
+
+	if p, ok := broker.GetAnnotations()[TopicDeletionPolicyAnnotation]; ok {
+		policy = TopicDeletionPolicy(p)
+	}
+	if gp, ok := broker.GetAnnotations()[TopicDeletionGracePeriodAnnotation]; ok {
+		d, err := time.ParseDuration(gp)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to parse annotation %s: %w", TopicDeletionGracePeriodAnnotation, err)
+		}
+		gracePeriod = d
+	}
+
+	if policy == "" {
+		policy = TopicDeletionPolicyDelete
+	}
+
+	if err := validateTopicDeletionPolicy(policy); err != nil {
+		return "", 0, err
+	}
+	if err := validateTopicDeletionGracePeriod(policy, gracePeriod); err != nil {
+		return "", 0, err
+	}
+
+	return policy, gracePeriod, nil
+}
+
+// topicRetained returns true when the given Broker retains its topic by annotation.
+func topicRetained(broker *eventing.Broker) bool {
+	return broker.GetAnnotations()[TopicDeletionPolicyAnnotation] == string(TopicDeletionPolicyRetain)
+}
+
+// retainTopicHint tells how to complete the deletion of a Broker which can't delete the given topic.
+func retainTopicHint(topic string) string {
+	return fmt.Sprintf(
+		"annotate the Broker with %s: %s to retain the topic %s and complete the Broker deletion",
+		TopicDeletionPolicyAnnotation,
+		TopicDeletionPolicyRetain,
+		topic,
+	)
+}
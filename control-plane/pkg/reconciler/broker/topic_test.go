@@ -17,6 +17,7 @@
 package broker_test // different package name due to import cycles. (broker -> testing -> broker)
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/Shopify/sarama"
@@ -49,6 +50,9 @@ func TestCreateTopicTopicAlreadyExists(t *testing.T) {
 					Err:    sarama.ErrTopicAlreadyExists,
 					ErrMsg: &errMsg,
 				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 0, 0),
+				},
 				T: t,
 			}, nil
 		},
@@ -59,3 +63,310 @@ func TestCreateTopicTopicAlreadyExists(t *testing.T) {
 	assert.Equal(t, topicRet, topic, "expected topic %s go %s", topic, topicRet)
 	assert.Nil(t, err, "expected nil error on topic already exists")
 }
+
+func TestCreateTopicTopicAlreadyExistsAlterConfig(t *testing.T) {
+
+	b := &eventing.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bname",
+			Namespace: "bnamespace",
+		},
+	}
+	topic := broker.Topic(b)
+	errMsg := "topic already exists"
+	retention := "86400000"
+
+	configEntries := map[string]*string{
+		"retention.ms": &retention,
+	}
+
+	r := broker.Reconciler{
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return reconcilertesting.MockKafkaClusterAdmin{
+				ExpectedTopicName: topic,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					ConfigEntries: configEntries,
+				},
+				ErrorOnCreateTopic: &sarama.TopicError{
+					Err:    sarama.ErrTopicAlreadyExists,
+					ErrMsg: &errMsg,
+				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 0, 0),
+				},
+				ExpectedConfigEntries: configEntries,
+				T:                     t,
+			}, nil
+		},
+	}
+
+	topicRet, err := r.CreateTopic(zap.NewNop(), topic, &broker.Config{
+		TopicDetail: sarama.TopicDetail{
+			ConfigEntries: configEntries,
+		},
+	})
+
+	assert.Equal(t, topicRet, topic, "expected topic %s go %s", topic, topicRet)
+	assert.Nil(t, err, "expected nil error on topic already exists")
+}
+
+func TestCreateTopicTopicAlreadyExistsFailedToAlterConfig(t *testing.T) {
+
+	b := &eventing.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bname",
+			Namespace: "bnamespace",
+		},
+	}
+	topic := broker.Topic(b)
+	errMsg := "topic already exists"
+	retention := "86400000"
+
+	configEntries := map[string]*string{
+		"retention.ms": &retention,
+	}
+
+	r := broker.Reconciler{
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return reconcilertesting.MockKafkaClusterAdmin{
+				ExpectedTopicName: topic,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					ConfigEntries: configEntries,
+				},
+				ErrorOnCreateTopic: &sarama.TopicError{
+					Err:    sarama.ErrTopicAlreadyExists,
+					ErrMsg: &errMsg,
+				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 0, 0),
+				},
+				ExpectedConfigEntries: configEntries,
+				ErrorOnAlterConfig:    sarama.ErrPolicyViolation,
+				T:                     t,
+			}, nil
+		},
+	}
+
+	_, err := r.CreateTopic(zap.NewNop(), topic, &broker.Config{
+		TopicDetail: sarama.TopicDetail{
+			ConfigEntries: configEntries,
+		},
+	})
+
+	assert.NotNil(t, err, "expected error on alter config failure")
+}
+
+func TestCreateTopicTopicAlreadyExistsConfigUpToDate(t *testing.T) {
+
+	b := &eventing.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bname",
+			Namespace: "bnamespace",
+		},
+	}
+	topic := broker.Topic(b)
+	errMsg := "topic already exists"
+	retention := "86400000"
+
+	configEntries := map[string]*string{
+		"retention.ms": &retention,
+	}
+
+	r := broker.Reconciler{
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return reconcilertesting.MockKafkaClusterAdmin{
+				ExpectedTopicName: topic,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					ConfigEntries: configEntries,
+				},
+				ErrorOnCreateTopic: &sarama.TopicError{
+					Err:    sarama.ErrTopicAlreadyExists,
+					ErrMsg: &errMsg,
+				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 0, 0),
+				},
+				TopicConfigEntries: []sarama.ConfigEntry{
+					{Name: "retention.ms", Value: retention, Source: sarama.SourceTopic},
+					{Name: "cleanup.policy", Value: "delete", Source: sarama.SourceDefault, Default: true},
+				},
+				ErrorOnAlterConfig: sarama.ErrPolicyViolation, // fail if the config is altered
+				T:                  t,
+			}, nil
+		},
+	}
+
+	topicRet, err := r.CreateTopic(zap.NewNop(), topic, &broker.Config{
+		TopicDetail: sarama.TopicDetail{
+			ConfigEntries: configEntries,
+		},
+	})
+
+	assert.Equal(t, topicRet, topic, "expected topic %s go %s", topic, topicRet)
+	assert.Nil(t, err, "expected nil error on topic config up to date")
+}
+
+func TestCreateTopicTopicAlreadyExistsNoConfigEntries(t *testing.T) {
+
+	b := &eventing.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bname",
+			Namespace: "bnamespace",
+		},
+	}
+	topic := broker.Topic(b)
+	errMsg := "topic already exists"
+
+	r := broker.Reconciler{
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return reconcilertesting.MockKafkaClusterAdmin{
+				ExpectedTopicName:   topic,
+				ExpectedTopicDetail: sarama.TopicDetail{},
+				ErrorOnCreateTopic: &sarama.TopicError{
+					Err:    sarama.ErrTopicAlreadyExists,
+					ErrMsg: &errMsg,
+				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 0, 0),
+				},
+				TopicConfigEntries: []sarama.ConfigEntry{
+					{Name: "retention.ms", Value: "86400000", Source: sarama.SourceTopic},
+				},
+				ErrorOnAlterConfig: sarama.ErrPolicyViolation, // fail if overrides set outside of the config map are reset
+				T:                  t,
+			}, nil
+		},
+	}
+
+	topicRet, err := r.CreateTopic(zap.NewNop(), topic, &broker.Config{})
+
+	assert.Equal(t, topicRet, topic, "expected topic %s go %s", topic, topicRet)
+	assert.Nil(t, err, "expected nil error on topic without desired config entries")
+}
+
+func TestCreateTopicTopicAlreadyExistsIncreasePartitions(t *testing.T) {
+
+	b := &eventing.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bname",
+			Namespace: "bnamespace",
+		},
+	}
+	topic := broker.Topic(b)
+	errMsg := "topic already exists"
+
+	r := broker.Reconciler{
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return reconcilertesting.MockKafkaClusterAdmin{
+				ExpectedTopicName: topic,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     20,
+					ReplicationFactor: 3,
+				},
+				ErrorOnCreateTopic: &sarama.TopicError{
+					Err:    sarama.ErrTopicAlreadyExists,
+					ErrMsg: &errMsg,
+				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 10, 3),
+				},
+				ExpectedPartitionsCount: 20,
+				T:                       t,
+			}, nil
+		},
+	}
+
+	topicRet, err := r.CreateTopic(zap.NewNop(), topic, &broker.Config{
+		TopicDetail: sarama.TopicDetail{
+			NumPartitions:     20,
+			ReplicationFactor: 3,
+		},
+	})
+
+	assert.Equal(t, topicRet, topic, "expected topic %s go %s", topic, topicRet)
+	assert.Nil(t, err, "expected nil error on partitions increase")
+}
+
+func TestCreateTopicTopicAlreadyExistsDecreasePartitions(t *testing.T) {
+
+	b := &eventing.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bname",
+			Namespace: "bnamespace",
+		},
+	}
+	topic := broker.Topic(b)
+	errMsg := "topic already exists"
+
+	r := broker.Reconciler{
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return reconcilertesting.MockKafkaClusterAdmin{
+				ExpectedTopicName: topic,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     5,
+					ReplicationFactor: 3,
+				},
+				ErrorOnCreateTopic: &sarama.TopicError{
+					Err:    sarama.ErrTopicAlreadyExists,
+					ErrMsg: &errMsg,
+				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 10, 3),
+				},
+				T: t,
+			}, nil
+		},
+	}
+
+	topicRet, err := r.CreateTopic(zap.NewNop(), topic, &broker.Config{
+		TopicDetail: sarama.TopicDetail{
+			NumPartitions:     5,
+			ReplicationFactor: 3,
+		},
+	})
+
+	assert.Equal(t, topicRet, topic, "expected topic %s go %s", topic, topicRet)
+	assert.True(t, errors.Is(err, broker.ErrDecreasePartitions), "expected %v got %v", broker.ErrDecreasePartitions, err)
+}
+
+func TestCreateTopicTopicAlreadyExistsReplicationFactorDrift(t *testing.T) {
+
+	b := &eventing.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bname",
+			Namespace: "bnamespace",
+		},
+	}
+	topic := broker.Topic(b)
+	errMsg := "topic already exists"
+
+	r := broker.Reconciler{
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			return reconcilertesting.MockKafkaClusterAdmin{
+				ExpectedTopicName: topic,
+				ExpectedTopicDetail: sarama.TopicDetail{
+					NumPartitions:     10,
+					ReplicationFactor: 3,
+				},
+				ErrorOnCreateTopic: &sarama.TopicError{
+					Err:    sarama.ErrTopicAlreadyExists,
+					ErrMsg: &errMsg,
+				},
+				TopicsMetadata: []*sarama.TopicMetadata{
+					reconcilertesting.TopicMetadata(topic, 10, 1),
+				},
+				T: t,
+			}, nil
+		},
+	}
+
+	topicRet, err := r.CreateTopic(zap.NewNop(), topic, &broker.Config{
+		TopicDetail: sarama.TopicDetail{
+			NumPartitions:     10,
+			ReplicationFactor: 3,
+		},
+	})
+
+	assert.Equal(t, topicRet, topic, "expected topic %s go %s", topic, topicRet)
+	assert.True(t, errors.Is(err, broker.ErrTopicDrift), "expected %v got %v", broker.ErrTopicDrift, err)
+}
@@ -0,0 +1,376 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	admissionlisters "k8s.io/client-go/listers/admissionregistration/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/logging"
+	"knative.dev/pkg/apis"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	vwhinformer "knative.dev/pkg/client/injection/kube/informers/admissionregistration/v1/validatingwebhookconfiguration"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/kafka"
+)
+
+const (
+	// ValidationWebhookName is the name of the ValidatingWebhookConfiguration validating Kafka-class Brokers.
+	ValidationWebhookName = "validation.kafka-broker.eventing.knative.dev"
+
+	// ValidationWebhookPath is the path the validation webhook of Kafka-class Brokers is served at.
+	ValidationWebhookPath = "/validation/brokers"
+)
+
+// ErrBootstrapServersChanged is returned when an update of a Broker changes the bootstrap servers of the Broker.
+var ErrBootstrapServersChanged = errors.New("bootstrap servers of a Broker cannot be changed")
+
+// bootstrapServersChanged returns an ErrBootstrapServersChanged error for a Broker using the given bootstrap servers.
+func bootstrapServersChanged(used, desired string) error {
+	return fmt.Errorf(
+		"%w: the Broker uses the bootstrap servers %s, desired bootstrap servers %s - recreate the Broker to use different bootstrap servers",
+		ErrBootstrapServersChanged,
+		used,
+		desired,
+	)
+}
+
+// NewValidationAdmissionController creates the admission controller validating Kafka-class Brokers.
+//
+// Brokers are validated with the same rules the Broker reconciler applies, so that invalid Brokers are rejected at
+// admission time, instead of being reported only in the Broker status.
+// The controller keeps the CA bundle and the rules of the ValidationWebhookName ValidatingWebhookConfiguration up to
+// date.
+func NewValidationAdmissionController(ctx context.Context, _ configmap.Watcher, configs *EnvConfigs) *controller.Impl {
+
+	vwhInformer := vwhinformer.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	options := webhook.GetOptions(ctx)
+
+	key := types.NamespacedName{Name: ValidationWebhookName}
+
+	reconciler := &validationReconciler{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+				enq(bkt, key)
+				return nil
+			},
+		},
+		key:        key,
+		secretName: options.SecretName,
+		validator: &BrokerValidator{
+			ConfigMapLister: configmapinformer.Get(ctx).Lister(),
+			Configs:         configs,
+		},
+		client:       kubeclient.Get(ctx),
+		vwhLister:    vwhInformer.Lister(),
+		secretLister: secretInformer.Lister(),
+	}
+
+	impl := controller.NewImpl(reconciler, logging.FromContext(ctx).Sugar(), "KafkaBrokerValidationWebhook")
+
+	// The webhook configuration is the only key, so it's reconciled when either the webhook configuration or the
+	// secret holding the CA certificate changes.
+	vwhInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithName(ValidationWebhookName),
+		Handler:    controller.HandleAll(func(interface{}) { impl.EnqueueKey(key) }),
+	})
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(system.Namespace(), options.SecretName),
+		Handler:    controller.HandleAll(func(interface{}) { impl.EnqueueKey(key) }),
+	})
+
+	return impl
+}
+
+type validationReconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	key        types.NamespacedName
+	secretName string
+	validator  *BrokerValidator
+
+	client       kubernetes.Interface
+	vwhLister    admissionlisters.ValidatingWebhookConfigurationLister
+	secretLister corelisters.SecretLister
+}
+
+var _ controller.Reconciler = (*validationReconciler)(nil)
+var _ pkgreconciler.LeaderAware = (*validationReconciler)(nil)
+var _ webhook.AdmissionController = (*validationReconciler)(nil)
+
+// Path implements webhook.AdmissionController.
+func (r *validationReconciler) Path() string {
+	return ValidationWebhookPath
+}
+
+// Admit implements webhook.AdmissionController.
+func (r *validationReconciler) Admit(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	broker := &KafkaBroker{}
+	if err := json.Unmarshal(request.Object.Raw, &broker.Broker); err != nil {
+		return webhook.MakeErrorStatus("decoding request failed: cannot decode incoming new object: %v", err)
+	}
+
+	ctx = WithBrokerValidator(ctx, r.validator)
+
+	if request.Operation == admissionv1.Update {
+		original := &KafkaBroker{}
+		if err := json.Unmarshal(request.OldObject.Raw, &original.Broker); err != nil {
+			return webhook.MakeErrorStatus("decoding request failed: cannot decode incoming old object: %v", err)
+		}
+		ctx = apis.WithinUpdate(ctx, original)
+	} else {
+		ctx = apis.WithinCreate(ctx)
+	}
+
+	if err := broker.Validate(ctx); err != nil {
+		return webhook.MakeErrorStatus("validation failed: %v", err)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+// Reconcile implements controller.Reconciler.
+func (r *validationReconciler) Reconcile(ctx context.Context, _ string) error {
+	logger := logging.FromContext(ctx)
+
+	if !r.IsLeaderFor(r.key) {
+		logger.Debug("Skipping key, not the leader", zap.Any("key", r.key))
+		return nil
+	}
+
+	secret, err := r.secretLister.Secrets(system.Namespace()).Get(r.secretName)
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s/%s: %w", system.Namespace(), r.secretName, err)
+	}
+	caCert, ok := secret.Data[certresources.CACert]
+	if !ok {
+		return fmt.Errorf("secret %s/%s is missing %q key", system.Namespace(), r.secretName, certresources.CACert)
+	}
+
+	return r.reconcileValidatingWebhook(ctx, caCert)
+}
+
+func (r *validationReconciler) reconcileValidatingWebhook(ctx context.Context, caCert []byte) error {
+	logger := logging.FromContext(ctx)
+
+	current, err := r.vwhLister.Get(ValidationWebhookName)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook configuration %s: %w", ValidationWebhookName, err)
+	}
+
+	desired := current.DeepCopy()
+
+	path := r.Path()
+	rules := []admissionregistrationv1.RuleWithOperations{{
+		Operations: []admissionregistrationv1.OperationType{
+			admissionregistrationv1.Create,
+			admissionregistrationv1.Update,
+		},
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{eventing.SchemeGroupVersion.Group},
+			APIVersions: []string{eventing.SchemeGroupVersion.Version},
+			Resources:   []string{"brokers"},
+		},
+	}}
+
+	for i, wh := range desired.Webhooks {
+		if wh.Name != desired.Name {
+			continue
+		}
+		if wh.ClientConfig.Service == nil {
+			return fmt.Errorf("missing service reference for webhook: %s", wh.Name)
+		}
+		desired.Webhooks[i].Rules = rules
+		desired.Webhooks[i].ClientConfig.CABundle = caCert
+		desired.Webhooks[i].ClientConfig.Service.Path = &path
+	}
+
+	if equality.Semantic.DeepEqual(current, desired) {
+		logger.Debug("Webhook configuration is up to date")
+		return nil
+	}
+
+	logger.Info("Updating webhook configuration")
+	if _, err := r.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Update(desired); err != nil {
+		return fmt.Errorf("failed to update webhook configuration %s: %w", ValidationWebhookName, err)
+	}
+	return nil
+}
+
+// BrokerValidator validates Kafka-class Brokers against the config maps they reference.
+type BrokerValidator struct {
+	ConfigMapLister corelisters.ConfigMapLister
+	Configs         *EnvConfigs
+}
+
+type brokerValidatorKey struct{}
+
+// WithBrokerValidator returns a copy of the given context carrying the given BrokerValidator.
+func WithBrokerValidator(ctx context.Context, validator *BrokerValidator) context.Context {
+	return context.WithValue(ctx, brokerValidatorKey{}, validator)
+}
+
+func getBrokerValidator(ctx context.Context) *BrokerValidator {
+	validator, _ := ctx.Value(brokerValidatorKey{}).(*BrokerValidator)
+	return validator
+}
+
+// KafkaBroker is the Broker type validated by the validation webhook of Kafka-class Brokers.
+// Brokers of other classes are always accepted.
+type KafkaBroker struct {
+	eventing.Broker
+}
+
+var _ apis.Validatable = (*KafkaBroker)(nil)
+
+// Validate implements apis.Validatable.
+func (b *KafkaBroker) Validate(ctx context.Context) *apis.FieldError {
+	if b.GetAnnotations()[eventing.BrokerClassAnnotationKey] != kafka.BrokerClass {
+		return nil
+	}
+
+	// Deleted Brokers must be finalized, even though their config map is gone.
+	if b.DeletionTimestamp != nil {
+		return nil
+	}
+
+	validator := getBrokerValidator(ctx)
+	if validator == nil {
+		return nil
+	}
+
+	var original *eventing.Broker
+	if apis.IsInUpdate(ctx) {
+		if baseline, ok := apis.GetBaseline(ctx).(*KafkaBroker); ok {
+			original = &baseline.Broker
+		}
+	}
+
+	return validator.Validate(logging.FromContext(ctx), &b.Broker, original)
+}
+
+// Validate validates the given Broker, original is the Broker before the update, nil on creation.
+//
+// The config of updated Brokers is validated only when the config reference changes, so that Brokers referencing a
+// config map that has become invalid can still be updated, for example by their reconciler.
+func (v *BrokerValidator) Validate(logger *zap.Logger, broker *eventing.Broker, original *eventing.Broker) *apis.FieldError {
+
+	if original != nil && equality.Semantic.DeepEqual(original.Spec.Config, broker.Spec.Config) {
+		return v.validateTopic(broker, original)
+	}
+
+	// Brokers that don't reference any config use the general config map, which is validated by the controller.
+	if broker.Spec.Config != nil {
+		cm, err := brokerConfigMap(v.ConfigMapLister, broker)
+		if err != nil {
+			return apis.ErrGeneric(err.Error(), "spec.config")
+		}
+		if _, err := configFromConfigMap(logger, cm); err != nil {
+			return apis.ErrGeneric(err.Error(), "spec.config")
+		}
+	}
+
+	if original == nil {
+		return nil
+	}
+
+	// The original config might be invalid or gone, in that case there is nothing to compare the bootstrap servers
+	// with.
+	config, err := v.resolveConfig(logger, broker)
+	if err != nil {
+		logger.Debug("Failed to resolve broker config", zap.Error(err))
+		return v.validateTopic(broker, original)
+	}
+	originalConfig, err := v.resolveConfig(logger, original)
+	if err != nil {
+		logger.Debug("Failed to resolve original broker config", zap.Error(err))
+		return v.validateTopic(broker, original)
+	}
+
+	var errs *apis.FieldError
+	if originalConfig.getBootstrapServers() != config.getBootstrapServers() {
+		errs = apis.ErrGeneric(
+			bootstrapServersChanged(originalConfig.getBootstrapServers(), config.getBootstrapServers()).Error(),
+			"spec.config",
+		)
+	}
+
+	return errs.Also(v.validateTopic(broker, original))
+}
+
+// validateTopic checks that the update of a Broker doesn't change the topic the Broker uses.
+func (v *BrokerValidator) validateTopic(broker *eventing.Broker, original *eventing.Broker) *apis.FieldError {
+	if original == nil {
+		return nil
+	}
+
+	// The topic is recorded in the status of the original Broker, the status of the updated Broker is set by the
+	// client.
+	b := broker.DeepCopy()
+	b.Status = *original.Status.DeepCopy()
+
+	if err := checkTopicChange(b); err != nil {
+		return apis.ErrGeneric(err.Error(), fmt.Sprintf("metadata.annotations[%s]", ExternalTopicAnnotation))
+	}
+	return nil
+}
+
+// resolveConfig returns the config of the given Broker, the general config map is used when the Broker doesn't
+// reference any config.
+func (v *BrokerValidator) resolveConfig(logger *zap.Logger, broker *eventing.Broker) (*Config, error) {
+
+	if broker.Spec.Config == nil {
+		cm, err := v.ConfigMapLister.ConfigMaps(v.Configs.SystemNamespace).Get(v.Configs.GeneralConfigMapName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get configmap %s/%s: %w", v.Configs.SystemNamespace, v.Configs.GeneralConfigMapName, err)
+		}
+		return configFromConfigMap(logger, cm)
+	}
+
+	cm, err := brokerConfigMap(v.ConfigMapLister, broker)
+	if err != nil {
+		return nil, err
+	}
+	return configFromConfigMap(logger, cm)
+}
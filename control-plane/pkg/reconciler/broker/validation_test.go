@@ -0,0 +1,187 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker_test // different package name due to import cycles. (broker -> testing -> broker)
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	reconcilertesting "knative.dev/eventing/pkg/reconciler/testing/v1"
+
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+)
+
+func TestKafkaBrokerValidate(t *testing.T) {
+
+	brokerConfig := BrokerConfig(bootstrapServers, 10, 1)
+
+	otherServersConfig := BrokerConfig("server3:9092", 10, 1, func(cm *corev1.ConfigMap) {
+		cm.Name = "other-servers"
+	})
+
+	sameServersConfig := BrokerConfig(bootstrapServers, 20, 1, func(cm *corev1.ConfigMap) {
+		cm.Name = "same-servers"
+	})
+
+	invalidConfig := BrokerConfig("", 10, 1, func(cm *corev1.ConfigMap) {
+		cm.Name = "invalid"
+	})
+
+	generalConfig := BrokerConfig(bootstrapServers, 10, 1, func(cm *corev1.ConfigMap) {
+		cm.Namespace = DefaultConfigs.SystemNamespace
+		cm.Name = "kafka-broker-config"
+	})
+
+	withManagedTopic := func(broker *eventing.Broker) {
+		broker.Status.Annotations = map[string]string{ManagedTopicStatusAnnotation: GetTopic()}
+	}
+
+	tests := []struct {
+		name     string
+		broker   *eventing.Broker
+		original *eventing.Broker
+		wantErr  string
+	}{
+		{
+			name:   "create - valid config",
+			broker: NewBroker(WithBrokerConfig(KReference(brokerConfig))).(*eventing.Broker),
+		},
+		{
+			name:   "create - no config",
+			broker: NewBroker().(*eventing.Broker),
+		},
+		{
+			name: "create - other broker class",
+			broker: reconcilertesting.NewBroker(
+				BrokerName,
+				BrokerNamespace,
+				reconcilertesting.WithBrokerClass("MTChannelBasedBroker"),
+				WithBrokerConfig(&duckv1.KReference{Kind: "Secret", Name: "name"}),
+			),
+		},
+		{
+			name:    "create - unsupported kind",
+			broker:  NewBroker(WithBrokerConfig(&duckv1.KReference{Kind: "Secret", Name: "name"})).(*eventing.Broker),
+			wantErr: "supported config Kind: ConfigMap - got Secret: spec.config",
+		},
+		{
+			name: "create - config map not found",
+			broker: NewBroker(WithBrokerConfig(&duckv1.KReference{
+				Kind:      "ConfigMap",
+				Namespace: ConfigMapNamespace,
+				Name:      "not-found",
+			})).(*eventing.Broker),
+			wantErr: `failed to get configmap ` + ConfigMapNamespace + `/not-found: configmap "not-found" not found: spec.config`,
+		},
+		{
+			name:    "create - invalid config map",
+			broker:  NewBroker(WithBrokerConfig(KReference(invalidConfig))).(*eventing.Broker),
+			wantErr: "invalid configuration - numPartitions: 10 - replicationFactor: 1 - bootstrapServers: : spec.config",
+		},
+		{
+			name: "deleted - config map not found",
+			broker: NewDeletedBroker(WithBrokerConfig(&duckv1.KReference{
+				Kind: "ConfigMap",
+				Name: "not-found",
+			})).(*eventing.Broker),
+		},
+		{
+			name:     "update - config unchanged and invalid",
+			broker:   NewBroker(WithBrokerConfig(KReference(invalidConfig)), WithBrokerAnnotation("a", "b")).(*eventing.Broker),
+			original: NewBroker(WithBrokerConfig(KReference(invalidConfig))).(*eventing.Broker),
+		},
+		{
+			name:     "update - config with the same bootstrap servers",
+			broker:   NewBroker(WithBrokerConfig(KReference(sameServersConfig))).(*eventing.Broker),
+			original: NewBroker(WithBrokerConfig(KReference(brokerConfig))).(*eventing.Broker),
+		},
+		{
+			name:     "update - config with different bootstrap servers",
+			broker:   NewBroker(WithBrokerConfig(KReference(otherServersConfig))).(*eventing.Broker),
+			original: NewBroker(WithBrokerConfig(KReference(brokerConfig))).(*eventing.Broker),
+			wantErr:  ErrBootstrapServersChanged.Error(),
+		},
+		{
+			name:     "update - from the general config to a config with different bootstrap servers",
+			broker:   NewBroker(WithBrokerConfig(KReference(otherServersConfig))).(*eventing.Broker),
+			original: NewBroker().(*eventing.Broker),
+			wantErr:  ErrBootstrapServersChanged.Error(),
+		},
+		{
+			name:     "update - from an invalid config",
+			broker:   NewBroker(WithBrokerConfig(KReference(otherServersConfig))).(*eventing.Broker),
+			original: NewBroker(WithBrokerConfig(KReference(invalidConfig))).(*eventing.Broker),
+		},
+		{
+			name:     "update - to an invalid config",
+			broker:   NewBroker(WithBrokerConfig(KReference(invalidConfig))).(*eventing.Broker),
+			original: NewBroker(WithBrokerConfig(KReference(brokerConfig))).(*eventing.Broker),
+			wantErr:  "invalid configuration",
+		},
+		{
+			name:     "update - external topic of a Broker using a managed topic",
+			broker:   NewBroker(WithBrokerAnnotation(ExternalTopicAnnotation, "my-topic")).(*eventing.Broker),
+			original: NewBroker(withManagedTopic).(*eventing.Broker),
+			wantErr:  ErrTopicChanged.Error(),
+		},
+		{
+			name:     "update - external topic of a Broker without a topic",
+			broker:   NewBroker(WithBrokerAnnotation(ExternalTopicAnnotation, "my-topic")).(*eventing.Broker),
+			original: NewBroker().(*eventing.Broker),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctx := WithBrokerValidator(context.Background(), &BrokerValidator{
+				ConfigMapLister: newConfigMapLister(t, brokerConfig, otherServersConfig, sameServersConfig, invalidConfig, generalConfig),
+				Configs: &EnvConfigs{
+					SystemNamespace:      DefaultConfigs.SystemNamespace,
+					GeneralConfigMapName: generalConfig.Name,
+				},
+			})
+			if tt.original != nil {
+				ctx = apis.WithinUpdate(ctx, &KafkaBroker{Broker: *tt.original})
+			} else {
+				ctx = apis.WithinCreate(ctx)
+			}
+
+			err := (&KafkaBroker{Broker: *tt.broker}).Validate(ctx)
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKafkaBrokerValidateNoValidator(t *testing.T) {
+	b := NewBroker(WithBrokerConfig(&duckv1.KReference{Kind: "Secret"})).(*eventing.Broker)
+
+	assert.Nil(t, (&KafkaBroker{Broker: *b}).Validate(context.Background()))
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	"knative.dev/pkg/resolver"
+
+	brokerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
+	triggerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/trigger"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/kafka"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/trigger"
+)
+
+// Builder builds the data plane config from the Kafka-class Brokers and their Triggers in the informer caches.
+//
+// Brokers are built from the topic and the config recorded in their status, so Brokers that haven't been reconciled
+// yet are left out, and the Builder never connects to Kafka.
+type Builder struct {
+	// Reconciler is used to shard Brokers and to get the copies of auth secrets.
+	Reconciler *base.Reconciler
+
+	BrokerLister  eventinglisters.BrokerLister
+	TriggerLister eventinglisters.TriggerLister
+	Resolver      *resolver.URIResolver
+}
+
+// NewBuilder creates a Builder using the informers in the given context.
+func NewBuilder(ctx context.Context, r *base.Reconciler) *Builder {
+	return &Builder{
+		Reconciler:    r,
+		BrokerLister:  brokerinformer.Get(ctx).Lister(),
+		TriggerLister: triggerinformer.Get(ctx).Lister(),
+		// Brokers and Triggers are reconciled when their destinations change, so there is nothing to enqueue.
+		Resolver: resolver.NewURIResolver(ctx, func(types.NamespacedName) {}),
+	}
+}
+
+// Build returns the data plane config of the given shard, with no volume generation.
+//
+// Brokers and Triggers whose config can't be built are left out, since their reconcilers report the failure.
+func (b *Builder) Build(logger *zap.Logger, shard int) (*coreconfig.Brokers, error) {
+
+	brokers, err := b.BrokerLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list brokers: %w", err)
+	}
+	// Sort Brokers, so that the same resources are always built into the same data plane config.
+	sort.Slice(brokers, func(i, j int) bool {
+		if brokers[i].Namespace != brokers[j].Namespace {
+			return brokers[i].Namespace < brokers[j].Namespace
+		}
+		return brokers[i].Name < brokers[j].Name
+	})
+
+	brokersTriggers := &coreconfig.Brokers{}
+
+	for _, br := range brokers {
+		if !kafka.BrokerClassFilter()(br) || !br.GetDeletionTimestamp().IsZero() || b.Reconciler.Shard(br.UID) != shard {
+			continue
+		}
+
+		brokerConfig, ok, err := broker.RecordedBrokerConfig(b.Reconciler.KubeClient, b.Reconciler.SystemNamespace, b.Resolver, br)
+		if err != nil {
+			logger.Warn("Failed to build broker config, skip broker",
+				zap.String("broker", fmt.Sprintf("%s/%s", br.Namespace, br.Name)),
+				zap.Error(err),
+			)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		brokerConfig.Triggers, err = b.triggers(logger, br)
+		if err != nil {
+			return nil, err
+		}
+
+		brokersTriggers.Brokers = append(brokersTriggers.Brokers, brokerConfig)
+	}
+
+	return brokersTriggers, nil
+}
+
+// triggers returns the data plane config of the Triggers of the given Broker.
+func (b *Builder) triggers(logger *zap.Logger, br *eventing.Broker) ([]*coreconfig.Trigger, error) {
+
+	triggers, err := b.TriggerLister.Triggers(br.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list triggers in namespace %s: %w", br.Namespace, err)
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].Name < triggers[j].Name
+	})
+
+	var triggersConfig []*coreconfig.Trigger
+	for _, t := range triggers {
+		if t.Spec.Broker != br.Name || !t.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		triggerConfig, err := trigger.ContractTrigger(b.Resolver, br, t)
+		if err != nil {
+			logger.Warn("Failed to build trigger config, skip trigger",
+				zap.String("trigger", fmt.Sprintf("%s/%s", t.Namespace, t.Name)),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		triggersConfig = append(triggersConfig, triggerConfig)
+	}
+
+	return triggersConfig, nil
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	reconcilertesting "knative.dev/eventing/pkg/reconciler/testing/v1"
+	_ "knative.dev/pkg/client/injection/ducks/duck/v1/addressable/fake"
+	. "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/resolver"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/trigger"
+)
+
+func newTrigger(name string, uid types.UID, brokerName string, options ...reconcilertesting.TriggerOption) *eventing.Trigger {
+	return reconcilertesting.NewTrigger(
+		name,
+		BrokerNamespace,
+		brokerName,
+		append(
+			[]reconcilertesting.TriggerOption{
+				reconcilertesting.WithTriggerSubscriberURI(ServiceURL),
+				func(t *eventing.Trigger) {
+					t.UID = uid
+				},
+			},
+			options...,
+		)...,
+	)
+}
+
+func newIndexer(t *testing.T, objects ...runtime.Object) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range objects {
+		assert.Nil(t, indexer.Add(obj))
+	}
+	return indexer
+}
+
+func newBuilder(t *testing.T, objects ...runtime.Object) *Builder {
+	ctx, _ := SetupFakeContext(t)
+
+	var brokers, triggers []runtime.Object
+	for _, obj := range objects {
+		switch obj.(type) {
+		case *eventing.Broker:
+			brokers = append(brokers, obj)
+		case *eventing.Trigger:
+			triggers = append(triggers, obj)
+		}
+	}
+
+	return &Builder{
+		Reconciler: &base.Reconciler{
+			KubeClient:      fake.NewSimpleClientset(),
+			SystemNamespace: DefaultConfigs.SystemNamespace,
+		},
+		BrokerLister:  eventinglisters.NewBrokerLister(newIndexer(t, brokers...)),
+		TriggerLister: eventinglisters.NewTriggerLister(newIndexer(t, triggers...)),
+		Resolver:      resolver.NewURIResolver(ctx, func(types.NamespacedName) {}),
+	}
+}
+
+func TestBuild(t *testing.T) {
+
+	const bootstrapServers = "kafka-1:9092,kafka-2:9093"
+
+	reconciled := NewBroker(
+		ManagedTopicStatus(GetTopic()),
+		BootstrapServersStatus(bootstrapServers),
+	)
+
+	notReconciled := NewBroker(func(b *eventing.Broker) {
+		b.Name = "not-reconciled"
+		b.UID = "not-reconciled"
+	})
+
+	deleted := NewDeletedBroker(
+		ManagedTopicStatus(GetTopic()),
+		BootstrapServersStatus(bootstrapServers),
+		func(b *eventing.Broker) {
+			b.Name = "deleted"
+			b.UID = "deleted"
+		},
+	)
+
+	otherClass := reconcilertesting.NewBroker(
+		"other-class",
+		BrokerNamespace,
+		reconcilertesting.WithBrokerClass("MTChannelBasedBroker"),
+		ManagedTopicStatus(GetTopic()),
+		BootstrapServersStatus(bootstrapServers),
+	)
+
+	now := metav1.Now()
+
+	b := newBuilder(t,
+		reconciled,
+		notReconciled,
+		deleted,
+		otherClass,
+		newTrigger("t2", "t2", BrokerName, reconcilertesting.WithTriggerSubscriberURI("http://t2.example.com")),
+		newTrigger("t1", TriggerUUID, BrokerName),
+		newTrigger("deleted", "deleted", BrokerName, func(t *eventing.Trigger) {
+			t.DeletionTimestamp = &now
+		}),
+		newTrigger("other-broker", "other-broker", "other-class"),
+	)
+
+	got, err := b.Build(zap.NewNop(), 0)
+	assert.Nil(t, err)
+
+	want := &coreconfig.Brokers{
+		Brokers: []*coreconfig.Broker{
+			{
+				Id:               BrokerUUID,
+				Topic:            GetTopic(),
+				Path:             broker.Path(BrokerNamespace, BrokerName),
+				BootstrapServers: bootstrapServers,
+				Triggers: []*coreconfig.Trigger{
+					{
+						Destination: ServiceURL,
+						Id:          TriggerUUID,
+					},
+					{
+						Destination: "http://t2.example.com",
+						Id:          "t2",
+					},
+				},
+			},
+		},
+	}
+	assert.True(t, proto.Equal(want, got), "want %v got %v", want, got)
+}
+
+func TestBuildSkipsInvalidTriggers(t *testing.T) {
+
+	b := newBuilder(t,
+		NewBroker(
+			ManagedTopicStatus(GetTopic()),
+			BootstrapServersStatus("kafka-1:9092"),
+		),
+		newTrigger("t1", TriggerUUID, BrokerName, func(t *eventing.Trigger) {
+			t.Annotations = map[string]string{trigger.FiltersAnnotation: "invalid"}
+		}),
+	)
+
+	got, err := b.Build(zap.NewNop(), 0)
+	assert.Nil(t, err)
+	assert.Len(t, got.Brokers, 1)
+	assert.Empty(t, got.Brokers[0].Triggers)
+}
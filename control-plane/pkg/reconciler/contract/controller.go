@@ -0,0 +1,101 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/eventing/pkg/logging"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+)
+
+const (
+	ControllerAgentName = "kafka-broker-contract-controller"
+)
+
+// NewController creates the controller periodically repairing the data plane config, updates of the data plane config
+// are committed by the given ContractWriter, or by a ContractWriter owned by the controller when nil.
+//
+// The data plane config is repaired every configs.DataPlaneConfigResyncInterval by the leader only.
+func NewController(ctx context.Context, _ configmap.Watcher, configs *broker.EnvConfigs, contractWriter *base.ContractWriter) *controller.Impl {
+
+	if contractWriter == nil {
+		contractWriter = broker.NewContractWriter(ctx, configs)
+	}
+
+	// The data plane config map is the only key, every shard is repaired with it.
+	key := types.NamespacedName{
+		Namespace: configs.DataPlaneConfigMapNamespace,
+		Name:      configs.DataPlaneConfigMapName,
+	}
+
+	reconciler := &Reconciler{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+				enq(bkt, key)
+				return nil
+			},
+		},
+		Key:            key,
+		ContractWriter: contractWriter,
+		Builder:        NewBuilder(ctx, contractWriter.Reconciler),
+		Recorder:       newRecorder(ctx),
+		ResyncInterval: configs.DataPlaneConfigResyncInterval,
+	}
+
+	impl := controller.NewImpl(reconciler, logging.FromContext(ctx).Sugar(), "KafkaBrokerContract")
+
+	reconciler.EnqueueAfter = impl.EnqueueKeyAfter
+
+	return impl
+}
+
+// newRecorder creates an event recorder, like generated reconcilers do.
+func newRecorder(ctx context.Context) record.EventRecorder {
+	if recorder := controller.GetEventRecorder(ctx); recorder != nil {
+		return recorder
+	}
+
+	logger := logging.FromContext(ctx).Sugar()
+
+	eventBroadcaster := record.NewBroadcaster()
+	watches := []watch.Interface{
+		eventBroadcaster.StartLogging(logger.Named("event-broadcaster").Infof),
+		eventBroadcaster.StartRecordingToSink(
+			&typedcorev1.EventSinkImpl{Interface: kubeclient.Get(ctx).CoreV1().Events("")}),
+	}
+	go func() {
+		<-ctx.Done()
+		for _, w := range watches {
+			w.Stop()
+		}
+	}()
+
+	return eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: ControllerAgentName})
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/eventing/pkg/logging"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/metrics"
+	pkgreconciler "knative.dev/pkg/reconciler"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/kafka"
+)
+
+const (
+	// Reasons of the events, on the data plane config map, reporting repairs of the data plane config.
+	OrphansRemovedReason  = "OrphansRemoved"
+	EntriesRestoredReason = "EntriesRestored"
+)
+
+var (
+	// repairsM counts the data plane config entries changed by repairs.
+	repairsM = stats.Int64(
+		"data_plane_config_repairs",
+		"Number of data plane config entries removed or restored by repairs",
+		stats.UnitDimensionless,
+	)
+
+	// kindKey is broker or trigger, actionKey is removed or restored.
+	kindKey   = tag.MustNewKey("kind")
+	actionKey = tag.MustNewKey("action")
+)
+
+func init() {
+	if err := view.Register(&view.View{
+		Description: repairsM.Description(),
+		Measure:     repairsM,
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{kindKey, actionKey},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// Reconciler repairs the data plane config: it removes entries whose Broker or Trigger doesn't exist anymore, which
+// incremental updates leave behind when they miss a deletion, and it restores missing entries.
+type Reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	// Key is the key the Reconciler is the leader for.
+	Key types.NamespacedName
+
+	ContractWriter *base.ContractWriter
+	Builder        *Builder
+	Recorder       record.EventRecorder
+
+	// ResyncInterval is the time between two repairs.
+	ResyncInterval time.Duration
+	// EnqueueAfter enqueues the given key after the given delay.
+	EnqueueAfter func(key types.NamespacedName, delay time.Duration)
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+var _ pkgreconciler.LeaderAware = (*Reconciler)(nil)
+
+// Reconcile implements controller.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, _ string) error {
+	logger := logging.FromContext(ctx).With(zap.String("key", r.Key.String()))
+
+	if !r.IsLeaderFor(r.Key) {
+		logger.Debug("Skipping key, not the leader")
+		return nil
+	}
+
+	// Resources aren't watched, so repair the data plane config periodically.
+	defer r.EnqueueAfter(r.Key, r.ResyncInterval)
+
+	for shard := 0; shard < r.ContractWriter.Reconciler.NumShards(); shard++ {
+		if err := r.repair(ctx, logger, shard); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) repair(ctx context.Context, logger *zap.Logger, shard int) error {
+
+	logger = logger.With(zap.Int("shard", shard))
+
+	var repairs Repairs
+	_, err := r.ContractWriter.Update(logger, shard, base.ContractUpdate{
+		NotifyReceivers:   true,
+		NotifyDispatchers: true,
+		Mutate: func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error) {
+			if readErr != nil {
+				return false, fmt.Errorf("failed to get brokers and triggers: %w", readErr)
+			}
+
+			// The informer caches are read while no other update of the data plane config is committed, otherwise
+			// entries removed by a finalizer since would be restored, and entries added since would be removed.
+			desired, err := r.Builder.Build(logger, shard)
+			if err != nil {
+				return false, fmt.Errorf("failed to build data plane config of shard %d: %w", shard, err)
+			}
+			resources, err := r.resources()
+			if err != nil {
+				return false, err
+			}
+
+			repairs = Repair(brokersTriggers, desired, resources)
+			return !repairs.Empty(), nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to repair data plane config map %s: %w", r.ContractWriter.Reconciler.DataPlaneConfigMapShardAsString(shard), err)
+	}
+
+	if repairs.Empty() {
+		logger.Debug("Data plane config up to date")
+		return nil
+	}
+
+	logger.Info("Data plane config repaired",
+		zap.Strings("removedBrokers", repairs.RemovedBrokers),
+		zap.Strings("removedTriggers", repairs.RemovedTriggers),
+		zap.Strings("restoredBrokers", repairs.RestoredBrokers),
+		zap.Strings("restoredTriggers", repairs.RestoredTriggers),
+	)
+
+	r.report(ctx, shard, &repairs)
+
+	return nil
+}
+
+// report records events on the config map of the given shard and metrics about the given repairs.
+func (r *Reconciler) report(ctx context.Context, shard int, repairs *Repairs) {
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.ContractWriter.Reconciler.DataPlaneConfigMapNamespace,
+			Name:      r.ContractWriter.Reconciler.DataPlaneConfigMapShardName(shard),
+		},
+	}
+
+	if len(repairs.RemovedBrokers) > 0 || len(repairs.RemovedTriggers) > 0 {
+		r.Recorder.Eventf(configMap, corev1.EventTypeWarning, OrphansRemovedReason,
+			"Removed orphan brokers %v and orphan triggers %v",
+			repairs.RemovedBrokers,
+			repairs.RemovedTriggers,
+		)
+	}
+	if len(repairs.RestoredBrokers) > 0 || len(repairs.RestoredTriggers) > 0 {
+		r.Recorder.Eventf(configMap, corev1.EventTypeWarning, EntriesRestoredReason,
+			"Restored missing brokers %v and missing triggers %v",
+			repairs.RestoredBrokers,
+			repairs.RestoredTriggers,
+		)
+	}
+
+	recordRepairs(ctx, "broker", "removed", len(repairs.RemovedBrokers))
+	recordRepairs(ctx, "trigger", "removed", len(repairs.RemovedTriggers))
+	recordRepairs(ctx, "broker", "restored", len(repairs.RestoredBrokers))
+	recordRepairs(ctx, "trigger", "restored", len(repairs.RestoredTriggers))
+}
+
+func recordRepairs(ctx context.Context, kind, action string, n int) {
+	if n == 0 {
+		return
+	}
+	ctx, err := tag.New(ctx, tag.Insert(kindKey, kind), tag.Insert(actionKey, action))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, repairsM.M(int64(n)))
+}
+
+// listerResources are the Brokers and the Triggers in the informer caches.
+type listerResources struct {
+	// brokers are Kafka-class Brokers by UID.
+	brokers map[string]types.NamespacedName
+	// triggers are the Brokers of Triggers by Trigger UID.
+	triggers map[string]types.NamespacedName
+}
+
+func (r *Reconciler) resources() (Resources, error) {
+
+	brokers, err := r.Builder.BrokerLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list brokers: %w", err)
+	}
+	triggers, err := r.Builder.TriggerLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list triggers: %w", err)
+	}
+
+	resources := &listerResources{
+		brokers:  make(map[string]types.NamespacedName, len(brokers)),
+		triggers: make(map[string]types.NamespacedName, len(triggers)),
+	}
+	for _, b := range brokers {
+		// Deleted Brokers are left to their finalizer.
+		if kafka.BrokerClassFilter()(b) {
+			resources.brokers[string(b.UID)] = types.NamespacedName{Namespace: b.Namespace, Name: b.Name}
+		}
+	}
+	for _, t := range triggers {
+		resources.triggers[string(t.UID)] = types.NamespacedName{Namespace: t.Namespace, Name: t.Spec.Broker}
+	}
+
+	return resources, nil
+}
+
+func (r *listerResources) BrokerExists(uid string) bool {
+	_, ok := r.brokers[uid]
+	return ok
+}
+
+func (r *listerResources) TriggerExists(brokerUID, uid string) bool {
+	b, ok := r.brokers[brokerUID]
+	if !ok {
+		return false
+	}
+	return r.triggers[uid] == b
+}
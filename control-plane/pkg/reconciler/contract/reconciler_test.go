@@ -0,0 +1,225 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	pkgreconciler "knative.dev/pkg/reconciler"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+)
+
+const resyncInterval = time.Minute
+
+func newReconciler(t *testing.T, current *coreconfig.Brokers, objects ...runtime.Object) (*Reconciler, *fake.Clientset, *[]types.NamespacedName) {
+
+	client := fake.NewSimpleClientset()
+
+	r := &base.Reconciler{
+		KubeClient:                  client,
+		PodLister:                   corelisters.NewPodLister(newIndexer(t)),
+		DataPlaneConfigMapNamespace: DefaultConfigs.DataPlaneConfigMapNamespace,
+		DataPlaneConfigMapName:      DefaultConfigs.DataPlaneConfigMapName,
+		DataPlaneConfigFormat:       base.Protobuf,
+		SystemNamespace:             DefaultConfigs.SystemNamespace,
+	}
+
+	data, err := base.MarshalDataPlaneConfig(current, r.DataPlaneConfigFormat)
+	assert.Nil(t, err)
+	_, err = client.CoreV1().ConfigMaps(r.DataPlaneConfigMapNamespace).Create(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.DataPlaneConfigMapNamespace,
+			Name:      r.DataPlaneConfigMapName,
+		},
+		BinaryData: map[string][]byte{base.ConfigMapDataKey: data},
+	})
+	assert.Nil(t, err)
+	client.ClearActions()
+
+	builder := newBuilder(t, objects...)
+	builder.Reconciler = r
+
+	var enqueued []types.NamespacedName
+
+	return &Reconciler{
+		Key:            types.NamespacedName{Namespace: r.DataPlaneConfigMapNamespace, Name: r.DataPlaneConfigMapName},
+		ContractWriter: base.NewContractWriter(r, 0),
+		Builder:        builder,
+		Recorder:       record.NewFakeRecorder(10),
+		ResyncInterval: resyncInterval,
+		EnqueueAfter: func(key types.NamespacedName, delay time.Duration) {
+			assert.Equal(t, resyncInterval, delay)
+			enqueued = append(enqueued, key)
+		},
+	}, client, &enqueued
+}
+
+func getBrokers(t *testing.T, r *Reconciler) *coreconfig.Brokers {
+	br := r.ContractWriter.Reconciler
+
+	cm, err := br.KubeClient.CoreV1().ConfigMaps(br.DataPlaneConfigMapNamespace).Get(br.DataPlaneConfigMapName, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	brokers, err := br.GetDataPlaneConfigMapData(zap.NewNop(), cm)
+	assert.Nil(t, err)
+	return brokers
+}
+
+func events(recorder record.EventRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.(*record.FakeRecorder).Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestReconcile(t *testing.T) {
+
+	current := &coreconfig.Brokers{
+		Brokers: []*coreconfig.Broker{
+			{Id: "orphan", Topic: "knative-broker-orphan"},
+			{
+				Id:       BrokerUUID,
+				Topic:    GetTopic(),
+				Path:     broker.Path(BrokerNamespace, BrokerName),
+				Triggers: []*coreconfig.Trigger{{Id: "orphan-trigger", Destination: ServiceURL}},
+			},
+		},
+		VolumeGeneration: 1,
+	}
+
+	r, client, enqueued := newReconciler(t, current,
+		NewBroker(
+			ManagedTopicStatus(GetTopic()),
+			BootstrapServersStatus("kafka-1:9092"),
+		),
+		newTrigger("t1", TriggerUUID, BrokerName),
+	)
+	assert.Nil(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+
+	assert.Nil(t, r.Reconcile(context.Background(), r.Key.String()))
+
+	want := &coreconfig.Brokers{
+		Brokers: []*coreconfig.Broker{
+			{
+				Id:       BrokerUUID,
+				Topic:    GetTopic(),
+				Path:     broker.Path(BrokerNamespace, BrokerName),
+				Triggers: []*coreconfig.Trigger{{Id: TriggerUUID, Destination: ServiceURL}},
+			},
+		},
+		VolumeGeneration: 2,
+	}
+	got := getBrokers(t, r)
+	assert.True(t, proto.Equal(want, got), "want %v got %v", want, got)
+
+	recorded := events(r.Recorder)
+	assert.Len(t, recorded, 2)
+	assert.True(t, strings.HasPrefix(recorded[0], "Warning "+OrphansRemovedReason), recorded[0])
+	assert.Contains(t, recorded[0], "orphan-trigger")
+	assert.True(t, strings.HasPrefix(recorded[1], "Warning "+EntriesRestoredReason), recorded[1])
+	assert.Contains(t, recorded[1], TriggerUUID)
+
+	assert.Equal(t, []types.NamespacedName{r.Key}, *enqueued)
+
+	// The data plane config is up to date, so it isn't updated again.
+	client.ClearActions()
+	assert.Nil(t, r.Reconcile(context.Background(), r.Key.String()))
+
+	for _, action := range client.Actions() {
+		assert.False(t, action.Matches("update", "configmaps"), "unexpected action %v", action)
+	}
+	assert.Empty(t, events(r.Recorder))
+	assert.Len(t, *enqueued, 2)
+}
+
+func TestReconcileTriggerDeletedDuringRepair(t *testing.T) {
+
+	current := &coreconfig.Brokers{
+		Brokers: []*coreconfig.Broker{
+			{
+				Id:    BrokerUUID,
+				Topic: GetTopic(),
+				Path:  broker.Path(BrokerNamespace, BrokerName),
+			},
+		},
+		VolumeGeneration: 1,
+	}
+
+	r, client, _ := newReconciler(t, current,
+		NewBroker(
+			ManagedTopicStatus(GetTopic()),
+			BootstrapServersStatus("kafka-1:9092"),
+		),
+	)
+	trigger := newTrigger("t1", TriggerUUID, BrokerName)
+	triggers := newIndexer(t, trigger)
+	r.Builder.TriggerLister = eventinglisters.NewTriggerLister(triggers)
+	assert.Nil(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+
+	// The Trigger is deleted, and its entry removed by its finalizer, right before the data plane config is read for
+	// the repair.
+	client.PrependReactor("get", "configmaps", func(clientgotesting.Action) (bool, runtime.Object, error) {
+		assert.Nil(t, triggers.Delete(trigger))
+		return false, nil, nil
+	})
+
+	assert.Nil(t, r.Reconcile(context.Background(), r.Key.String()))
+
+	got := getBrokers(t, r)
+	assert.True(t, proto.Equal(current, got), "want %v got %v", current, got)
+	assert.Empty(t, events(r.Recorder))
+}
+
+func TestReconcileNotLeader(t *testing.T) {
+
+	current := &coreconfig.Brokers{
+		Brokers:          []*coreconfig.Broker{{Id: "orphan"}},
+		VolumeGeneration: 1,
+	}
+
+	r, client, enqueued := newReconciler(t, current)
+
+	assert.Nil(t, r.Reconcile(context.Background(), r.Key.String()))
+
+	assert.Empty(t, client.Actions())
+	assert.Empty(t, events(r.Recorder))
+	assert.Empty(t, *enqueued)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+// Resources reports whether the Brokers and the Triggers of data plane config entries exist.
+type Resources interface {
+	// BrokerExists returns true when the Broker with the given UID exists.
+	BrokerExists(uid string) bool
+	// TriggerExists returns true when the Trigger with the given UID exists and it refers to the Broker with the given
+	// UID.
+	TriggerExists(brokerUID, uid string) bool
+}
+
+// Repairs lists the IDs of the data plane config entries changed by a repair.
+type Repairs struct {
+	// RemovedBrokers and RemovedTriggers are orphan entries, whose resource doesn't exist.
+	RemovedBrokers  []string
+	RemovedTriggers []string
+
+	// RestoredBrokers and RestoredTriggers are missing entries of existing resources.
+	RestoredBrokers  []string
+	RestoredTriggers []string
+}
+
+// Empty returns true when nothing has been repaired.
+func (r *Repairs) Empty() bool {
+	return len(r.RemovedBrokers) == 0 &&
+		len(r.RemovedTriggers) == 0 &&
+		len(r.RestoredBrokers) == 0 &&
+		len(r.RestoredTriggers) == 0
+}
+
+// Repair makes the given current data plane config match the given desired data plane config, built from the
+// informer caches, and it returns what it changed.
+//
+// Entries missing from the desired data plane config are removed only when their resource doesn't exist, since the
+// desired data plane config leaves out resources whose config can't be built. Entries in both are left to the
+// reconcilers of their resources.
+func Repair(current, desired *coreconfig.Brokers, resources Resources) Repairs {
+
+	var repairs Repairs
+
+	desiredBrokers := make(map[string]*coreconfig.Broker, len(desired.Brokers))
+	for _, b := range desired.Brokers {
+		desiredBrokers[b.Id] = b
+	}
+
+	brokers := current.Brokers[:0]
+	for _, b := range current.Brokers {
+		desiredBroker, isDesired := desiredBrokers[b.Id]
+		if !isDesired && !resources.BrokerExists(b.Id) {
+			repairs.RemovedBrokers = append(repairs.RemovedBrokers, b.Id)
+			continue
+		}
+		delete(desiredBrokers, b.Id)
+
+		desiredTriggers := make(map[string]struct{}, len(desiredBroker.GetTriggers()))
+		for _, t := range desiredBroker.GetTriggers() {
+			desiredTriggers[t.Id] = struct{}{}
+		}
+
+		triggers := b.Triggers[:0]
+		existingTriggers := make(map[string]struct{}, len(b.Triggers))
+		for _, t := range b.Triggers {
+			if _, ok := desiredTriggers[t.Id]; !ok && !resources.TriggerExists(b.Id, t.Id) {
+				repairs.RemovedTriggers = append(repairs.RemovedTriggers, t.Id)
+				continue
+			}
+			existingTriggers[t.Id] = struct{}{}
+			triggers = append(triggers, t)
+		}
+
+		for _, t := range desiredBroker.GetTriggers() {
+			if _, ok := existingTriggers[t.Id]; !ok {
+				repairs.RestoredTriggers = append(repairs.RestoredTriggers, t.Id)
+				triggers = append(triggers, t)
+			}
+		}
+
+		if len(triggers) == 0 {
+			triggers = nil
+		}
+		b.Triggers = triggers
+		brokers = append(brokers, b)
+	}
+
+	// Keep the order of the desired data plane config, so that restored Brokers are always appended in the same order.
+	for _, b := range desired.Brokers {
+		if _, ok := desiredBrokers[b.Id]; ok {
+			repairs.RestoredBrokers = append(repairs.RestoredBrokers, b.Id)
+			brokers = append(brokers, b)
+		}
+	}
+
+	if len(brokers) == 0 {
+		brokers = nil
+	}
+	current.Brokers = brokers
+
+	return repairs
+}
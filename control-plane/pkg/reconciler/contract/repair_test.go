@@ -0,0 +1,152 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+type fakeResources struct {
+	brokers  map[string]struct{}
+	triggers map[string]string
+}
+
+func (r *fakeResources) BrokerExists(uid string) bool {
+	_, ok := r.brokers[uid]
+	return ok
+}
+
+func (r *fakeResources) TriggerExists(brokerUID, uid string) bool {
+	b, ok := r.triggers[uid]
+	return ok && b == brokerUID
+}
+
+func brokerEntry(id string, triggers ...string) *coreconfig.Broker {
+	b := &coreconfig.Broker{Id: id, Topic: "knative-broker-" + id}
+	for _, t := range triggers {
+		b.Triggers = append(b.Triggers, &coreconfig.Trigger{Id: t, Destination: "http://" + t})
+	}
+	return b
+}
+
+func TestRepair(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		current   *coreconfig.Brokers
+		desired   *coreconfig.Brokers
+		resources *fakeResources
+		want      *coreconfig.Brokers
+		repairs   Repairs
+	}{
+		{
+			name:      "up to date",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}, VolumeGeneration: 3},
+			desired:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			resources: &fakeResources{},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}, VolumeGeneration: 3},
+		},
+		{
+			name:      "empty",
+			current:   &coreconfig.Brokers{},
+			desired:   &coreconfig.Brokers{},
+			resources: &fakeResources{},
+			want:      &coreconfig.Brokers{},
+		},
+		{
+			name: "remove orphan broker",
+			current: &coreconfig.Brokers{Brokers: []*coreconfig.Broker{
+				brokerEntry("orphan", "t2"),
+				brokerEntry("b1", "t1"),
+			}},
+			desired:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			resources: &fakeResources{},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			repairs:   Repairs{RemovedBrokers: []string{"orphan"}},
+		},
+		{
+			name:      "remove last orphan broker",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("orphan")}},
+			desired:   &coreconfig.Brokers{},
+			resources: &fakeResources{},
+			want:      &coreconfig.Brokers{},
+			repairs:   Repairs{RemovedBrokers: []string{"orphan"}},
+		},
+		{
+			name:      "keep existing broker missing from desired",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			desired:   &coreconfig.Brokers{},
+			resources: &fakeResources{brokers: map[string]struct{}{"b1": {}}, triggers: map[string]string{"t1": "b1"}},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+		},
+		{
+			name:      "remove orphan triggers",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "orphan", "t1", "moved")}},
+			desired:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			resources: &fakeResources{triggers: map[string]string{"moved": "b2"}},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			repairs:   Repairs{RemovedTriggers: []string{"orphan", "moved"}},
+		},
+		{
+			name:      "remove last orphan trigger",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "orphan")}},
+			desired:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1")}},
+			resources: &fakeResources{},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1")}},
+			repairs:   Repairs{RemovedTriggers: []string{"orphan"}},
+		},
+		{
+			name:      "keep existing trigger missing from desired",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1", "t2")}},
+			desired:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			resources: &fakeResources{triggers: map[string]string{"t2": "b1"}},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1", "t2")}},
+		},
+		{
+			name:      "restore missing brokers and triggers",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b2", "t3")}},
+			desired:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1"), brokerEntry("b2", "t3", "t4")}},
+			resources: &fakeResources{},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b2", "t3", "t4"), brokerEntry("b1", "t1")}},
+			repairs: Repairs{
+				RestoredBrokers:  []string{"b1"},
+				RestoredTriggers: []string{"t4"},
+			},
+		},
+		{
+			name:      "entries in both are left alone",
+			current:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{{Id: "b1", Topic: "old", Triggers: []*coreconfig.Trigger{{Id: "t1", Destination: "old"}}}}},
+			desired:   &coreconfig.Brokers{Brokers: []*coreconfig.Broker{brokerEntry("b1", "t1")}},
+			resources: &fakeResources{},
+			want:      &coreconfig.Brokers{Brokers: []*coreconfig.Broker{{Id: "b1", Topic: "old", Triggers: []*coreconfig.Trigger{{Id: "t1", Destination: "old"}}}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repairs := Repair(tt.current, tt.desired, tt.resources)
+
+			assert.Equal(t, tt.repairs, repairs)
+			assert.True(t, proto.Equal(tt.want, tt.current), "want %v got %v", tt.want, tt.current)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/Shopify/sarama"
+)
+
+var _ sarama.Client = &MockKafkaClient{}
+
+// MockKafkaClient is a sarama Client returning offsets of a topic and a coordinator, other methods aren't implemented
+// except for health checks.
+type MockKafkaClient struct {
+	sarama.Client
+
+	ExpectedTopicName string
+
+	// Partitions and GetOffset
+	EndOffsets       map[int32]int64
+	ErrorOnGetOffset error
+
+	// GetOffset, offsets of partitions by time, partitions missing for a time don't have records since then.
+	OffsetsForTimes map[int64]map[int32]int64
+
+	// Coordinator
+	CoordinatorBroker  *sarama.Broker
+	ErrorOnCoordinator error
+
+	T *testing.T
+}
+
+func (m MockKafkaClient) Partitions(topic string) ([]int32, error) {
+	if topic != m.ExpectedTopicName {
+		m.T.Errorf("expected topic %s got %s", m.ExpectedTopicName, topic)
+	}
+
+	partitions := make([]int32, 0, len(m.EndOffsets))
+	for p := range m.EndOffsets {
+		partitions = append(partitions, p)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	return partitions, nil
+}
+
+func (m MockKafkaClient) GetOffset(topic string, partitionID int32, time int64) (int64, error) {
+	if topic != m.ExpectedTopicName {
+		m.T.Errorf("expected topic %s got %s", m.ExpectedTopicName, topic)
+	}
+
+	if time == sarama.OffsetNewest {
+		return m.EndOffsets[partitionID], m.ErrorOnGetOffset
+	}
+
+	offset, ok := m.OffsetsForTimes[time][partitionID]
+	if !ok {
+		return -1, m.ErrorOnGetOffset
+	}
+	return offset, m.ErrorOnGetOffset
+}
+
+func (m MockKafkaClient) Coordinator(consumerGroup string) (*sarama.Broker, error) {
+	return m.CoordinatorBroker, m.ErrorOnCoordinator
+}
+
+func (m MockKafkaClient) Close() error {
+	return nil
+}
+
+// RefreshController is used as health check by the cache of Client.
+func (m MockKafkaClient) RefreshController() (*sarama.Broker, error) {
+	return nil, nil
+}
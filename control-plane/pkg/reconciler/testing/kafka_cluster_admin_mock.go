@@ -20,6 +20,37 @@ type MockKafkaClusterAdmin struct {
 	// DeleteTopic
 	ErrorOnDeleteTopic error
 
+	// DescribeTopics
+	TopicsMetadata        []*sarama.TopicMetadata
+	ErrorOnDescribeTopics error
+
+	// CreatePartitions
+	ExpectedPartitionsCount int32
+	ErrorOnCreatePartitions error
+
+	// DescribeConfig
+	TopicConfigEntries    []sarama.ConfigEntry
+	ErrorOnDescribeConfig error
+
+	// AlterConfig
+	ExpectedConfigEntries map[string]*string
+	ErrorOnAlterConfig    error
+
+	// DescribeCluster
+	ErrorOnDescribeCluster error
+
+	// DescribeConsumerGroups
+	ExpectedConsumerGroups        []string
+	ConsumerGroupsDescriptions    []*sarama.GroupDescription
+	ErrorOnDescribeConsumerGroups error
+
+	// ListConsumerGroupOffsets
+	ConsumerGroupOffsets            *sarama.OffsetFetchResponse
+	ErrorOnListConsumerGroupOffsets error
+
+	// DeleteConsumerGroup
+	ErrorOnDeleteConsumerGroup error
+
 	T *testing.T
 }
 
@@ -40,7 +71,11 @@ func (m MockKafkaClusterAdmin) ListTopics() (map[string]sarama.TopicDetail, erro
 }
 
 func (m MockKafkaClusterAdmin) DescribeTopics(topics []string) (metadata []*sarama.TopicMetadata, err error) {
-	panic("implement me")
+	if len(topics) != 1 || topics[0] != m.ExpectedTopicName {
+		m.T.Errorf("expected topics [%s] got %v", m.ExpectedTopicName, topics)
+	}
+
+	return m.TopicsMetadata, m.ErrorOnDescribeTopics
 }
 
 func (m MockKafkaClusterAdmin) DeleteTopic(topic string) error {
@@ -52,7 +87,15 @@ func (m MockKafkaClusterAdmin) DeleteTopic(topic string) error {
 }
 
 func (m MockKafkaClusterAdmin) CreatePartitions(topic string, count int32, assignment [][]int32, validateOnly bool) error {
-	panic("implement me")
+	if topic != m.ExpectedTopicName {
+		m.T.Errorf("expected topic %s got %s", m.ExpectedTopicName, topic)
+	}
+
+	if count != m.ExpectedPartitionsCount {
+		m.T.Errorf("expected partitions count %d got %d", m.ExpectedPartitionsCount, count)
+	}
+
+	return m.ErrorOnCreatePartitions
 }
 
 func (m MockKafkaClusterAdmin) AlterPartitionReassignments(topic string, assignment [][]int32) error {
@@ -68,11 +111,31 @@ func (m MockKafkaClusterAdmin) DeleteRecords(topic string, partitionOffsets map[
 }
 
 func (m MockKafkaClusterAdmin) DescribeConfig(resource sarama.ConfigResource) ([]sarama.ConfigEntry, error) {
-	panic("implement me")
+	if resource.Type != sarama.TopicResource {
+		m.T.Errorf("expected resource type %v got %v", sarama.TopicResource, resource.Type)
+	}
+
+	if resource.Name != m.ExpectedTopicName {
+		m.T.Errorf("expected topic %s got %s", m.ExpectedTopicName, resource.Name)
+	}
+
+	return m.TopicConfigEntries, m.ErrorOnDescribeConfig
 }
 
 func (m MockKafkaClusterAdmin) AlterConfig(resourceType sarama.ConfigResourceType, name string, entries map[string]*string, validateOnly bool) error {
-	panic("implement me")
+	if resourceType != sarama.TopicResource {
+		m.T.Errorf("expected resource type %v got %v", sarama.TopicResource, resourceType)
+	}
+
+	if name != m.ExpectedTopicName {
+		m.T.Errorf("expected topic %s got %s", m.ExpectedTopicName, name)
+	}
+
+	if diff := cmp.Diff(entries, m.ExpectedConfigEntries); diff != "" {
+		m.T.Errorf("unexpected config entries (-want +got) %s", diff)
+	}
+
+	return m.ErrorOnAlterConfig
 }
 
 func (m MockKafkaClusterAdmin) CreateACL(resource sarama.Resource, acl sarama.Acl) error {
@@ -92,19 +155,38 @@ func (m MockKafkaClusterAdmin) ListConsumerGroups() (map[string]string, error) {
 }
 
 func (m MockKafkaClusterAdmin) DescribeConsumerGroups(groups []string) ([]*sarama.GroupDescription, error) {
-	panic("implement me")
+	if diff := cmp.Diff(m.ExpectedConsumerGroups, groups); diff != "" {
+		m.T.Errorf("unexpected consumer groups (-want +got) %s", diff)
+	}
+
+	return m.ConsumerGroupsDescriptions, m.ErrorOnDescribeConsumerGroups
 }
 
 func (m MockKafkaClusterAdmin) ListConsumerGroupOffsets(group string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
-	panic("implement me")
+	if len(m.ExpectedConsumerGroups) != 1 || group != m.ExpectedConsumerGroups[0] {
+		m.T.Errorf("expected consumer groups %v got %s", m.ExpectedConsumerGroups, group)
+	}
+
+	if _, ok := topicPartitions[m.ExpectedTopicName]; !ok || len(topicPartitions) != 1 {
+		m.T.Errorf("expected topic %s got %v", m.ExpectedTopicName, topicPartitions)
+	}
+
+	if m.ConsumerGroupOffsets == nil {
+		return &sarama.OffsetFetchResponse{}, m.ErrorOnListConsumerGroupOffsets
+	}
+	return m.ConsumerGroupOffsets, m.ErrorOnListConsumerGroupOffsets
 }
 
 func (m MockKafkaClusterAdmin) DeleteConsumerGroup(group string) error {
-	panic("implement me")
+	if len(m.ExpectedConsumerGroups) != 1 || group != m.ExpectedConsumerGroups[0] {
+		m.T.Errorf("expected consumer groups %v got %s", m.ExpectedConsumerGroups, group)
+	}
+
+	return m.ErrorOnDeleteConsumerGroup
 }
 
 func (m MockKafkaClusterAdmin) DescribeCluster() (brokers []*sarama.Broker, controllerID int32, err error) {
-	panic("implement me")
+	return nil, 0, m.ErrorOnDescribeCluster
 }
 
 func (m MockKafkaClusterAdmin) DescribeLogDirs(brokers []int32) (map[int32][]sarama.DescribeLogDirsResponseDirMetadata, error) {
@@ -114,3 +196,24 @@ func (m MockKafkaClusterAdmin) DescribeLogDirs(brokers []int32) (map[int32][]sar
 func (m MockKafkaClusterAdmin) Close() error {
 	return nil
 }
+
+// TopicMetadata returns the metadata of a topic with the given number of partitions and replication factor.
+func TopicMetadata(topic string, numPartitions int32, replicationFactor int16) *sarama.TopicMetadata {
+	partitions := make([]*sarama.PartitionMetadata, numPartitions)
+	for i := range partitions {
+		replicas := make([]int32, replicationFactor)
+		for j := range replicas {
+			replicas[j] = int32(j)
+		}
+		partitions[i] = &sarama.PartitionMetadata{
+			ID:       int32(i),
+			Replicas: replicas,
+			Isr:      replicas,
+		}
+	}
+
+	return &sarama.TopicMetadata{
+		Name:       topic,
+		Partitions: partitions,
+	}
+}
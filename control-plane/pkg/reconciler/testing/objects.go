@@ -19,9 +19,9 @@ package testing
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -55,7 +55,7 @@ const (
 )
 
 var (
-	Formats = []string{base.Protobuf, base.Json}
+	Formats = []string{base.Protobuf, base.Json, base.ProtobufGzip}
 )
 
 func GetTopic() string {
@@ -120,6 +120,42 @@ func ReceiverPodUpdate(namespace string, annotations map[string]string) clientgo
 	)
 }
 
+func NewRunningReceiverPod(namespace string, annotations map[string]string) runtime.Object {
+	pod := NewReceiverPod(namespace, annotations).(*corev1.Pod)
+	pod.Status.Phase = corev1.PodRunning
+	return pod
+}
+
+func RunningReceiverPodUpdate(namespace string, annotations map[string]string) clientgotesting.UpdateActionImpl {
+	return clientgotesting.NewUpdateAction(
+		schema.GroupVersionResource{
+			Group:    "*",
+			Version:  "v1",
+			Resource: "Pod",
+		},
+		namespace,
+		NewRunningReceiverPod(namespace, annotations),
+	)
+}
+
+func NewRunningDispatcherPod(namespace string, annotations map[string]string) runtime.Object {
+	pod := NewDispatcherPod(namespace, annotations).(*corev1.Pod)
+	pod.Status.Phase = corev1.PodRunning
+	return pod
+}
+
+func RunningDispatcherPodUpdate(namespace string, annotations map[string]string) clientgotesting.UpdateActionImpl {
+	return clientgotesting.NewUpdateAction(
+		schema.GroupVersionResource{
+			Group:    "*",
+			Version:  "v1",
+			Resource: "Pod",
+		},
+		namespace,
+		NewRunningDispatcherPod(namespace, annotations),
+	)
+}
+
 func NewService() *corev1.Service {
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
@@ -150,13 +186,7 @@ func NewConfigMap(configs *Configs, data []byte) runtime.Object {
 }
 
 func NewConfigMapFromBrokers(brokers *coreconfig.Brokers, configs *Configs) runtime.Object {
-	var data []byte
-	var err error
-	if configs.DataPlaneConfigFormat == base.Protobuf {
-		data, err = proto.Marshal(brokers)
-	} else {
-		data, err = json.Marshal(brokers)
-	}
+	data, err := base.MarshalDataPlaneConfig(brokers, configs.DataPlaneConfigFormat)
 	if err != nil {
 		panic(err)
 	}
@@ -219,14 +249,42 @@ func WithDelivery() func(*eventing.Broker) {
 	}
 }
 
+func WithRetry(retry int32, policy eventingduck.BackoffPolicyType, delay string) func(*eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		if broker.Spec.Delivery == nil {
+			broker.Spec.Delivery = &eventingduck.DeliverySpec{}
+		}
+		broker.Spec.Delivery.Retry = &retry
+		broker.Spec.Delivery.BackoffPolicy = &policy
+		broker.Spec.Delivery.BackoffDelay = &delay
+	}
+}
+
+func WithBrokerAnnotation(key, value string) func(*eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		annotations := broker.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string, 1)
+		}
+		annotations[key] = value
+		broker.SetAnnotations(annotations)
+	}
+}
+
+func WithDeletionTime(t time.Time) func(*eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		broker.DeletionTimestamp = &metav1.Time{Time: t}
+	}
+}
+
 func WithBrokerConfig(reference *duckv1.KReference) func(*eventing.Broker) {
 	return func(broker *eventing.Broker) {
 		broker.Spec.Config = reference
 	}
 }
 
-func BrokerConfig(bootstrapServers string, numPartitions, replicationFactor int) *corev1.ConfigMap {
-	return &corev1.ConfigMap{
+func BrokerConfig(bootstrapServers string, numPartitions, replicationFactor int, options ...func(*corev1.ConfigMap)) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: ConfigMapNamespace,
 			Name:      ConfigMapName,
@@ -237,6 +295,86 @@ func BrokerConfig(bootstrapServers string, numPartitions, replicationFactor int)
 			DefaultTopicNumPartitionConfigMapKey:      fmt.Sprintf("%d", numPartitions),
 		},
 	}
+
+	for _, opt := range options {
+		opt(cm)
+	}
+
+	return cm
+}
+
+func WithTopicConfig(name, value string) func(*corev1.ConfigMap) {
+	return func(cm *corev1.ConfigMap) {
+		cm.Data[DefaultTopicConfigConfigMapKeyPrefix+name] = value
+	}
+}
+
+func WithAuthSecret(name string) func(*corev1.ConfigMap) {
+	return func(cm *corev1.ConfigMap) {
+		cm.Data[AuthSecretNameConfigMapKey] = name
+	}
+}
+
+func AuthSecret(name string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ConfigMapNamespace,
+			Name:      name,
+		},
+		Data: data,
+	}
+}
+
+func AuthSecretCopy(configs *Configs, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: configs.SystemNamespace,
+			Name:      AuthSecretCopyName(BrokerUUID),
+			Labels: map[string]string{
+				AuthSecretBrokerLabel: BrokerUUID,
+			},
+		},
+		Data: data,
+	}
+}
+
+func AuthSecretCopyReference(configs *Configs, data map[string][]byte) *coreconfig.SecretReference {
+	return &coreconfig.SecretReference{
+		Namespace: configs.SystemNamespace,
+		Name:      AuthSecretCopyName(BrokerUUID),
+		Version:   SecretDataVersion(data),
+	}
+}
+
+func RetainedTopicsConfigMap(configs *Configs, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: configs.DataPlaneConfigMapNamespace,
+			Name:      configs.DataPlaneConfigMapName + RetainedTopicsConfigMapSuffix,
+		},
+		Data: data,
+	}
+}
+
+func RetainedTopicsConfigMapUpdate(configs *Configs, data map[string]string) clientgotesting.UpdateActionImpl {
+	return clientgotesting.NewUpdateAction(
+		schema.GroupVersionResource{
+			Group:    "*",
+			Version:  "v1",
+			Resource: "ConfigMap",
+		},
+		configs.DataPlaneConfigMapNamespace,
+		RetainedTopicsConfigMap(configs, data),
+	)
+}
+
+// RetainedTopic returns the retained topics config map value of a topic to delete at the given time.
+func RetainedTopic(bootstrapServers string, deleteAt time.Time) string {
+	servers, err := json.Marshal(strings.Split(bootstrapServers, ","))
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf(`{"bootstrapServers":%s,"deleteAt":"%s"}`, servers, deleteAt.UTC().Format(time.RFC3339))
 }
 
 func KReference(configMap *corev1.ConfigMap) *duckv1.KReference {
@@ -279,12 +417,59 @@ func ConfigParsed(broker *eventing.Broker) {
 	broker.GetConditionSet().Manage(broker.GetStatus()).MarkTrue(ConditionConfigParsed)
 }
 
+func DataPlaneReady(broker *eventing.Broker) {
+	broker.GetConditionSet().Manage(broker.GetStatus()).MarkTrue(ConditionDataPlaneReady)
+}
+
+func NoDataPlanePods(broker *eventing.Broker) {
+	broker.GetConditionSet().Manage(broker.GetStatus()).MarkUnknown(
+		ConditionDataPlaneReady,
+		"Waiting for receiver pods",
+		"no running receiver pods",
+	)
+}
+
+func DataPlaneNotReady(volumeGeneration uint64, acked, total int) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkUnknown(
+			ConditionDataPlaneReady,
+			"Waiting for receiver pods",
+			"%d of %d receiver pods applied volume generation %d",
+			acked,
+			total,
+			volumeGeneration,
+		)
+	}
+}
+
 func ConfigNotParsed(reason string) func(broker *eventing.Broker) {
 	return func(broker *eventing.Broker) {
 		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(ConditionConfigParsed, reason, "")
 	}
 }
 
+func BootstrapServersChangeRejected(err string) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(
+			ConditionConfigParsed,
+			"Bootstrap servers change rejected",
+			"%s",
+			err,
+		)
+	}
+}
+
+func UnsupportedDelivery(err string) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(
+			ConditionConfigParsed,
+			"Unsupported delivery spec",
+			"%s",
+			err,
+		)
+	}
+}
+
 func Addressable(configs *Configs) func(broker *eventing.Broker) {
 
 	return func(broker *eventing.Broker) {
@@ -310,6 +495,167 @@ func FailedToCreateTopic(broker *eventing.Broker) {
 
 }
 
+func FailedToCreateTopicWithError(err error) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(
+			ConditionTopicReady,
+			fmt.Sprintf("Failed to create topic: %s", GetTopic()),
+			"%v",
+			err,
+		)
+	}
+}
+
+func ExternalTopicReady(topic string) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkTrueWithReason(
+			ConditionTopicReady,
+			fmt.Sprintf("External topic %s found", topic),
+			"",
+		)
+	}
+}
+
+func FailedToGetExternalTopic(topic string, err error) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(
+			ConditionTopicReady,
+			fmt.Sprintf("Failed to get external topic: %s", topic),
+			"%v",
+			err,
+		)
+	}
+}
+
+func ExternalTopicRejected(topic string, err error) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(
+			ConditionTopicReady,
+			fmt.Sprintf("External topic %s rejected", topic),
+			"%v",
+			err,
+		)
+	}
+}
+
+func TopicPartitionsDecreaseUnsupported(current, desired int32) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkTrueWithReason(
+			ConditionTopicReady,
+			PartitionsDecreaseUnsupportedReason,
+			"%v: topic %s has %d partitions, desired %d",
+			ErrDecreasePartitions, GetTopic(), current, desired,
+		)
+	}
+}
+
+func TopicDrift(current, desired int16) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkTrueWithReason(
+			ConditionTopicReady,
+			fmt.Sprintf("Topic %s doesn't match the desired configuration", GetTopic()),
+			"%v: partition 0 of topic %s has replication factor %d, desired %d",
+			ErrTopicDrift, GetTopic(), current, desired,
+		)
+	}
+}
+
+func ManagedTopicStatus(topic string) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		if broker.Status.Annotations == nil {
+			broker.Status.Annotations = make(map[string]string, 1)
+		}
+		broker.Status.Annotations[ManagedTopicStatusAnnotation] = topic
+	}
+}
+
+func AuthSecretStatus(broker *eventing.Broker) {
+	if broker.Status.Annotations == nil {
+		broker.Status.Annotations = make(map[string]string, 1)
+	}
+	broker.Status.Annotations[AuthSecretStatusAnnotation] = AuthSecretCopyName(BrokerUUID)
+}
+
+func BootstrapServersStatus(bootstrapServers string) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		if broker.Status.Annotations == nil {
+			broker.Status.Annotations = make(map[string]string, 1)
+		}
+		broker.Status.Annotations[BootstrapServersStatusAnnotation] = bootstrapServers
+	}
+}
+
+func AuthSecretRefStatus(namespace, name string) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		if broker.Status.Annotations == nil {
+			broker.Status.Annotations = make(map[string]string, 1)
+		}
+		broker.Status.Annotations[AuthSecretRefStatusAnnotation] = namespace + "/" + name
+	}
+}
+
+func TopicDeletionPolicyStatus(policy TopicDeletionPolicy, gracePeriod time.Duration) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		if broker.Status.Annotations == nil {
+			broker.Status.Annotations = make(map[string]string, 2)
+		}
+		broker.Status.Annotations[TopicDeletionPolicyStatusAnnotation] = string(policy)
+		if gracePeriod != 0 {
+			broker.Status.Annotations[TopicDeletionGracePeriodStatusAnnotation] = gracePeriod.String()
+		}
+	}
+}
+
+func ExternalTopicStatus(topic string) func(broker *eventing.Broker) {
+	return func(broker *eventing.Broker) {
+		if broker.Status.Annotations == nil {
+			broker.Status.Annotations = make(map[string]string, 1)
+		}
+		broker.Status.Annotations[ExternalTopicStatusAnnotation] = topic
+	}
+}
+
+func TopicChangeRejected(recorded, desired string) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(
+			ConditionTopicReady,
+			"Topic change rejected",
+			"%v: the Broker uses the topic %s, desired topic %s (annotation %s) - recreate the Broker to use a different topic",
+			ErrTopicChanged, recorded, desired, ExternalTopicAnnotation,
+		)
+	}
+}
+
+func FailedToGetConfigMapData(configs *Configs, err string) func(broker *eventing.Broker) {
+
+	return func(broker *eventing.Broker) {
+
+		broker.GetConditionSet().Manage(broker.GetStatus()).MarkFalse(
+			ConditionConfigMapUpdated,
+			fmt.Sprintf(
+				"Failed to get brokers and trigger data from ConfigMap: %s",
+				configs.DataPlaneConfigMapAsString(),
+			),
+			"%s",
+			err,
+		)
+	}
+}
+
 func FailedToGetConfigMap(configs *Configs) func(broker *eventing.Broker) {
 
 	return func(broker *eventing.Broker) {
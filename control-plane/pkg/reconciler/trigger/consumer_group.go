@@ -0,0 +1,251 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/clusteradmin"
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	brokerreconciler "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+)
+
+const (
+	// DeleteConsumerGroupAnnotation is the annotation Triggers can set to "false" to keep their consumer group, and
+	// committed offsets, in Kafka once they're deleted.
+	DeleteConsumerGroupAnnotation = "kafka.eventing.knative.dev/consumer.group.delete"
+
+	// BootstrapServersStatusAnnotation records the bootstrap servers of the Kafka cluster of the consumer group of a
+	// Trigger, so that the consumer group is deleted even when the Broker is gone.
+	BootstrapServersStatusAnnotation = brokerreconciler.BootstrapServersStatusAnnotation
+
+	// AuthSecretRefStatusAnnotation records the auth secret of the Broker of a Trigger as namespace/name.
+	// The copy of the auth secret in the system namespace is deleted with the Broker, so the Broker's own reference is
+	// recorded.
+	AuthSecretRefStatusAnnotation = brokerreconciler.AuthSecretRefStatusAnnotation
+
+	// ConsumerGroupStable is the state of a consumer group whose members are assigned partitions.
+	ConsumerGroupStable = "Stable"
+
+	// ConsumerGroupEmpty is the state of a consumer group without members, which still has committed offsets.
+	ConsumerGroupEmpty = "Empty"
+
+	// ConsumerGroupDead is the state of a consumer group without members and without committed offsets.
+	ConsumerGroupDead = "Dead"
+
+	// consumerGroupCheckDelay is the delay after which a Trigger waiting for its consumer group is reconciled again.
+	consumerGroupCheckDelay = 10 * time.Second
+)
+
+// ConsumerGroup returns the consumer group id used by dispatcher pods for the given Trigger.
+func ConsumerGroup(trigger *eventing.Trigger) string {
+	return string(trigger.UID)
+}
+
+// describeConsumerGroup returns the state of the consumer group of the given Trigger, using the Kafka cluster of the
+// given Broker entry of the data plane config.
+func (r *Reconciler) describeConsumerGroup(broker *coreconfig.Broker, trigger *eventing.Trigger) (_ string, err error) {
+
+	kafkaClusterAdmin, release, err := r.getKafkaClusterAdmin(broker)
+	if err != nil {
+		return "", err
+	}
+	defer func() { release(err) }()
+
+	group := ConsumerGroup(trigger)
+
+	groups, err := kafkaClusterAdmin.DescribeConsumerGroups([]string{group})
+	if err != nil {
+		return "", err
+	}
+
+	for _, g := range groups {
+		if g.GroupId != group {
+			continue
+		}
+		if g.Err != sarama.ErrNoError {
+			return "", g.Err
+		}
+		return g.State, nil
+	}
+
+	return "", fmt.Errorf("consumer group %s not described", group)
+}
+
+// shouldDeleteConsumerGroup returns true when the consumer group of the given Trigger has to be deleted once the
+// Trigger is deleted.
+func shouldDeleteConsumerGroup(trigger *eventing.Trigger) bool {
+	return trigger.GetAnnotations()[DeleteConsumerGroupAnnotation] != "false"
+}
+
+// deleteConsumerGroup deletes the consumer group of the given Trigger, using the Kafka cluster of the given Broker entry
+// of the data plane config.
+//
+// Kafka rejects deleting a consumer group with active members, so it fails until dispatcher pods stop consuming for
+// the Trigger.
+func (r *Reconciler) deleteConsumerGroup(broker *coreconfig.Broker, trigger *eventing.Trigger) (err error) {
+
+	kafkaClusterAdmin, release, err := r.getKafkaClusterAdmin(broker)
+	if err != nil {
+		return err
+	}
+	defer func() { release(err) }()
+
+	group := ConsumerGroup(trigger)
+
+	err = kafkaClusterAdmin.DeleteConsumerGroup(group)
+	if err == sarama.ErrGroupIDNotFound {
+		// Dispatcher pods never joined the consumer group, or it's already deleted.
+		return nil
+	}
+	if err == sarama.ErrNonEmptyGroup {
+		return fmt.Errorf("consumer group %s still has active members: %w", group, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete consumer group %s: %w", group, err)
+	}
+	return nil
+}
+
+// recordKafkaCluster records the Kafka cluster of the given Broker entry of the data plane config on the Trigger
+// status.
+func recordKafkaCluster(trigger *eventing.Trigger, broker *eventing.Broker, brokerConfig *coreconfig.Broker) {
+	forgetKafkaCluster(trigger)
+
+	if brokerConfig.BootstrapServers == "" {
+		return
+	}
+
+	annotations := trigger.Status.Annotations
+	if annotations == nil {
+		annotations = make(map[string]string, 2)
+		trigger.Status.Annotations = annotations
+	}
+
+	annotations[BootstrapServersStatusAnnotation] = brokerConfig.BootstrapServers
+	if ref, ok := broker.Status.Annotations[brokerreconciler.AuthSecretRefStatusAnnotation]; ok {
+		annotations[AuthSecretRefStatusAnnotation] = ref
+	}
+}
+
+// recordedKafkaCluster returns the Kafka cluster recorded on the given Trigger status as a Broker entry of the data
+// plane config, it returns false when the Trigger has no valid recorded Kafka cluster.
+func recordedKafkaCluster(trigger *eventing.Trigger) (*coreconfig.Broker, bool) {
+	annotations := trigger.Status.Annotations
+
+	bootstrapServers := annotations[BootstrapServersStatusAnnotation]
+	if bootstrapServers == "" {
+		return nil, false
+	}
+
+	broker := &coreconfig.Broker{BootstrapServers: bootstrapServers}
+
+	if ref, ok := annotations[AuthSecretRefStatusAnnotation]; ok {
+		parts := strings.SplitN(ref, string(types.Separator), 2)
+		if len(parts) != 2 {
+			return nil, false
+		}
+		broker.AuthSecret = &coreconfig.SecretReference{
+			Namespace: parts[0],
+			Name:      parts[1],
+		}
+	}
+
+	return broker, true
+}
+
+// forgetKafkaCluster deletes the Kafka cluster recorded on the given Trigger status.
+func forgetKafkaCluster(trigger *eventing.Trigger) {
+	delete(trigger.Status.Annotations, BootstrapServersStatusAnnotation)
+	delete(trigger.Status.Annotations, AuthSecretRefStatusAnnotation)
+}
+
+// ClusterAdmins returns the cache of ClusterAdmin used by the reconciler.
+func (r *Reconciler) ClusterAdmins() *clusteradmin.Cache {
+	r.clusterAdminsOnce.Do(func() {
+		r.clusterAdmins = clusteradmin.NewCache(r.NewClusterAdmin)
+	})
+	return r.clusterAdmins
+}
+
+// getKafkaClusterAdmin returns a sarama ClusterAdmin connecting to the Kafka cluster of the given Broker entry of the
+// data plane config.
+func (r *Reconciler) getKafkaClusterAdmin(broker *coreconfig.Broker) (sarama.ClusterAdmin, clusteradmin.ReleaseFunc, error) {
+	secret, err := r.getAuthSecret(broker)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.ClusterAdmins().Get(bootstrapServers(broker), secret)
+}
+
+// Clients returns the cache of Client used by the reconciler.
+func (r *Reconciler) Clients() *clusteradmin.ClientCache {
+	r.clientsOnce.Do(func() {
+		r.clients = clusteradmin.NewClientCache(r.NewClient)
+	})
+	return r.clients
+}
+
+// getKafkaClient returns a sarama Client connecting to the Kafka cluster of the given Broker entry of the data plane
+// config.
+func (r *Reconciler) getKafkaClient(broker *coreconfig.Broker) (sarama.Client, clusteradmin.ReleaseFunc, error) {
+	secret, err := r.getAuthSecret(broker)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Clients().Get(bootstrapServers(broker), secret)
+}
+
+// getAuthSecret returns the auth secret of the given Broker entry of the data plane config, if any.
+//
+// The auth secret of the Broker entry is the copy in the system namespace, so Triggers don't need to resolve the
+// Broker config.
+func (r *Reconciler) getAuthSecret(broker *coreconfig.Broker) (*corev1.Secret, error) {
+	ref := broker.GetAuthSecret()
+	if ref == nil {
+		return nil, nil
+	}
+
+	// Secrets are read directly instead of being watched to not cache every Secret of the cluster.
+	secret, err := r.KubeClient.CoreV1().Secrets(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	return secret, nil
+}
+
+// isSecretNotFound returns true when the given error is caused by a missing auth secret.
+func isSecretNotFound(err error) bool {
+	var status *apierrors.StatusError
+	return errors.As(err, &status) && apierrors.IsNotFound(status)
+}
+
+func bootstrapServers(broker *coreconfig.Broker) []string {
+	return strings.Split(broker.BootstrapServers, ",")
+}
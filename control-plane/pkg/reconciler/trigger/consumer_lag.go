@@ -0,0 +1,265 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	brokerreconciler "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/kafka"
+)
+
+const (
+	// ConsumerLagAnnotation records the total lag of the consumer group of a Trigger.
+	ConsumerLagAnnotation = "kafka.eventing.knative.dev/consumer.lag"
+
+	// ConsumerMaxPartitionLagAnnotation records the lag of the partition with the highest lag.
+	ConsumerMaxPartitionLagAnnotation = "kafka.eventing.knative.dev/consumer.lag.max"
+
+	// ConsumerCommittedOffsetAnnotation records the sum of the offsets committed by the consumer group of a Trigger.
+	ConsumerCommittedOffsetAnnotation = "kafka.eventing.knative.dev/consumer.offset.committed"
+
+	// ConsumerLastCommitAnnotation records the time at which the committed offsets have been seen changing for the
+	// last time, in RFC 3339 format.
+	ConsumerLastCommitAnnotation = "kafka.eventing.knative.dev/consumer.offset.committed.time"
+
+	// consumerLagCheckInterval is the interval at which the consumer lag of ready Triggers is refreshed.
+	consumerLagCheckInterval = time.Minute
+)
+
+// ConsumerLag is the lag of the consumer group of a Trigger.
+type ConsumerLag struct {
+	// Total is the sum of the lag of every partition.
+	Total int64
+	// Max is the lag of the partition with the highest lag.
+	Max int64
+	// Committed is the sum of the offsets committed on every partition.
+	Committed int64
+}
+
+// RunConsumerLagMonitor periodically refreshes the consumer lag of ready Triggers, until the given context is done.
+// The consumer lag of a Trigger is updated only when isLeader returns true for it, so that a single replica updates it.
+func (r *Reconciler) RunConsumerLagMonitor(ctx context.Context, logger *zap.Logger, isLeader func(key types.NamespacedName) bool) {
+
+	ticker := time.NewTicker(consumerLagCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.UpdateConsumerLags(logger, isLeader)
+		}
+	}
+}
+
+// UpdateConsumerLags refreshes the consumer lag of ready Triggers of every Kafka-class Broker.
+//
+// End offsets of the topic of a Broker are fetched once for all its Triggers, and connections to Kafka clusters are
+// shared with the reconciler.
+func (r *Reconciler) UpdateConsumerLags(logger *zap.Logger, isLeader func(key types.NamespacedName) bool) {
+
+	brokers, err := r.BrokerLister.List(labels.Everything())
+	if err != nil {
+		logger.Warn("Failed to list brokers", zap.Error(err))
+		return
+	}
+
+	for _, broker := range brokers {
+		if !kafka.BrokerClassFilter()(broker) || !broker.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if err := r.updateConsumerLags(logger, broker, isLeader); err != nil {
+			// The consumer lag is informative, so failing to get it doesn't affect the Trigger readiness.
+			logger.Warn("Failed to update consumer lag",
+				zap.String("broker", fmt.Sprintf("%s/%s", broker.Namespace, broker.Name)),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// updateConsumerLags refreshes the consumer lag of ready Triggers of the given Broker.
+func (r *Reconciler) updateConsumerLags(logger *zap.Logger, broker *eventing.Broker, isLeader func(key types.NamespacedName) bool) error {
+
+	triggers, err := r.TriggerLister.Triggers(broker.Namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list triggers: %w", err)
+	}
+
+	var ready []*eventing.Trigger
+	for _, t := range triggers {
+		if t.Spec.Broker != broker.Name || !t.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if !t.Status.GetCondition(ConditionConsumerGroupReady).IsTrue() {
+			continue
+		}
+		if !isLeader(types.NamespacedName{Namespace: t.Namespace, Name: t.Name}) {
+			continue
+		}
+		ready = append(ready, t)
+	}
+	if len(ready) == 0 {
+		return nil
+	}
+
+	// Use the recorded config of the Broker, so that lags are fetched from the cluster the data plane consumes from.
+	brokerConfig, ok, err := brokerreconciler.RecordedBrokerConfig(r.KubeClient, r.SystemNamespace, r.Resolver, broker)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	endOffsets, err := r.endOffsets(brokerConfig)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range ready {
+		lag, err := r.consumerLag(brokerConfig, endOffsets, t)
+		if err != nil {
+			logger.Warn("Failed to get consumer lag",
+				zap.String("trigger", fmt.Sprintf("%s/%s", t.Namespace, t.Name)),
+				zap.String("group", ConsumerGroup(t)),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if err := r.updateConsumerLag(t, lag); err != nil {
+			logger.Warn("Failed to update consumer lag",
+				zap.String("trigger", fmt.Sprintf("%s/%s", t.Namespace, t.Name)),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// updateConsumerLag records the given lag in the status of the given Trigger.
+//
+// The update is skipped on conflicts, since the lag is refreshed periodically.
+func (r *Reconciler) updateConsumerLag(trigger *eventing.Trigger, lag ConsumerLag) error {
+
+	updated := trigger.DeepCopy()
+	statusConditionManager := statusConditionManager{Trigger: updated}
+	statusConditionManager.consumerLag(lag, r.Now())
+
+	if equality.Semantic.DeepEqual(trigger.Status.Annotations, updated.Status.Annotations) {
+		return nil
+	}
+
+	_, err := r.EventingClient.EventingV1().Triggers(updated.Namespace).UpdateStatus(updated)
+	return err
+}
+
+// consumerLag computes the lag of the consumer group of the given Trigger, using the given end offsets of the topic of
+// the given Broker entry of the data plane config.
+//
+// Dispatchers start consuming partitions without a committed offset from the end of the partition, so these partitions
+// don't lag.
+func (r *Reconciler) consumerLag(broker *coreconfig.Broker, endOffsets map[int32]int64, trigger *eventing.Trigger) (_ ConsumerLag, err error) {
+
+	kafkaClusterAdmin, release, err := r.getKafkaClusterAdmin(broker)
+	if err != nil {
+		return ConsumerLag{}, err
+	}
+	defer func() { release(err) }()
+
+	partitions := make([]int32, 0, len(endOffsets))
+	for partition := range endOffsets {
+		partitions = append(partitions, partition)
+	}
+
+	offsets, err := kafkaClusterAdmin.ListConsumerGroupOffsets(
+		ConsumerGroup(trigger),
+		map[string][]int32{broker.Topic: partitions},
+	)
+	if err != nil {
+		return ConsumerLag{}, fmt.Errorf("failed to list consumer group offsets: %w", err)
+	}
+	if offsets.Err != sarama.ErrNoError {
+		return ConsumerLag{}, fmt.Errorf("failed to list consumer group offsets: %w", offsets.Err)
+	}
+
+	lag := ConsumerLag{}
+	for partition, endOffset := range endOffsets {
+		block := offsets.GetBlock(broker.Topic, partition)
+		if block == nil || block.Offset < 0 {
+			continue
+		}
+		if block.Err != sarama.ErrNoError {
+			return ConsumerLag{}, fmt.Errorf("failed to get committed offset of partition %d: %w", partition, block.Err)
+		}
+
+		partitionLag := endOffset - block.Offset
+		if partitionLag < 0 {
+			partitionLag = 0
+		}
+
+		lag.Total += partitionLag
+		lag.Committed += block.Offset
+		if partitionLag > lag.Max {
+			lag.Max = partitionLag
+		}
+	}
+
+	return lag, nil
+}
+
+// endOffsets returns the offsets of the next record of every partition of the topic of the given Broker entry of the
+// data plane config.
+func (r *Reconciler) endOffsets(broker *coreconfig.Broker) (_ map[int32]int64, err error) {
+
+	client, release, err := r.getKafkaClient(broker)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(err) }()
+
+	partitions, err := client.Partitions(broker.Topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partitions of topic %s: %w", broker.Topic, err)
+	}
+
+	endOffsets := make(map[int32]int64, len(partitions))
+	for _, partition := range partitions {
+		offset, err := client.GetOffset(broker.Topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get end offset of partition %d of topic %s: %w", partition, broker.Topic, err)
+		}
+		endOffsets[partition] = offset
+	}
+
+	return endOffsets, nil
+}
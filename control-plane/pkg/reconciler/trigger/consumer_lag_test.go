@@ -0,0 +1,142 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	fakeeventingclientset "knative.dev/eventing/pkg/client/clientset/versioned/fake"
+	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	reconcilertesting "knative.dev/eventing/pkg/reconciler/testing/v1"
+
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+)
+
+// consumerGroupsClusterAdmin is a sarama ClusterAdmin returning the committed offsets of multiple consumer groups.
+type consumerGroupsClusterAdmin struct {
+	sarama.ClusterAdmin
+
+	offsets map[string]*sarama.OffsetFetchResponse
+}
+
+func (a *consumerGroupsClusterAdmin) ListConsumerGroupOffsets(group string, _ map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	if offsets, ok := a.offsets[group]; ok {
+		return offsets, nil
+	}
+	return &sarama.OffsetFetchResponse{}, nil
+}
+
+func newLagTrigger(name, uid string, options ...reconcilertesting.TriggerOption) *eventing.Trigger {
+	trigger := newTrigger(options...).(*eventing.Trigger)
+	trigger.Name = name
+	trigger.UID = types.UID(uid)
+	return trigger
+}
+
+func TestUpdateConsumerLags(t *testing.T) {
+
+	now := time.Date(2020, 11, 1, 8, 0, 0, 0, time.UTC)
+
+	broker := NewBroker(
+		ManagedTopicStatus(GetTopic()),
+		BootstrapServersStatus("kafka-1:9092"),
+	)
+
+	triggers := []runtime.Object{
+		newLagTrigger("ready", "ready", withConsumerGroupReady),
+		newLagTrigger("unchanged", "unchanged", withConsumerGroupReady, withConsumerLag(10, 8, 7, now.Add(-time.Hour))),
+		newLagTrigger("not-ready", "not-ready"),
+		newLagTrigger("not-leader", "not-leader", withConsumerGroupReady),
+	}
+
+	brokerIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	assert.Nil(t, brokerIndexer.Add(broker))
+	triggerIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, trigger := range triggers {
+		assert.Nil(t, triggerIndexer.Add(trigger))
+	}
+
+	eventingClient := fakeeventingclientset.NewSimpleClientset(triggers...)
+
+	offsets := offsetFetchResponse(map[int32]int64{
+		0: 4,
+		1: 3,
+		2: -1, // partitions without a committed offset are consumed from the end
+	})
+
+	clients := 0
+	r := &Reconciler{
+		Reconciler: &base.Reconciler{
+			KubeClient:      fake.NewSimpleClientset(),
+			SystemNamespace: "knative-eventing",
+		},
+		BrokerLister:   eventinglisters.NewBrokerLister(brokerIndexer),
+		TriggerLister:  eventinglisters.NewTriggerLister(triggerIndexer),
+		EventingClient: eventingClient,
+		NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+			assert.Equal(t, []string{"kafka-1:9092"}, addrs)
+			return &consumerGroupsClusterAdmin{
+				offsets: map[string]*sarama.OffsetFetchResponse{
+					"ready":     offsets,
+					"unchanged": offsets,
+				},
+			}, nil
+		},
+		NewClient: func(addrs []string, config *sarama.Config) (sarama.Client, error) {
+			assert.Equal(t, []string{"kafka-1:9092"}, addrs)
+			clients++
+			return &MockKafkaClient{
+				ExpectedTopicName: GetTopic(),
+				EndOffsets:        map[int32]int64{0: 10, 1: 5, 2: 7},
+				T:                 t,
+			}, nil
+		},
+		Now: func() time.Time { return now },
+	}
+
+	isLeader := func(key types.NamespacedName) bool {
+		return key.Name != "not-leader"
+	}
+
+	r.UpdateConsumerLags(zap.NewNop(), isLeader)
+	r.UpdateConsumerLags(zap.NewNop(), isLeader)
+
+	// End offsets are fetched with a single cached client.
+	assert.Equal(t, 1, clients)
+
+	for name, want := range map[string]map[string]string{
+		"ready":      newLagTrigger("", "", withConsumerLag(8, 6, 7, now)).Status.Annotations,
+		"unchanged":  newLagTrigger("", "", withConsumerLag(8, 6, 7, now.Add(-time.Hour))).Status.Annotations,
+		"not-ready":  nil,
+		"not-leader": nil,
+	} {
+		got, err := eventingClient.EventingV1().Triggers(BrokerNamespace).Get(name, metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, want, got.Status.Annotations, name)
+	}
+}
@@ -18,7 +18,9 @@ package trigger
 
 import (
 	"context"
+	"time"
 
+	"github.com/Shopify/sarama"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
@@ -48,7 +50,9 @@ const (
 	FinalizerName = "kafka.triggers.eventing.knative.dev"
 )
 
-func NewController(ctx context.Context, _ configmap.Watcher, configs *broker.EnvConfigs) *controller.Impl {
+// NewController creates the Trigger controller, updates of the data plane config are committed by the given
+// ContractWriter, or by a ContractWriter owned by the controller when nil.
+func NewController(ctx context.Context, _ configmap.Watcher, configs *broker.EnvConfigs, contractWriter *base.ContractWriter) *controller.Impl {
 
 	logger := logging.FromContext(ctx)
 
@@ -56,6 +60,10 @@ func NewController(ctx context.Context, _ configmap.Watcher, configs *broker.Env
 	triggerInformer := triggerinformer.Get(ctx)
 	triggerLister := triggerInformer.Lister()
 
+	if contractWriter == nil {
+		contractWriter = broker.NewContractWriter(ctx, configs)
+	}
+
 	reconciler := &Reconciler{
 		Reconciler: &base.Reconciler{
 			KubeClient:                  kubeclient.Get(ctx),
@@ -64,10 +72,16 @@ func NewController(ctx context.Context, _ configmap.Watcher, configs *broker.Env
 			DataPlaneConfigMapName:      configs.DataPlaneConfigMapName,
 			DataPlaneConfigFormat:       configs.DataPlaneConfigFormat,
 			SystemNamespace:             configs.SystemNamespace,
+			DataPlaneConfigMapShards:    configs.DataPlaneConfigMapShards,
+			ContractWriter:              contractWriter,
 		},
-		BrokerLister:   brokerInformer.Lister(),
-		EventingClient: eventingclient.Get(ctx),
-		Configs:        configs,
+		BrokerLister:    brokerInformer.Lister(),
+		TriggerLister:   triggerLister,
+		EventingClient:  eventingclient.Get(ctx),
+		NewClusterAdmin: sarama.NewClusterAdmin,
+		NewClient:       sarama.NewClient,
+		Now:             time.Now,
+		Configs:         configs,
 	}
 
 	impl := triggerreconciler.NewImpl(ctx, reconciler, func(impl *controller.Impl) controller.Options {
@@ -79,18 +93,28 @@ func NewController(ctx context.Context, _ configmap.Watcher, configs *broker.Env
 	})
 
 	reconciler.Resolver = resolver.NewURIResolver(ctx, impl.EnqueueKey)
+	reconciler.EnqueueAfter = impl.EnqueueKeyAfter
 
 	triggerInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: filterTriggers(reconciler.BrokerLister),
 		Handler:    controller.HandleAll(impl.Enqueue),
 	})
 
+	// Triggers waiting for dispatcher pods to apply the volume generation carrying them are enqueued when pods change.
+	podinformer.Get(ctx).Informer().AddEventHandler(reconciler.DataPlanePodsHandler(base.DispatcherLabel, impl.EnqueueKey))
+
 	// Filter Brokers and enqueue associated Triggers
 	brokerInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: kafka.BrokerClassFilter(),
 		Handler:    enqueueTriggers(logger, triggerLister, impl.Enqueue),
 	})
 
+	go reconciler.ClusterAdmins().Run(ctx)
+	go reconciler.Clients().Run(ctx)
+
+	// Consumer lag changes aren't watched, so refresh the consumer lag of ready Triggers periodically.
+	go reconciler.RunConsumerLagMonitor(ctx, logger, base.IsLeaderForFunc(impl))
+
 	return impl
 }
 
@@ -37,7 +37,7 @@ import (
 func TestNewController(t *testing.T) {
 	ctx, _ := reconcilertesting.SetupFakeContext(t)
 
-	controller := NewController(ctx, configmap.NewStaticWatcher(), &brokerreconciler.EnvConfigs{})
+	controller := NewController(ctx, configmap.NewStaticWatcher(), &brokerreconciler.EnvConfigs{}, nil)
 	if controller == nil {
 		t.Error("failed to create controller: <nil>")
 	}
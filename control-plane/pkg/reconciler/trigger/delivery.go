@@ -0,0 +1,143 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rickb777/date/period"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+)
+
+const (
+	// DeliveryAnnotation is the annotation Triggers set to override the delivery spec of their Broker.
+	// Its value is a JSON delivery spec, fields that aren't set are inherited from the delivery spec of the Broker.
+	//
+	// Example:
+	//   kafka.eventing.knative.dev/delivery: |
+	//     {"deadLetterSink": {"uri": "http://dls.ns.svc.cluster.local"}, "retry": 5, "timeout": "PT10S"}
+	DeliveryAnnotation = "kafka.eventing.knative.dev/delivery"
+
+	// DeliveryOrderAnnotation is the annotation Triggers set to choose the order in which events are sent to their
+	// subscriber, see DeliveryOrderUnordered and DeliveryOrderOrdered.
+	DeliveryOrderAnnotation = "kafka.eventing.knative.dev/delivery.order"
+
+	// DeliveryOrderUnordered sends events concurrently, it's the default delivery order.
+	DeliveryOrderUnordered = "unordered"
+	// DeliveryOrderOrdered sends events of the same partition one at a time, so that the subscriber sees events with
+	// the same key in order.
+	DeliveryOrderOrdered = "ordered"
+)
+
+// DeliverySpec is the delivery spec of a Trigger.
+type DeliverySpec struct {
+	eventingduck.DeliverySpec
+
+	// Timeout is the timeout of each request sent to the subscriber, in ISO 8601 format.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// deliverySpec returns the delivery spec of the given Trigger, nil when the Trigger inherits the delivery spec of its
+// Broker.
+func deliverySpec(trigger *eventing.Trigger) (*DeliverySpec, error) {
+	value, ok := trigger.GetAnnotations()[DeliveryAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	delivery := &DeliverySpec{}
+	if err := json.Unmarshal([]byte(value), delivery); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation %s: %w", DeliveryAnnotation, err)
+	}
+	return delivery, nil
+}
+
+// egressConfig returns the egress config of the data plane config of the given Trigger delivery spec, fields that
+// the Trigger doesn't set are inherited from the given Broker delivery spec.
+func egressConfig(broker *eventingduck.DeliverySpec, trigger *DeliverySpec) (*coreconfig.EgressConfig, error) {
+
+	delivery := eventingduck.DeliverySpec{}
+	if broker != nil {
+		delivery.Retry = broker.Retry
+		delivery.BackoffPolicy = broker.BackoffPolicy
+		delivery.BackoffDelay = broker.BackoffDelay
+	}
+	if trigger.Retry != nil {
+		delivery.Retry = trigger.Retry
+	}
+	if trigger.BackoffPolicy != nil {
+		delivery.BackoffPolicy = trigger.BackoffPolicy
+	}
+	if trigger.BackoffDelay != nil {
+		delivery.BackoffDelay = trigger.BackoffDelay
+	}
+
+	egressConfig, err := base.EgressConfig(&delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	if trigger.Timeout == nil {
+		return egressConfig, nil
+	}
+
+	p, err := period.Parse(*trigger.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported delivery spec: failed to parse timeout: %w", err)
+	}
+	timeout, _ := p.Duration()
+	if timeout <= 0 {
+		return nil, fmt.Errorf("unsupported delivery spec: timeout must be positive - got %s", *trigger.Timeout)
+	}
+
+	if egressConfig == nil {
+		egressConfig = &coreconfig.EgressConfig{}
+	}
+	egressConfig.Timeout = uint64(timeout / time.Millisecond)
+
+	return egressConfig, nil
+}
+
+// deliveryOrder returns the delivery order of the given Trigger.
+func deliveryOrder(trigger *eventing.Trigger) (coreconfig.DeliveryOrder, error) {
+	value, ok := trigger.GetAnnotations()[DeliveryOrderAnnotation]
+	if !ok {
+		return coreconfig.DeliveryOrder_Unordered, nil
+	}
+
+	switch value {
+	case DeliveryOrderUnordered:
+		return coreconfig.DeliveryOrder_Unordered, nil
+	case DeliveryOrderOrdered:
+		return coreconfig.DeliveryOrder_Ordered, nil
+	default:
+		return coreconfig.DeliveryOrder_Unordered, fmt.Errorf(
+			"unsupported annotation %s value %q - supported values: %s, %s",
+			DeliveryOrderAnnotation,
+			value,
+			DeliveryOrderUnordered,
+			DeliveryOrderOrdered,
+		)
+	}
+}
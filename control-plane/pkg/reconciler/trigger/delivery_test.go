@@ -0,0 +1,181 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+func Test_deliverySpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *eventing.Trigger
+		want    *DeliverySpec
+		wantErr bool
+	}{
+		{
+			name:    "no annotation",
+			trigger: newTrigger().(*eventing.Trigger),
+		},
+		{
+			name:    "retry and timeout",
+			trigger: newTrigger(withDelivery(`{"retry": 5, "timeout": "PT10S"}`)).(*eventing.Trigger),
+			want: &DeliverySpec{
+				DeliverySpec: eventingduck.DeliverySpec{Retry: pointer.Int32Ptr(5)},
+				Timeout:      pointer.StringPtr("PT10S"),
+			},
+		},
+		{
+			name:    "invalid",
+			trigger: newTrigger(withDelivery(`{"retry": "five"}`)).(*eventing.Trigger),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deliverySpec(tt.trigger)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("deliverySpec() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_egressConfig(t *testing.T) {
+
+	linear := eventingduck.BackoffPolicyLinear
+
+	tests := []struct {
+		name    string
+		broker  *eventingduck.DeliverySpec
+		trigger *DeliverySpec
+		want    *coreconfig.EgressConfig
+		wantErr bool
+	}{
+		{
+			name:    "no retries",
+			trigger: &DeliverySpec{},
+		},
+		{
+			name: "inherit broker delivery",
+			broker: &eventingduck.DeliverySpec{
+				Retry:         pointer.Int32Ptr(3),
+				BackoffPolicy: &linear,
+				BackoffDelay:  pointer.StringPtr("PT1S"),
+			},
+			trigger: &DeliverySpec{},
+			want: &coreconfig.EgressConfig{
+				Retry:         3,
+				BackoffPolicy: coreconfig.BackoffPolicy_Linear,
+				BackoffDelay:  1000,
+			},
+		},
+		{
+			name: "override broker delivery",
+			broker: &eventingduck.DeliverySpec{
+				Retry:         pointer.Int32Ptr(3),
+				BackoffPolicy: &linear,
+				BackoffDelay:  pointer.StringPtr("PT1S"),
+			},
+			trigger: &DeliverySpec{
+				DeliverySpec: eventingduck.DeliverySpec{
+					Retry:        pointer.Int32Ptr(10),
+					BackoffDelay: pointer.StringPtr("PT0.1S"),
+				},
+			},
+			want: &coreconfig.EgressConfig{
+				Retry:         10,
+				BackoffPolicy: coreconfig.BackoffPolicy_Linear,
+				BackoffDelay:  100,
+			},
+		},
+		{
+			name:    "timeout only",
+			trigger: &DeliverySpec{Timeout: pointer.StringPtr("PT30S")},
+			want:    &coreconfig.EgressConfig{Timeout: 30000},
+		},
+		{
+			name:    "invalid timeout",
+			trigger: &DeliverySpec{Timeout: pointer.StringPtr("30s")},
+			wantErr: true,
+		},
+		{
+			name:    "zero timeout",
+			trigger: &DeliverySpec{Timeout: pointer.StringPtr("PT0S")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := egressConfig(tt.broker, tt.trigger)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("egressConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_deliveryOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *eventing.Trigger
+		want    coreconfig.DeliveryOrder
+		wantErr bool
+	}{
+		{
+			name:    "no annotation",
+			trigger: newTrigger().(*eventing.Trigger),
+			want:    coreconfig.DeliveryOrder_Unordered,
+		},
+		{
+			name:    "unordered",
+			trigger: newTrigger(withDeliveryOrder(DeliveryOrderUnordered)).(*eventing.Trigger),
+			want:    coreconfig.DeliveryOrder_Unordered,
+		},
+		{
+			name:    "ordered",
+			trigger: newTrigger(withDeliveryOrder(DeliveryOrderOrdered)).(*eventing.Trigger),
+			want:    coreconfig.DeliveryOrder_Ordered,
+		},
+		{
+			name:    "unsupported",
+			trigger: newTrigger(withDeliveryOrder("Ordered")).(*eventing.Trigger),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deliveryOrder(tt.trigger)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("deliveryOrder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+const (
+	// FiltersAnnotation is the annotation Triggers set to filter events with filter expressions, in addition to
+	// Trigger.Spec.Filter.Attributes.
+	// Its value is a JSON list of filter expressions, an event passes the filter if it matches every expression.
+	// Each expression has exactly one of the following dialects:
+	//   - exact: attributes values are equal to the event context attributes values.
+	//   - prefix: attributes values are prefixes of the event context attributes values.
+	//   - suffix: attributes values are suffixes of the event context attributes values.
+	//   - all: the event matches all the nested expressions.
+	//   - any: the event matches at least one of the nested expressions.
+	//   - not: the event doesn't match the nested expression.
+	//
+	// Example:
+	//   kafka.eventing.knative.dev/filters: |
+	//     [{"prefix": {"type": "dev.knative."}}, {"not": {"exact": {"source": "ignored"}}}]
+	FiltersAnnotation = "kafka.eventing.knative.dev/filters"
+)
+
+// attributeNameRegex matches valid CloudEvents context attribute names.
+var attributeNameRegex = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// FilterExpression is a filter expression of a Trigger.
+type FilterExpression struct {
+	Exact  map[string]string  `json:"exact,omitempty"`
+	Prefix map[string]string  `json:"prefix,omitempty"`
+	Suffix map[string]string  `json:"suffix,omitempty"`
+	All    []FilterExpression `json:"all,omitempty"`
+	Any    []FilterExpression `json:"any,omitempty"`
+	Not    *FilterExpression  `json:"not,omitempty"`
+}
+
+// filters returns the data plane config of the filter expressions of the given Trigger, nil when the Trigger has
+// no filter expressions.
+func filters(trigger *eventing.Trigger) ([]*coreconfig.Filter, error) {
+	value, ok := trigger.GetAnnotations()[FiltersAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	var expressions []FilterExpression
+	if err := json.Unmarshal([]byte(value), &expressions); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation %s: %w", FiltersAnnotation, err)
+	}
+
+	filters, err := filtersConfig(expressions, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid annotation %s: %w", FiltersAnnotation, err)
+	}
+	return filters, nil
+}
+
+func filtersConfig(expressions []FilterExpression, path string) ([]*coreconfig.Filter, error) {
+	filters := make([]*coreconfig.Filter, 0, len(expressions))
+	for i := range expressions {
+		filter, err := filterConfig(&expressions[i], fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func filterConfig(expression *FilterExpression, path string) (*coreconfig.Filter, error) {
+
+	dialects := 0
+	for _, set := range []bool{
+		expression.Exact != nil,
+		expression.Prefix != nil,
+		expression.Suffix != nil,
+		expression.All != nil,
+		expression.Any != nil,
+		expression.Not != nil,
+	} {
+		if set {
+			dialects++
+		}
+	}
+	if dialects != 1 {
+		return nil, fmt.Errorf("%s: expected exactly one of exact, prefix, suffix, all, any, not - got %d", path, dialects)
+	}
+
+	switch {
+	case expression.Exact != nil:
+		return attributesFilterConfig(coreconfig.FilterDialect_Exact, expression.Exact, false, path+".exact")
+	case expression.Prefix != nil:
+		return attributesFilterConfig(coreconfig.FilterDialect_Prefix, expression.Prefix, true, path+".prefix")
+	case expression.Suffix != nil:
+		return attributesFilterConfig(coreconfig.FilterDialect_Suffix, expression.Suffix, true, path+".suffix")
+	case expression.All != nil:
+		return nestedFilterConfig(coreconfig.FilterDialect_All, expression.All, path+".all")
+	case expression.Any != nil:
+		return nestedFilterConfig(coreconfig.FilterDialect_Any, expression.Any, path+".any")
+	default:
+		filter, err := filterConfig(expression.Not, path+".not")
+		if err != nil {
+			return nil, err
+		}
+		return &coreconfig.Filter{
+			Dialect: coreconfig.FilterDialect_Not,
+			Filters: []*coreconfig.Filter{filter},
+		}, nil
+	}
+}
+
+func attributesFilterConfig(dialect coreconfig.FilterDialect, attributes map[string]string, nonEmptyValues bool, path string) (*coreconfig.Filter, error) {
+	if len(attributes) == 0 {
+		return nil, fmt.Errorf("%s: at least one attribute is required", path)
+	}
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	// Sort names so that the same invalid expression is always reported with the same error.
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := attributes[name]
+		if !attributeNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("%s: invalid attribute name %q - it must consist of lowercase letters and digits", path, name)
+		}
+		if nonEmptyValues && value == "" {
+			return nil, fmt.Errorf("%s: attribute %s value must not be empty", path, name)
+		}
+	}
+
+	return &coreconfig.Filter{
+		Attributes: attributes,
+		Dialect:    dialect,
+	}, nil
+}
+
+func nestedFilterConfig(dialect coreconfig.FilterDialect, expressions []FilterExpression, path string) (*coreconfig.Filter, error) {
+	if len(expressions) == 0 {
+		return nil, fmt.Errorf("%s: at least one filter expression is required", path)
+	}
+
+	filters, err := filtersConfig(expressions, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coreconfig.Filter{
+		Dialect: dialect,
+		Filters: filters,
+	}, nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+func Test_filters(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *eventing.Trigger
+		want    []*coreconfig.Filter
+		wantErr string
+	}{
+		{
+			name:    "no annotation",
+			trigger: newTrigger().(*eventing.Trigger),
+		},
+		{
+			name:    "exact, prefix and suffix",
+			trigger: newTrigger(withFilters(`[{"exact": {"source": "s"}}, {"prefix": {"type": "dev."}}, {"suffix": {"subject": ".json"}}]`)).(*eventing.Trigger),
+			want: []*coreconfig.Filter{
+				{Attributes: map[string]string{"source": "s"}, Dialect: coreconfig.FilterDialect_Exact},
+				{Attributes: map[string]string{"type": "dev."}, Dialect: coreconfig.FilterDialect_Prefix},
+				{Attributes: map[string]string{"subject": ".json"}, Dialect: coreconfig.FilterDialect_Suffix},
+			},
+		},
+		{
+			name:    "all, any and not",
+			trigger: newTrigger(withFilters(`[{"all": [{"exact": {"source": "s"}}, {"any": [{"prefix": {"type": "a."}}, {"not": {"suffix": {"type": ".b"}}}]}]}]`)).(*eventing.Trigger),
+			want: []*coreconfig.Filter{
+				{
+					Dialect: coreconfig.FilterDialect_All,
+					Filters: []*coreconfig.Filter{
+						{Attributes: map[string]string{"source": "s"}, Dialect: coreconfig.FilterDialect_Exact},
+						{
+							Dialect: coreconfig.FilterDialect_Any,
+							Filters: []*coreconfig.Filter{
+								{Attributes: map[string]string{"type": "a."}, Dialect: coreconfig.FilterDialect_Prefix},
+								{
+									Dialect: coreconfig.FilterDialect_Not,
+									Filters: []*coreconfig.Filter{
+										{Attributes: map[string]string{"type": ".b"}, Dialect: coreconfig.FilterDialect_Suffix},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "malformed",
+			trigger: newTrigger(withFilters(`{"exact": {"source": "s"}}`)).(*eventing.Trigger),
+			wantErr: "failed to parse annotation " + FiltersAnnotation + ": json: cannot unmarshal object into Go value of type []trigger.FilterExpression",
+		},
+		{
+			name:    "multiple dialects",
+			trigger: newTrigger(withFilters(`[{"exact": {"source": "s"}, "prefix": {"type": "t"}}]`)).(*eventing.Trigger),
+			wantErr: "invalid annotation " + FiltersAnnotation + ": [0]: expected exactly one of exact, prefix, suffix, all, any, not - got 2",
+		},
+		{
+			name:    "no dialect",
+			trigger: newTrigger(withFilters(`[{}]`)).(*eventing.Trigger),
+			wantErr: "invalid annotation " + FiltersAnnotation + ": [0]: expected exactly one of exact, prefix, suffix, all, any, not - got 0",
+		},
+		{
+			name:    "invalid attribute name",
+			trigger: newTrigger(withFilters(`[{"all": [{"exact": {"Source": "s"}}]}]`)).(*eventing.Trigger),
+			wantErr: "invalid annotation " + FiltersAnnotation + `: [0].all[0].exact: invalid attribute name "Source" - it must consist of lowercase letters and digits`,
+		},
+		{
+			name:    "empty prefix",
+			trigger: newTrigger(withFilters(`[{"prefix": {"type": ""}}]`)).(*eventing.Trigger),
+			wantErr: "invalid annotation " + FiltersAnnotation + ": [0].prefix: attribute type value must not be empty",
+		},
+		{
+			name:    "empty suffix attributes",
+			trigger: newTrigger(withFilters(`[{"suffix": {}}]`)).(*eventing.Trigger),
+			wantErr: "invalid annotation " + FiltersAnnotation + ": [0].suffix: at least one attribute is required",
+		},
+		{
+			name:    "invalid not",
+			trigger: newTrigger(withFilters(`[{"not": {"any": []}}]`)).(*eventing.Trigger),
+			wantErr: "invalid annotation " + FiltersAnnotation + ": [0].not.any: at least one filter expression is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := filters(tt.trigger)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+)
+
+const (
+	// ReplayAnnotation is the annotation Triggers set to have events re-delivered to their subscriber.
+	// Its value is either an RFC 3339 timestamp, to replay events appended to the topic since then, or ReplayEarliest.
+	// Changing the value starts a new replay.
+	ReplayAnnotation = "kafka.eventing.knative.dev/replay"
+
+	// ReplayEarliest replays every event retained in the topic.
+	ReplayEarliest = "earliest"
+
+	// ReplayStatusAnnotation records the value of ReplayAnnotation of the last replay.
+	ReplayStatusAnnotation = "kafka.eventing.knative.dev/replay"
+
+	// ReplayTimeAnnotation records when offsets of the last replay have been reset, in RFC 3339 format.
+	ReplayTimeAnnotation = "kafka.eventing.knative.dev/replay.time"
+)
+
+// replay is a replay requested by a Trigger.
+type replay struct {
+	// value of ReplayAnnotation.
+	value string
+	// time to reset offsets to, it's either a timestamp in milliseconds or sarama.OffsetOldest.
+	time int64
+}
+
+// pendingReplay returns the replay requested by the given Trigger, nil when there is no replay or it's done.
+func pendingReplay(trigger *eventing.Trigger) (*replay, error) {
+	value, ok := trigger.GetAnnotations()[ReplayAnnotation]
+	if !ok || value == "" || value == trigger.Status.Annotations[ReplayStatusAnnotation] {
+		return nil, nil
+	}
+
+	if value == ReplayEarliest {
+		return &replay{value: value, time: sarama.OffsetOldest}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation %q, expected %q or an RFC 3339 timestamp: %w", ReplayAnnotation, value, ReplayEarliest, err)
+	}
+	return &replay{value: value, time: t.UnixNano() / int64(time.Millisecond)}, nil
+}
+
+// resetOffsets commits, for the consumer group of the given Trigger, the offsets of the first records appended at or
+// after the time of the given replay to partitions of the topic of the given Broker entry of the data plane config.
+//
+// Kafka accepts offsets committed outside of a group generation only when the consumer group is empty.
+func (r *Reconciler) resetOffsets(broker *coreconfig.Broker, trigger *eventing.Trigger, replay *replay) (err error) {
+
+	client, release, err := r.getKafkaClient(broker)
+	if err != nil {
+		return err
+	}
+	defer func() { release(err) }()
+
+	offsets, err := replayOffsets(client, broker.Topic, replay.time)
+	if err != nil {
+		return err
+	}
+
+	group := ConsumerGroup(trigger)
+
+	request := &sarama.OffsetCommitRequest{
+		Version:                 1,
+		ConsumerGroup:           group,
+		ConsumerGroupGeneration: sarama.GroupGenerationUndefined,
+	}
+	for partition, offset := range offsets {
+		request.AddBlock(broker.Topic, partition, offset, sarama.ReceiveTime, "")
+	}
+
+	coordinator, err := client.Coordinator(group)
+	if err != nil {
+		return fmt.Errorf("failed to get coordinator of consumer group %s: %w", group, err)
+	}
+
+	response, err := coordinator.CommitOffset(request)
+	if err != nil {
+		return fmt.Errorf("failed to commit offsets of consumer group %s: %w", group, err)
+	}
+	for _, partitions := range response.Errors {
+		for partition, kerr := range partitions {
+			if kerr != sarama.ErrNoError {
+				return fmt.Errorf("failed to commit offset of partition %d of consumer group %s: %w", partition, group, kerr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// replayOffsets returns the offsets of the first records appended at or after the given time to every partition of
+// the given topic.
+func replayOffsets(client sarama.Client, topic string, time int64) (map[int32]int64, error) {
+
+	partitions, err := client.Partitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partitions of topic %s: %w", topic, err)
+	}
+
+	offsets := make(map[int32]int64, len(partitions))
+	for _, partition := range partitions {
+		offset, err := client.GetOffset(topic, partition, time)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offset of partition %d of topic %s: %w", partition, topic, err)
+		}
+
+		if offset < 0 {
+			// No records have been appended to the partition since then.
+			offset, err = client.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get end offset of partition %d of topic %s: %w", partition, topic, err)
+			}
+		}
+
+		offsets[partition] = offset
+	}
+
+	return offsets, nil
+}
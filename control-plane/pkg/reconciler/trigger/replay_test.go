@@ -0,0 +1,124 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+)
+
+func Test_pendingReplay(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *eventing.Trigger
+		want    *replay
+		wantErr bool
+	}{
+		{
+			name:    "no replay",
+			trigger: newTrigger().(*eventing.Trigger),
+		},
+		{
+			name:    "earliest",
+			trigger: newTrigger(withReplay(ReplayEarliest)).(*eventing.Trigger),
+			want:    &replay{value: ReplayEarliest, time: sarama.OffsetOldest},
+		},
+		{
+			name:    "timestamp",
+			trigger: newTrigger(withReplay("2020-11-01T09:00:00+01:00")).(*eventing.Trigger),
+			want:    &replay{value: "2020-11-01T09:00:00+01:00", time: 1604217600000},
+		},
+		{
+			name:    "replay done",
+			trigger: newTrigger(withReplay(ReplayEarliest), withReplayed(ReplayEarliest, now)).(*eventing.Trigger),
+		},
+		{
+			name:    "invalid",
+			trigger: newTrigger(withReplay("yesterday")).(*eventing.Trigger),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pendingReplay(tt.trigger)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("pendingReplay() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_resetOffsets(t *testing.T) {
+
+	mockBroker := sarama.NewMockBroker(t, 1)
+	defer mockBroker.Close()
+
+	mockBroker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+	})
+
+	coordinator := sarama.NewBroker(mockBroker.Addr())
+	if err := coordinator.Open(sarama.NewConfig()); err != nil {
+		t.Fatal(err)
+	}
+	defer coordinator.Close()
+
+	r := &Reconciler{
+		NewClient: func(addrs []string, config *sarama.Config) (sarama.Client, error) {
+			return &MockKafkaClient{
+				ExpectedTopicName: GetTopic(),
+				EndOffsets:        map[int32]int64{0: 10, 1: 20},
+				OffsetsForTimes: map[int64]map[int32]int64{
+					1604217600000: {0: 5},
+				},
+				CoordinatorBroker: coordinator,
+				T:                 t,
+			}, nil
+		},
+	}
+
+	err := r.resetOffsets(
+		&coreconfig.Broker{Topic: GetTopic()},
+		newTrigger().(*eventing.Trigger),
+		&replay{value: "2020-11-01T08:00:00Z", time: 1604217600000},
+	)
+	assert.Nil(t, err)
+
+	history := mockBroker.History()
+	assert.Len(t, history, 1)
+
+	request := history[0].Request.(*sarama.OffsetCommitRequest)
+	assert.Equal(t, TriggerUUID, request.ConsumerGroup)
+
+	for partition, want := range map[int32]int64{
+		0: 5,
+		// No records have been appended since then, so the offset is the end offset.
+		1: 20,
+	} {
+		offset, _, err := request.Offset(GetTopic(), partition)
+		assert.Nil(t, err)
+		assert.Equal(t, want, offset, "partition %d", partition)
+	}
+}
@@ -18,12 +18,17 @@ package trigger
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
+	"sync"
+	"time"
 
+	"github.com/Shopify/sarama"
+	"github.com/gogo/protobuf/proto"
 	"go.uber.org/zap"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	eventingclientset "knative.dev/eventing/pkg/client/clientset/versioned"
@@ -32,6 +37,7 @@ import (
 	"knative.dev/pkg/reconciler"
 	"knative.dev/pkg/resolver"
 
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/clusteradmin"
 	coreconfig "knative.dev/eventing-kafka-broker/control-plane/pkg/core/config"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/log"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
@@ -46,9 +52,32 @@ type Reconciler struct {
 	*base.Reconciler
 
 	BrokerLister   eventinglisters.BrokerLister
+	TriggerLister  eventinglisters.TriggerLister
 	EventingClient eventingclientset.Interface
 	Resolver       *resolver.URIResolver
 
+	// NewClusterAdmin creates new sarama ClusterAdmin. It's convenient to add this as Reconciler field so that we can
+	// mock the function used during the reconciliation loop.
+	NewClusterAdmin func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error)
+
+	// NewClient creates new sarama Client. It's convenient to add this as Reconciler field so that we can mock the
+	// function used during the reconciliation loop.
+	NewClient func(addrs []string, config *sarama.Config) (sarama.Client, error)
+
+	// clusterAdmins caches ClusterAdmin created with NewClusterAdmin, see ClusterAdmins.
+	clusterAdmins     *clusteradmin.Cache
+	clusterAdminsOnce sync.Once
+
+	// clients caches Client created with NewClient, see Clients.
+	clients     *clusteradmin.ClientCache
+	clientsOnce sync.Once
+
+	// EnqueueAfter enqueues the given Trigger after the given delay.
+	EnqueueAfter func(key types.NamespacedName, delay time.Duration)
+
+	// Now returns the current time.
+	Now func() time.Time
+
 	Configs *brokerreconciler.EnvConfigs
 }
 
@@ -68,102 +97,260 @@ func (r *Reconciler) finalizeKind(ctx context.Context, trigger *eventing.Trigger
 
 	logger := log.Logger(ctx, "finalize", trigger)
 
+	r.Waiting.Done(types.NamespacedName{Namespace: trigger.Namespace, Name: trigger.Name})
+
 	broker, err := r.BrokerLister.Brokers(trigger.Namespace).Get(trigger.Spec.Broker)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return fmt.Errorf("failed to get broker from lister: %w", err)
 	}
 
 	if apierrors.IsNotFound(err) {
-		// If the broker is deleted, resources associated with the Trigger will be deleted.
-		return nil
+		// If the broker is deleted, resources associated with the Trigger will be deleted, except for the consumer
+		// group.
+		return r.deleteOrphanedConsumerGroup(logger, trigger)
 	}
 
-	// Get data plane config map.
-	dataPlaneConfigMap, err := r.GetOrCreateDataPlaneConfigMap()
-	if err != nil {
-		return fmt.Errorf("failed to get data plane config map %s: %w", r.Configs.DataPlaneConfigMapAsString(), err)
-	}
-
-	logger.Debug("Got data plane config map")
+	shard := r.Shard(broker.UID)
 
-	// Get brokersTriggers data.
-	brokersTriggers, err := r.GetDataPlaneConfigMapData(logger, dataPlaneConfigMap)
-	if err != nil {
-		return fmt.Errorf("failed to get brokers and triggers: %w", err)
-	}
+	// Broker entry of the data plane config, it's used to delete the consumer group of the Trigger.
+	var brokerConfig *coreconfig.Broker
 
-	logger.Debug(
-		"Got brokers and triggers data from data plane config map",
-		zap.Any(base.BrokersTriggersDataLogKey, log.BrokersMarshaller{Brokers: brokersTriggers}),
-	)
+	result, err := r.GetContractWriter().Update(logger, shard, base.ContractUpdate{
+		NotifyDispatchers: true,
+		Mutate: func(brokersTriggers *coreconfig.Brokers, readErr error) (bool, error) {
+			if readErr != nil {
+				return false, fmt.Errorf("failed to get brokers and triggers: %w", readErr)
+			}
 
-	brokerIndex := brokerreconciler.FindBroker(brokersTriggers, broker)
-	if brokerIndex == brokerreconciler.NoBroker {
-		// If the broker is not there, resources associated with the Trigger are deleted accordingly.
-		return nil
-	}
+			brokerIndex := brokerreconciler.FindBroker(brokersTriggers, broker)
+			if brokerIndex == brokerreconciler.NoBroker {
+				// If the broker is not there, resources associated with the Trigger are deleted accordingly.
+				return false, nil
+			}
 
-	logger.Debug("Found Broker", zap.Int("brokerIndex", brokerIndex))
+			logger.Debug("Found Broker", zap.Int("brokerIndex", brokerIndex))
 
-	triggers := brokersTriggers.Brokers[brokerIndex].Triggers
-	triggerIndex := findTrigger(triggers, trigger)
-	if triggerIndex == noTrigger {
-		// The trigger is not there, resources associated with the Trigger are deleted accordingly.
-		logger.Debug("trigger not found in config map")
+			brokerConfig = &coreconfig.Broker{
+				BootstrapServers: brokersTriggers.Brokers[brokerIndex].BootstrapServers,
+				AuthSecret:       brokersTriggers.Brokers[brokerIndex].AuthSecret,
+			}
 
-		return nil
-	}
+			triggers := brokersTriggers.Brokers[brokerIndex].Triggers
+			triggerIndex := findTrigger(triggers, trigger)
+			if triggerIndex == noTrigger {
+				// The trigger is not there, resources associated with the Trigger are deleted accordingly.
+				logger.Debug("trigger not found in config map")
 
-	logger.Debug("Found Trigger", zap.Int("triggerIndex", brokerIndex))
+				return false, nil
+			}
 
-	// Delete the Trigger from the config map data.
-	brokersTriggers.Brokers[brokerIndex].Triggers = deleteTrigger(triggers, triggerIndex)
+			logger.Debug("Found Trigger", zap.Int("triggerIndex", triggerIndex))
 
-	// Increment volume generation
-	brokersTriggers.VolumeGeneration = incrementVolumeGeneration(brokersTriggers.VolumeGeneration)
+			// Delete the Trigger from the config map data.
+			brokersTriggers.Brokers[brokerIndex].Triggers = deleteTrigger(triggers, triggerIndex)
 
-	// Update data plane config map.
-	err = r.UpdateDataPlaneConfigMap(brokersTriggers, dataPlaneConfigMap)
+			return true, nil
+		},
+	})
+	if base.IsGetConfigMapError(err) {
+		return fmt.Errorf("failed to get data plane config map %s: %w", r.DataPlaneConfigMapShardAsString(shard), err)
+	}
 	if err != nil {
 		return err
 	}
 
-	logger.Debug("Updated data plane config map", zap.String("configmap", r.Configs.DataPlaneConfigMapAsString()))
-
-	// Update volume generation annotation of dispatcher pods
-	if err := r.UpdateDispatcherPodsAnnotation(logger, brokersTriggers.VolumeGeneration); err != nil {
+	if result.DispatchersErr != nil {
 		// Failing to update dispatcher pods annotation leads to config map refresh delayed by several seconds.
 		// The delete trigger will eventually be seen by the data plane pods, so log out the error and move on to the
 		// next step.
 		logger.Warn(
 			"Failed to update dispatcher pod annotation to trigger an immediate config map refresh",
-			zap.Error(err),
+			zap.Error(result.DispatchersErr),
 		)
+	}
+
+	if brokerConfig == nil {
+		// The Broker is being deleted and its entry is gone already.
+		return r.deleteOrphanedConsumerGroup(logger, trigger)
+	}
+
+	if !shouldDeleteConsumerGroup(trigger) {
+		return nil
+	}
+
+	// Returning an error keeps the finalizer, so that the deletion is retried until dispatcher pods leave the consumer
+	// group.
+	if err := r.deleteConsumerGroup(brokerConfig, trigger); err != nil {
+		return err
+	}
+
+	logger.Debug("Deleted consumer group", zap.String("group", ConsumerGroup(trigger)))
+
+	return nil
+}
+
+// deleteOrphanedConsumerGroup deletes the consumer group of the given Trigger whose Broker is gone, using the Kafka
+// cluster recorded on the Trigger status.
+func (r *Reconciler) deleteOrphanedConsumerGroup(logger *zap.Logger, trigger *eventing.Trigger) error {
+
+	brokerConfig, ok := recordedKafkaCluster(trigger)
+	if !ok || !shouldDeleteConsumerGroup(trigger) {
+		return nil
+	}
+
+	err := r.deleteConsumerGroup(brokerConfig, trigger)
+	if isSecretNotFound(err) {
+		// The auth secret is gone too, so the consumer group can't be deleted anymore. Don't block the Trigger
+		// deletion forever.
+		logger.Warn("Failed to delete consumer group of Trigger without Broker", zap.Error(err))
+	} else if err != nil {
+		// Returning an error keeps the finalizer, so that the deletion is retried until dispatcher pods leave the
+		// consumer group.
+		return err
 	} else {
-		logger.Debug("Updated dispatcher pod annotation successfully")
+		logger.Debug("Deleted consumer group", zap.String("group", ConsumerGroup(trigger)))
 	}
 
+	// Triggers without Broker are reconciled again on resync, so don't delete their consumer group again.
+	forgetKafkaCluster(trigger)
+
 	return nil
 }
 
-func (r *Reconciler) GetTriggerConfig(trigger *eventing.Trigger) (coreconfig.Trigger, error) {
+// replay resets offsets of the consumer group of the given paused Trigger once dispatcher pods left it, and resumes the
+// Trigger.
+func (r *Reconciler) replay(ctx context.Context, statusConditionManager *statusConditionManager, broker *coreconfig.Broker, trigger *eventing.Trigger, replay *replay) reconciler.Event {
+
+	logger := log.Logger(ctx, "replay", trigger)
+
+	group := ConsumerGroup(trigger)
+	state, err := r.describeConsumerGroup(broker, trigger)
+	if err != nil {
+		return statusConditionManager.failedToDescribeConsumerGroup(group, err)
+	}
+	if state != ConsumerGroupEmpty && state != ConsumerGroupDead {
+		logger.Debug("Waiting for consumer group to be empty", zap.String("group", group), zap.String("state", state))
+
+		// Consumer group changes aren't watched, so check the consumer group again later.
+		r.EnqueueAfter(types.NamespacedName{Namespace: trigger.Namespace, Name: trigger.Name}, consumerGroupCheckDelay)
+		statusConditionManager.waitingForReplay(group, state)
+		return nil
+	}
+
+	if err := r.resetOffsets(broker, trigger, replay); err != nil {
+		return statusConditionManager.failedToReplay(err)
+	}
+
+	logger.Debug("Reset consumer group offsets", zap.String("group", group), zap.String("replay", replay.value))
+
+	statusConditionManager.replayed(replay, r.Now())
+
+	// The replay is recorded in the status, so reconciling the Trigger again resumes it.
+	return r.reconcileKind(ctx, trigger)
+}
+
+// GetTriggerConfig returns the data plane config of the given Trigger of the given Broker.
+func (r *Reconciler) GetTriggerConfig(broker *eventing.Broker, trigger *eventing.Trigger) (coreconfig.Trigger, error) {
+	return triggerConfig(r.Resolver, broker, trigger)
+}
+
+// ContractTrigger returns the data plane config of the given Trigger of the given Broker as the reconciler writes it,
+// without changing the Trigger.
+func ContractTrigger(uriResolver *resolver.URIResolver, broker *eventing.Broker, trigger *eventing.Trigger) (*coreconfig.Trigger, error) {
+
+	// triggerConfig sets the subscriber URI.
+	trigger = trigger.DeepCopy()
+
+	config, err := triggerConfig(uriResolver, broker, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalid replays are ignored by the reconciler too.
+	replay, err := pendingReplay(trigger)
+	config.Paused = err == nil && replay != nil
+
+	return &config, nil
+}
+
+func triggerConfig(uriResolver *resolver.URIResolver, broker *eventing.Broker, trigger *eventing.Trigger) (coreconfig.Trigger, error) {
 
 	var attributes map[string]string
 	if trigger.Spec.Filter != nil {
 		attributes = trigger.Spec.Filter.Attributes
 	}
 
-	destination, err := r.Resolver.URIFromDestinationV1(trigger.Spec.Subscriber, trigger)
+	// The Trigger config is parsed before resolving destinations, so that an invalid config is reported as such.
+
+	order, err := deliveryOrder(trigger)
+	if err != nil {
+		return coreconfig.Trigger{}, invalidTriggerConfigError{err}
+	}
+
+	filters, err := filters(trigger)
+	if err != nil {
+		return coreconfig.Trigger{}, invalidTriggerConfigError{err}
+	}
+
+	delivery, err := deliverySpec(trigger)
+	if err != nil {
+		return coreconfig.Trigger{}, invalidTriggerConfigError{err}
+	}
+
+	var egress *coreconfig.EgressConfig
+	if delivery != nil {
+		egress, err = egressConfig(broker.Spec.Delivery, delivery)
+		if err != nil {
+			return coreconfig.Trigger{}, invalidTriggerConfigError{err}
+		}
+	}
+
+	destination, err := uriResolver.URIFromDestinationV1(trigger.Spec.Subscriber, trigger)
 	if err != nil {
 		return coreconfig.Trigger{}, fmt.Errorf("failed to resolve Trigger.Spec.Subscriber: %w", err)
 	}
 	trigger.Status.SubscriberURI = destination
 
-	return coreconfig.Trigger{
-		Attributes:  attributes,
-		Destination: destination.String(),
-		Id:          string(trigger.UID),
-	}, nil
+	triggerConfig := coreconfig.Trigger{
+		Attributes:    attributes,
+		Destination:   destination.String(),
+		Id:            string(trigger.UID),
+		DeliveryOrder: order,
+		Filters:       filters,
+	}
+
+	if delivery == nil {
+		// The Trigger inherits the delivery spec of the Broker.
+		return triggerConfig, nil
+	}
+
+	triggerConfig.EgressConfig = egress
+
+	if delivery.DeadLetterSink != nil {
+		deadLetterSinkURL, err := uriResolver.URIFromDestinationV1(*delivery.DeadLetterSink, trigger)
+		if err != nil {
+			return coreconfig.Trigger{}, fmt.Errorf("failed to resolve annotation %s deadLetterSink: %w", DeliveryAnnotation, err)
+		}
+		triggerConfig.DeadLetterSink = deadLetterSinkURL.String()
+	}
+
+	return triggerConfig, nil
+}
+
+// invalidTriggerConfigError is a Trigger config that can't be accepted until the Trigger changes, as opposed to a
+// Trigger config whose destinations can't be resolved yet.
+type invalidTriggerConfigError struct {
+	error
+}
+
+func (e invalidTriggerConfigError) Unwrap() error {
+	return e.error
+}
+
+// isInvalidTriggerConfigError returns true when the given error is an invalid Trigger config.
+func isInvalidTriggerConfigError(err error) bool {
+	var e invalidTriggerConfigError
+	return errors.As(err, &e)
 }
 
 func findTrigger(triggers []*coreconfig.Trigger, trigger *eventing.Trigger) int {
@@ -196,6 +383,7 @@ func (r *Reconciler) reconcileKind(ctx context.Context, trigger *eventing.Trigge
 		Configs:  r.Configs,
 		Recorder: controller.GetEventRecorder(ctx),
 	}
+	statusConditionManager.initializeConditions()
 
 	broker, err := r.BrokerLister.Brokers(trigger.Namespace).Get(trigger.Spec.Broker)
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -227,57 +415,75 @@ func (r *Reconciler) reconcileKind(ctx context.Context, trigger *eventing.Trigge
 
 	statusConditionManager.propagateBrokerCondition(broker)
 
-	// Get data plane config map.
-	dataPlaneConfigMap, err := r.GetOrCreateDataPlaneConfigMap()
+	// Triggers are paused in the contract while replaying, so that dispatcher pods leave their consumer group.
+	replay, err := pendingReplay(trigger)
 	if err != nil {
-		return statusConditionManager.failedToGetDataPlaneConfigMap(err)
-	}
-
-	logger.Debug("Got brokers and triggers config map")
-
-	// Get data plane config data.
-	dataPlaneConfig, err := r.GetDataPlaneConfigMapData(logger, dataPlaneConfigMap)
-	if err != nil || dataPlaneConfig == nil {
-		return statusConditionManager.failedToGetDataPlaneConfigFromConfigMap(err)
+		statusConditionManager.invalidReplay(err)
 	}
 
-	logger.Debug(
-		"Got brokers and triggers data from config map",
-		zap.Any(base.BrokersTriggersDataLogKey, log.BrokersMarshaller{Brokers: dataPlaneConfig}),
-	)
-
-	brokerIndex := brokerreconciler.FindBroker(dataPlaneConfig, broker)
-	if brokerIndex == brokerreconciler.NoBroker {
-		return statusConditionManager.brokerNotFoundInDataPlaneConfigMap()
+	shard := r.Shard(broker.UID)
+
+	// Broker entry of the data plane config, it's used to connect to the Kafka cluster of the Broker.
+	var brokerConfig *coreconfig.Broker
+
+	result, err := r.GetContractWriter().Update(logger, shard, base.ContractUpdate{
+		NotifyDispatchers: true,
+		Mutate: func(dataPlaneConfig *coreconfig.Brokers, readErr error) (bool, error) {
+			if readErr != nil {
+				return false, statusConditionManager.failedToGetDataPlaneConfigFromConfigMap(readErr)
+			}
+
+			brokerIndex := brokerreconciler.FindBroker(dataPlaneConfig, broker)
+			if brokerIndex == brokerreconciler.NoBroker {
+				return false, statusConditionManager.brokerNotFoundInDataPlaneConfigMap()
+			}
+
+			brokerConfig = &coreconfig.Broker{
+				Topic:            dataPlaneConfig.Brokers[brokerIndex].Topic,
+				BootstrapServers: dataPlaneConfig.Brokers[brokerIndex].BootstrapServers,
+				AuthSecret:       dataPlaneConfig.Brokers[brokerIndex].AuthSecret,
+			}
+
+			triggerIndex := findTrigger(dataPlaneConfig.Brokers[brokerIndex].Triggers, trigger)
+
+			triggerConfig, err := r.GetTriggerConfig(broker, trigger)
+			if isInvalidTriggerConfigError(err) {
+				return false, statusConditionManager.invalidTriggerConfig(err)
+			}
+			statusConditionManager.triggerConfigParsed()
+			if err != nil {
+				return false, statusConditionManager.failedToResolveTriggerConfig(err)
+			}
+
+			statusConditionManager.subscriberResolved()
+			triggerConfig.Paused = replay != nil
+
+			if triggerIndex != noTrigger && proto.Equal(dataPlaneConfig.Brokers[brokerIndex].Triggers[triggerIndex], &triggerConfig) {
+				// Don't bump the volume generation, data plane pods have the Trigger already.
+				return false, nil
+			}
+
+			if triggerIndex == noTrigger {
+				dataPlaneConfig.Brokers[brokerIndex].Triggers = append(
+					dataPlaneConfig.Brokers[brokerIndex].Triggers,
+					&triggerConfig,
+				)
+			} else {
+				dataPlaneConfig.Brokers[brokerIndex].Triggers[triggerIndex] = &triggerConfig
+			}
+
+			return true, nil
+		},
+	})
+	if base.IsGetConfigMapError(err) {
+		return statusConditionManager.failedToGetDataPlaneConfigMap(err)
 	}
-	triggerIndex := findTrigger(dataPlaneConfig.Brokers[brokerIndex].Triggers, trigger)
-
-	triggerConfig, err := r.GetTriggerConfig(trigger)
 	if err != nil {
-		return statusConditionManager.failedToResolveTriggerConfig(err)
-	}
-
-	statusConditionManager.subscriberResolved()
-
-	if triggerIndex == noTrigger {
-		dataPlaneConfig.Brokers[brokerIndex].Triggers = append(
-			dataPlaneConfig.Brokers[brokerIndex].Triggers,
-			&triggerConfig,
-		)
-	} else {
-		dataPlaneConfig.Brokers[brokerIndex].Triggers[triggerIndex] = &triggerConfig
-	}
-
-	// Increment volumeGeneration
-	dataPlaneConfig.VolumeGeneration = incrementVolumeGeneration(dataPlaneConfig.VolumeGeneration)
-
-	// Update the configuration map with the new dataPlaneConfig data.
-	if err := r.UpdateDataPlaneConfigMap(dataPlaneConfig, dataPlaneConfigMap); err != nil {
 		return err
 	}
 
-	// Update volume generation annotation of dispatcher pods
-	if err := r.UpdateDispatcherPodsAnnotation(logger, dataPlaneConfig.VolumeGeneration); err != nil {
+	// Volume generation annotation of dispatcher pods
+	if err := result.DispatchersErr; err != nil {
 		// Failing to update dispatcher pods annotation leads to config map refresh delayed by several seconds.
 		// Since the dispatcher side is the consumer side, we don't lose availability, and we can consider the Trigger
 		// ready. So, log out the error and move on to the next step.
@@ -293,9 +499,61 @@ func (r *Reconciler) reconcileKind(ctx context.Context, trigger *eventing.Trigge
 
 	logger.Debug("Brokers and triggers config map updated")
 
-	return statusConditionManager.reconciled()
-}
+	statusConditionManager.contractUpdated()
+
+	// The consumer group of the Trigger lives in the Kafka cluster of the Broker, so record it in case the Broker is
+	// deleted before the Trigger.
+	recordKafkaCluster(trigger, broker, brokerConfig)
+
+	// The Trigger is ready when every dispatcher pod applied the volume generation carrying it, and dispatcher pods
+	// joined its consumer group.
+	key := types.NamespacedName{Namespace: trigger.Namespace, Name: trigger.Name}
+	r.Waiting.Wait(key)
+
+	acked, total, err := r.DataPlanePodsAcked(base.DispatcherLabel, shard, result.VolumeGeneration)
+	if err != nil {
+		return statusConditionManager.failedToGetDataPlanePods(err)
+	}
+	if total == 0 {
+		// Nobody applied the volume generation, so events aren't dispatched to the subscriber.
+		logger.Debug("No running dispatcher pods", zap.Uint64("volumeGeneration", result.VolumeGeneration))
 
-func incrementVolumeGeneration(generation uint64) uint64 {
-	return (generation + 1) % (math.MaxUint64 - 1)
+		statusConditionManager.noDataPlanePods()
+		return nil
+	}
+	if acked < total {
+		logger.Debug("Waiting for dispatcher pods",
+			zap.Uint64("volumeGeneration", result.VolumeGeneration),
+			zap.Int("acked", acked),
+			zap.Int("total", total),
+		)
+
+		statusConditionManager.waitingForDataPlane(result.VolumeGeneration, acked, total)
+		return nil
+	}
+
+	r.Waiting.Done(key)
+	statusConditionManager.dataPlaneReady()
+
+	if replay != nil {
+		return r.replay(ctx, &statusConditionManager, brokerConfig, trigger, replay)
+	}
+
+	group := ConsumerGroup(trigger)
+	state, err := r.describeConsumerGroup(brokerConfig, trigger)
+	if err != nil {
+		return statusConditionManager.failedToDescribeConsumerGroup(group, err)
+	}
+	if state != ConsumerGroupStable {
+		logger.Debug("Waiting for consumer group", zap.String("group", group), zap.String("state", state))
+
+		// Consumer group changes aren't watched, so check the consumer group again later.
+		r.EnqueueAfter(key, consumerGroupCheckDelay)
+		statusConditionManager.waitingForConsumerGroup(group, state)
+		return nil
+	}
+
+	statusConditionManager.consumerGroupReady()
+
+	return nil
 }
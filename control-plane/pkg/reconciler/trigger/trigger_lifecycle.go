@@ -18,16 +18,43 @@ package trigger
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/reconciler"
 
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/broker"
 )
 
+const (
+	ConditionBrokerReady        = eventing.TriggerConditionBroker
+	ConditionSubscriberResolved = eventing.TriggerConditionSubscriberResolved
+
+	ConditionConfigParsed       apis.ConditionType = "ConfigParsed"
+	ConditionContractUpdated    apis.ConditionType = "ContractUpdated"
+	ConditionDataPlaneReady     apis.ConditionType = "DataPlaneReady"
+	ConditionConsumerGroupReady apis.ConditionType = "ConsumerGroupReady"
+)
+
+// ConditionSet is the condition set of Kafka Triggers.
+//
+// Eventing doesn't allow registering an alternate condition set for Triggers, so conditions of Kafka Triggers are
+// always managed with this condition set, instead of with the helpers of the Trigger status, which use the eventing
+// condition set.
+var ConditionSet = apis.NewLivingConditionSet(
+	ConditionBrokerReady,
+	ConditionConfigParsed,
+	ConditionSubscriberResolved,
+	ConditionContractUpdated,
+	ConditionDataPlaneReady,
+	ConditionConsumerGroupReady,
+)
+
 type statusConditionManager struct {
 	Trigger *eventing.Trigger
 
@@ -36,9 +63,26 @@ type statusConditionManager struct {
 	Recorder record.EventRecorder
 }
 
+func (m *statusConditionManager) manager() apis.ConditionManager {
+	return ConditionSet.Manage(&m.Trigger.Status)
+}
+
+// initializeConditions initializes conditions of the Kafka Trigger condition set.
+//
+// The generated reconciler initializes conditions of the eventing condition set, so conditions that don't belong to
+// the Kafka Trigger condition set are removed, since they're never reconciled.
+func (m *statusConditionManager) initializeConditions() {
+	manager := m.manager()
+	manager.InitializeConditions()
+
+	_ = manager.ClearCondition(eventing.TriggerConditionSubscribed)
+	_ = manager.ClearCondition(eventing.TriggerConditionDependency)
+}
+
 func (m *statusConditionManager) failedToGetBroker(err error) reconciler.Event {
 
-	m.Trigger.Status.MarkBrokerFailed(
+	m.manager().MarkFalse(
+		ConditionBrokerReady,
 		"Failed to get broker",
 		"%v",
 		err,
@@ -47,10 +91,26 @@ func (m *statusConditionManager) failedToGetBroker(err error) reconciler.Event {
 	return fmt.Errorf("failed to get broker: %w", err)
 }
 
+func (m *statusConditionManager) propagateBrokerCondition(broker *eventing.Broker) {
+
+	bc := broker.Status.GetTopLevelCondition()
+	switch {
+	case bc == nil:
+		m.manager().MarkUnknown(ConditionBrokerReady, "BrokerNotConfigured", "Broker has not yet been reconciled.")
+	case bc.Status == corev1.ConditionTrue:
+		m.manager().MarkTrue(ConditionBrokerReady)
+	case bc.Status == corev1.ConditionFalse:
+		m.manager().MarkFalse(ConditionBrokerReady, bc.Reason, bc.Message)
+	default:
+		m.manager().MarkUnknown(ConditionBrokerReady, bc.Reason, bc.Message)
+	}
+}
+
 func (m *statusConditionManager) failedToGetDataPlaneConfigMap(err error) reconciler.Event {
 
 	reason := fmt.Sprintf("Failed to get data plane config map %s", m.Configs.DataPlaneConfigMapAsString())
-	m.Trigger.Status.MarkDependencyFailed(
+	m.manager().MarkFalse(
+		ConditionContractUpdated,
 		reason,
 		"%v",
 		err,
@@ -59,13 +119,10 @@ func (m *statusConditionManager) failedToGetDataPlaneConfigMap(err error) reconc
 	return fmt.Errorf(reason+": %w", err)
 }
 
-func (m *statusConditionManager) propagateBrokerCondition(broker *eventing.Broker) {
-	m.Trigger.Status.PropagateBrokerCondition(broker.Status.GetTopLevelCondition())
-}
-
 func (m *statusConditionManager) failedToGetDataPlaneConfigFromConfigMap(err error) reconciler.Event {
 
-	m.Trigger.Status.MarkDependencyFailed(
+	m.manager().MarkFalse(
+		ConditionContractUpdated,
 		"Failed to get data plane config from config map",
 		"%v",
 		err,
@@ -76,7 +133,8 @@ func (m *statusConditionManager) failedToGetDataPlaneConfigFromConfigMap(err err
 
 func (m *statusConditionManager) brokerNotFoundInDataPlaneConfigMap() reconciler.Event {
 
-	m.Trigger.Status.MarkBrokerFailed(
+	m.manager().MarkFalse(
+		ConditionBrokerReady,
 		"Broker not found in data plane map",
 		"config map: %s",
 		m.Configs.DataPlaneConfigMapAsString(),
@@ -85,21 +143,31 @@ func (m *statusConditionManager) brokerNotFoundInDataPlaneConfigMap() reconciler
 	return fmt.Errorf("broker not found in data plane config map %s", m.Configs.DataPlaneConfigMapAsString())
 }
 
-func (m *statusConditionManager) reconciled() reconciler.Event {
+func (m *statusConditionManager) contractUpdated() {
+	m.manager().MarkTrue(ConditionContractUpdated)
+}
+
+func (m *statusConditionManager) invalidTriggerConfig(err error) reconciler.Event {
 
-	m.Trigger.Status.MarkDependencySucceeded()
+	m.manager().MarkFalse(
+		ConditionConfigParsed,
+		"Invalid trigger config",
+		"%v",
+		err,
+	)
 
-	// TODO we don't have a subscription, consider register custom condition set for Triggers
-	m.Trigger.Status.PropagateSubscriptionCondition(&apis.Condition{
-		Status: corev1.ConditionTrue,
-	})
+	// Nothing changes until the Trigger is updated again.
+	return controller.NewPermanentError(err)
+}
 
-	return nil
+func (m *statusConditionManager) triggerConfigParsed() {
+	m.manager().MarkTrue(ConditionConfigParsed)
 }
 
 func (m *statusConditionManager) failedToResolveTriggerConfig(err error) reconciler.Event {
 
-	m.Trigger.Status.MarkSubscriberResolvedFailed(
+	m.manager().MarkFalse(
+		ConditionSubscriberResolved,
 		"Failed to resolve trigger config",
 		"%v",
 		err,
@@ -123,5 +191,156 @@ func (m *statusConditionManager) failedToUpdateDispatcherPodsAnnotation(err erro
 }
 
 func (m *statusConditionManager) subscriberResolved() {
-	m.Trigger.Status.MarkSubscriberResolvedSucceeded()
+	m.manager().MarkTrue(ConditionSubscriberResolved)
+}
+
+func (m *statusConditionManager) dataPlaneReady() {
+	m.manager().MarkTrue(ConditionDataPlaneReady)
+}
+
+func (m *statusConditionManager) noDataPlanePods() {
+
+	m.manager().MarkUnknown(
+		ConditionDataPlaneReady,
+		"Waiting for dispatcher pods",
+		"no running dispatcher pods",
+	)
+	m.waitingForDataPlaneConsumerGroup()
+}
+
+func (m *statusConditionManager) waitingForDataPlane(volumeGeneration uint64, acked, total int) {
+
+	m.manager().MarkUnknown(
+		ConditionDataPlaneReady,
+		"Waiting for dispatcher pods",
+		"%d of %d dispatcher pods applied volume generation %d",
+		acked,
+		total,
+		volumeGeneration,
+	)
+	m.waitingForDataPlaneConsumerGroup()
+}
+
+func (m *statusConditionManager) failedToGetDataPlanePods(err error) reconciler.Event {
+
+	m.manager().MarkFalse(
+		ConditionDataPlaneReady,
+		"Failed to get dispatcher pods",
+		"%v",
+		err,
+	)
+
+	return fmt.Errorf("failed to get dispatcher pods: %w", err)
+}
+
+// waitingForDataPlaneConsumerGroup marks the consumer group as not ready, since dispatcher pods join the consumer group
+// once they apply the Trigger.
+func (m *statusConditionManager) waitingForDataPlaneConsumerGroup() {
+
+	m.manager().MarkUnknown(
+		ConditionConsumerGroupReady,
+		"Waiting for dispatcher pods",
+		"dispatcher pods join the consumer group once they apply the Trigger",
+	)
+}
+
+func (m *statusConditionManager) consumerGroupReady() {
+	m.manager().MarkTrue(ConditionConsumerGroupReady)
+}
+
+func (m *statusConditionManager) waitingForConsumerGroup(group, state string) {
+
+	m.manager().MarkUnknown(
+		ConditionConsumerGroupReady,
+		"Waiting for consumer group",
+		"consumer group %s is %s",
+		group,
+		state,
+	)
+}
+
+func (m *statusConditionManager) failedToDescribeConsumerGroup(group string, err error) reconciler.Event {
+
+	m.manager().MarkFalse(
+		ConditionConsumerGroupReady,
+		"Failed to describe consumer group",
+		"%v",
+		err,
+	)
+
+	return fmt.Errorf("failed to describe consumer group %s: %w", group, err)
+}
+
+func (m *statusConditionManager) consumerLag(lag ConsumerLag, now time.Time) {
+
+	annotations := m.statusAnnotations()
+
+	committed := strconv.FormatInt(lag.Committed, 10)
+	if _, ok := annotations[ConsumerLastCommitAnnotation]; !ok || annotations[ConsumerCommittedOffsetAnnotation] != committed {
+		annotations[ConsumerLastCommitAnnotation] = now.UTC().Format(time.RFC3339)
+	}
+
+	annotations[ConsumerLagAnnotation] = strconv.FormatInt(lag.Total, 10)
+	annotations[ConsumerMaxPartitionLagAnnotation] = strconv.FormatInt(lag.Max, 10)
+	annotations[ConsumerCommittedOffsetAnnotation] = committed
+}
+
+func (m *statusConditionManager) invalidReplay(err error) {
+
+	// The Trigger is reconciled as if it didn't request a replay.
+
+	// Record the event.
+	m.Recorder.Eventf(
+		m.Trigger,
+		corev1.EventTypeWarning,
+		"InvalidReplay",
+		"%v",
+		err,
+	)
+}
+
+func (m *statusConditionManager) waitingForReplay(group, state string) {
+
+	m.manager().MarkUnknown(
+		ConditionConsumerGroupReady,
+		"Waiting for replay",
+		"consumer group %s is %s, offsets are reset once dispatcher pods leave it",
+		group,
+		state,
+	)
+}
+
+func (m *statusConditionManager) failedToReplay(err error) reconciler.Event {
+
+	m.manager().MarkFalse(
+		ConditionConsumerGroupReady,
+		"Failed to replay",
+		"%v",
+		err,
+	)
+
+	return fmt.Errorf("failed to replay: %w", err)
+}
+
+func (m *statusConditionManager) replayed(replay *replay, now time.Time) {
+
+	annotations := m.statusAnnotations()
+	annotations[ReplayStatusAnnotation] = replay.value
+	annotations[ReplayTimeAnnotation] = now.UTC().Format(time.RFC3339)
+
+	m.Recorder.Eventf(
+		m.Trigger,
+		corev1.EventTypeNormal,
+		"Replayed",
+		"reset offsets of consumer group %s to %s",
+		ConsumerGroup(m.Trigger),
+		replay.value,
+	)
+}
+
+func (m *statusConditionManager) statusAnnotations() map[string]string {
+	if m.Trigger.Status.Annotations == nil {
+		m.Trigger.Status.Annotations = make(map[string]string)
+	}
+	return m.Trigger.Status.Annotations
 }
@@ -18,14 +18,18 @@ package trigger
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/Shopify/sarama"
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgotesting "k8s.io/client-go/testing"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	eventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
@@ -44,19 +48,52 @@ import (
 	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
 )
 
+const (
+	consumerGroupState          = "consumerGroupState"
+	describeConsumerGroupsError = "describeConsumerGroupsError"
+	consumerGroupOffsets        = "consumerGroupOffsets"
+	endOffsets                  = "endOffsets"
+	getOffsetError              = "getOffsetError"
+	deleteConsumerGroupError    = "deleteConsumerGroupError"
+	commitOffsets               = "commitOffsets"
+)
+
 const (
 	// name of the trigger under test
 	triggerName = "test-trigger"
 	// namespace of the trigger under test
 	triggerNamespace = "test-namespace"
+	// bootstrap servers of the Kafka cluster of the broker of the trigger under test
+	triggerBootstrapServers = "kafka-1:9092,kafka-2:9092"
 )
 
 var (
+	now = time.Date(2020, time.November, 1, 10, 0, 0, 0, time.UTC)
+
 	finalizerUpdatedEvent = Eventf(
 		corev1.EventTypeNormal,
 		"FinalizerUpdate",
 		fmt.Sprintf(`Updated %q finalizers`, triggerName),
 	)
+
+	deadLetterSinkNotFoundDelivery = `{"deadLetterSink": {"ref": {"apiVersion": "eventing.knative.dev/v1", "kind": "Broker", "namespace": "test-namespace", "name": "dls"}}, "retry": 5}`
+	deadLetterSinkNotFoundErrMsg   = fmt.Sprintf(
+		`failed to resolve annotation %s deadLetterSink: failed to get ref &ObjectReference{Kind:Broker,Namespace:%s,Name:dls,UID:,APIVersion:eventing.knative.dev/v1,ResourceVersion:,FieldPath:,}: brokers.eventing.knative.dev "dls" not found`,
+		DeliveryAnnotation,
+		triggerNamespace,
+	)
+
+	unsupportedDeliveryOrderErrMsg = fmt.Sprintf(
+		`unsupported annotation %s value "sorted" - supported values: %s, %s`,
+		DeliveryOrderAnnotation,
+		DeliveryOrderUnordered,
+		DeliveryOrderOrdered,
+	)
+
+	invalidFiltersErrMsg = fmt.Sprintf(
+		`invalid annotation %s: [0].any: at least one filter expression is required`,
+		FiltersAnnotation,
+	)
 )
 
 func TestTriggerReconciler(t *testing.T) {
@@ -93,17 +130,1000 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 						},
 					},
 				}, &configs),
-				NewDispatcherPod(configs.SystemNamespace, nil),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
+					),
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - with delivery annotation",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+					WithRetry(3, eventingduck.BackoffPolicyExponential, "PT1S"),
+				),
+				newTrigger(withDelivery(`{"deadLetterSink": {"uri": "http://dls.example.com"}, "retry": 5, "timeout": "PT10S"}`)),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination:    ServiceURL,
+									Id:             TriggerUUID,
+									DeadLetterSink: "http://dls.example.com",
+									EgressConfig: &coreconfig.EgressConfig{
+										Retry:         5,
+										BackoffPolicy: coreconfig.BackoffPolicy_Exponential,
+										BackoffDelay:  1000,
+										Timeout:       10000,
+									},
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withDelivery(`{"deadLetterSink": {"uri": "http://dls.example.com"}, "retry": 5, "timeout": "PT10S"}`),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
+					),
+				},
+			},
+		},
+		{
+			Name: "Unsupported delivery annotation",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withDelivery(`{"backoffDelay": "PT1S"}`)),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"unsupported delivery spec: backoffPolicy and backoffDelay require retry",
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withDelivery(`{"backoffDelay": "PT1S"}`),
+						withInitKafkaTriggerConditions,
+						withBrokerReady,
+						withConfigNotParsed("unsupported delivery spec: backoffPolicy and backoffDelay require retry"),
+					),
+				},
+			},
+		},
+		{
+			Name: "Dead letter sink not resolved",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withDelivery(deadLetterSinkNotFoundDelivery)),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					"failed to resolve trigger config: %s",
+					deadLetterSinkNotFoundErrMsg,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withDelivery(deadLetterSinkNotFoundDelivery),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberNotResolved(deadLetterSinkNotFoundErrMsg),
+					),
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - ordered delivery",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withDeliveryOrder(DeliveryOrderOrdered)),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination:   ServiceURL,
+									Id:            TriggerUUID,
+									DeliveryOrder: coreconfig.DeliveryOrder_Ordered,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withDeliveryOrder(DeliveryOrderOrdered),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
+					),
+				},
+			},
+		},
+		{
+			Name: "Unsupported delivery order",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withDeliveryOrder("sorted")),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					unsupportedDeliveryOrderErrMsg,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withDeliveryOrder("sorted"),
+						withInitKafkaTriggerConditions,
+						withBrokerReady,
+						withConfigNotParsed(unsupportedDeliveryOrderErrMsg),
+					),
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - filters",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withFilters(`[{"prefix": {"type": "dev.knative."}}, {"not": {"exact": {"source": "ignored"}}}]`)),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+									Filters: []*coreconfig.Filter{
+										{
+											Attributes: map[string]string{"type": "dev.knative."},
+											Dialect:    coreconfig.FilterDialect_Prefix,
+										},
+										{
+											Dialect: coreconfig.FilterDialect_Not,
+											Filters: []*coreconfig.Filter{
+												{
+													Attributes: map[string]string{"source": "ignored"},
+													Dialect:    coreconfig.FilterDialect_Exact,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "1",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withFilters(`[{"prefix": {"type": "dev.knative."}}, {"not": {"exact": {"source": "ignored"}}}]`),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
+					),
+				},
+			},
+		},
+		{
+			Name: "Invalid filters",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withFilters(`[{"any": []}]`)),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					invalidFiltersErrMsg,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withFilters(`[{"any": []}]`),
+						withInitKafkaTriggerConditions,
+						withBrokerReady,
+						withConfigNotParsed(invalidFiltersErrMsg),
+					),
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - waiting for dispatcher pods",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+						},
+					},
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAckAnnotationKey: "0",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}),
+				RunningDispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "0",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneNotReady(1, 0, 1),
+					),
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - dispatcher pods applied the trigger",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withConsumerGroupReady,
+					),
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - record Kafka cluster",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+					AuthSecretRefStatus(triggerNamespace, "kafka-auth"),
+				),
+				newTrigger(),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:               BrokerUUID,
+							Topic:            GetTopic(),
+							Path:             broker.Path(BrokerNamespace, BrokerName),
+							BootstrapServers: triggerBootstrapServers,
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withConsumerGroupReady,
+						withRecordedKafkaCluster(triggerBootstrapServers, triggerNamespace+"/kafka-auth"),
+					),
+				},
+			},
+		},
+		{
+			Name: "Replay - pause the Trigger",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withReplay("earliest")),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+									Paused:      true,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 2,
+				}),
+				RunningDispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "2",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withReplay("earliest"),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneNotReady(2, 0, 1),
+					),
+				},
+			},
+		},
+		{
+			Name: "Replay - waiting for consumer group to be empty",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withReplay("earliest")),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+									Paused:      true,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withReplay("earliest"),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withReplayNotReady(ConsumerGroupStable),
+					),
+				},
+			},
+		},
+		{
+			Name: "Replay - offsets reset, resume the Trigger",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withReplay("2020-11-01T09:00:00Z")),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+									Paused:      true,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			OtherTestData: map[string]interface{}{
+				consumerGroupState: ConsumerGroupEmpty,
+				commitOffsets:      true,
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeNormal,
+					"Replayed",
+					fmt.Sprintf("reset offsets of consumer group %s to 2020-11-01T09:00:00Z", TriggerUUID),
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 2,
+				}),
+				RunningDispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "2",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withReplay("2020-11-01T09:00:00Z"),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withReplayed("2020-11-01T09:00:00Z", now),
+						withDataPlaneNotReady(2, 0, 1),
+					),
+				},
+			},
+		},
+		{
+			Name: "Replay - failed to reset offsets",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withReplay("earliest")),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+									Paused:      true,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key:     testKey,
+			WantErr: true,
+			OtherTestData: map[string]interface{}{
+				consumerGroupState: ConsumerGroupDead,
+				endOffsets:         map[int32]int64{0: 10},
+				getOffsetError:     errors.New("failed"),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					fmt.Sprintf("failed to replay: failed to get offset of partition 0 of topic %s: failed", GetTopic()),
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withReplay("earliest"),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withConsumerGroupFailed("Failed to replay", fmt.Sprintf("failed to get offset of partition 0 of topic %s: failed", GetTopic())),
+					),
+				},
+			},
+		},
+		{
+			Name: "Replay - invalid annotation",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(withReplay("yesterday")),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InvalidReplay",
+					`invalid kafka.eventing.knative.dev/replay annotation "yesterday", expected "earliest" or an RFC 3339 timestamp: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`,
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withReplay("yesterday"),
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withConsumerGroupReady,
+					),
+				},
+			},
+		},
+		{
+			Name: "Reconciled normal - waiting for consumer group",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Path:  broker.Path(BrokerNamespace, BrokerName),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
+				}),
 			},
 			Key: testKey,
+			OtherTestData: map[string]interface{}{
+				consumerGroupState: "PreparingRebalance",
+			},
 			WantEvents: []string{
 				finalizerUpdatedEvent,
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
 			},
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withConsumerGroupNotReady("PreparingRebalance"),
+					),
+				},
+			},
+		},
+		{
+			Name: "Failed to describe consumer group",
+			Objects: []runtime.Object{
+				NewBroker(
+					BrokerReady,
+				),
+				newTrigger(),
+				NewService(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
 						{
 							Id:    BrokerUUID,
@@ -118,20 +1138,39 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 						},
 					},
 					VolumeGeneration: 1,
+				}, &configs),
+				NewRunningDispatcherPod(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey:    "1",
+					base.VolumeGenerationAckAnnotationKey: "1",
 				}),
-				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "1",
-				}),
+			},
+			Key:     testKey,
+			WantErr: true,
+			OtherTestData: map[string]interface{}{
+				describeConsumerGroupsError: errors.New("failed"),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					fmt.Sprintf("failed to describe consumer group %s: failed", TriggerUUID),
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
 			},
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerSubscribed(),
+						withInitKafkaTriggerConditions,
 						withSubscriberURI,
-						reconcilertesting.WithTriggerDependencyReady(),
-						reconcilertesting.WithTriggerBrokerReady(),
-						reconcilertesting.WithTriggerSubscriberResolvedSucceeded(),
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withDataPlaneReady,
+						withConsumerGroupFailed("Failed to describe consumer group", "failed"),
 					),
 				},
 			},
@@ -232,12 +1271,13 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerSubscribed(),
+						withInitKafkaTriggerConditions,
 						withSubscriberURI,
-						reconcilertesting.WithTriggerDependencyReady(),
-						reconcilertesting.WithTriggerBrokerReady(),
-						reconcilertesting.WithTriggerSubscriberResolvedSucceeded(),
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
 					),
 				},
 			},
@@ -274,8 +1314,8 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerBrokerFailed(
+						withInitKafkaTriggerConditions,
+						withBrokerFailed(
 							"Broker not found in data plane map",
 							fmt.Sprintf("config map: %s", configs.DataPlaneConfigMapAsString()),
 						),
@@ -310,8 +1350,8 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerBrokerFailed(
+						withInitKafkaTriggerConditions,
+						withBrokerFailed(
 							"Broker not found in data plane map",
 							fmt.Sprintf("config map: %s", configs.DataPlaneConfigMapAsString()),
 						),
@@ -334,7 +1374,7 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -358,7 +1398,7 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -388,7 +1428,7 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -449,7 +1489,7 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -493,42 +1533,19 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
 			},
-			WantUpdates: []clientgotesting.UpdateActionImpl{
-				ConfigMapUpdate(&configs, &coreconfig.Brokers{
-					Brokers: []*coreconfig.Broker{
-						{
-							Id:    BrokerUUID,
-							Topic: GetTopic(),
-							Path:  broker.Path(BrokerNamespace, BrokerName),
-							Triggers: []*coreconfig.Trigger{
-								{
-									Destination: ServiceURL,
-									Id:          TriggerUUID,
-									Attributes: map[string]string{
-										"type": "type1",
-									},
-								},
-							},
-						},
-					},
-					VolumeGeneration: 1,
-				}),
-				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "1",
-				}),
-			},
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
 						withAttributes(map[string]string{
 							"type": "type1",
 						}),
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerSubscribed(),
+						withInitKafkaTriggerConditions,
 						withSubscriberURI,
-						reconcilertesting.WithTriggerDependencyReady(),
-						reconcilertesting.WithTriggerBrokerReady(),
-						reconcilertesting.WithTriggerSubscriberResolvedSucceeded(),
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
 					),
 				},
 			},
@@ -693,12 +1710,13 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 						withAttributes(map[string]string{
 							"type": "type1",
 						}),
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerSubscribed(),
+						withInitKafkaTriggerConditions,
 						withSubscriberURI,
-						reconcilertesting.WithTriggerDependencyReady(),
-						reconcilertesting.WithTriggerBrokerReady(),
-						reconcilertesting.WithTriggerSubscriberResolvedSucceeded(),
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
 					),
 				},
 			},
@@ -859,12 +1877,13 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 						withAttributes(map[string]string{
 							"ext": "extval",
 						}),
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerSubscribed(),
+						withInitKafkaTriggerConditions,
 						withSubscriberURI,
-						reconcilertesting.WithTriggerDependencyReady(),
-						reconcilertesting.WithTriggerBrokerReady(),
-						reconcilertesting.WithTriggerSubscriberResolvedSucceeded(),
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
 					),
 				},
 			},
@@ -951,172 +1970,347 @@ func triggerReconciliation(t *testing.T, format string, configs broker.Configs)
 									Id:          "1",
 								},
 								{
-									Destination: "http://example.com/2",
-									Id:          "2",
-									Attributes: map[string]string{
-										"source": "source2",
-									},
+									Destination: "http://example.com/2",
+									Id:          "2",
+									Attributes: map[string]string{
+										"source": "source2",
+									},
+								},
+								{
+									Destination: "http://example.com/3",
+									Id:          "3",
+									Attributes: map[string]string{
+										"source": "source3",
+									},
+								},
+							},
+						},
+					},
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withSubscriberURI,
+						withBrokerReady,
+						withConfigParsed,
+						withSubscriberResolved,
+						withContractUpdated,
+						withNoDataPlanePods,
+					),
+				},
+			},
+		},
+	}
+
+	for i := range table {
+		table[i].Name = table[i].Name + " - " + format
+	}
+
+	useTable(t, table, &configs)
+}
+
+func TestTriggerFinalizer(t *testing.T) {
+
+	t.Parallel()
+
+	for _, f := range Formats {
+		triggerFinalizer(t, f, *DefaultConfigs)
+	}
+
+}
+
+func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
+
+	testKey := fmt.Sprintf("%s/%s", triggerNamespace, triggerName)
+
+	configs.DataPlaneConfigFormat = format
+
+	table := TableTest{
+		{
+			Name: "Broker not found, delete consumer group of recorded Kafka cluster",
+			Objects: []runtime.Object{
+				newTrigger(withRecordedKafkaCluster(triggerBootstrapServers, "")),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withRecordedKafkaCluster("", ""),
+					),
+				},
+			},
+		},
+		{
+			Name: "Broker deleted, no broker in config map, consumer group of recorded Kafka cluster still active",
+			Objects: []runtime.Object{
+				newTrigger(withRecordedKafkaCluster(triggerBootstrapServers, "")),
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					VolumeGeneration: 8,
+				}, &configs),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			Key:     testKey,
+			WantErr: true,
+			OtherTestData: map[string]interface{}{
+				deleteConsumerGroupError: sarama.ErrNonEmptyGroup,
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					fmt.Sprintf("consumer group %s still has active members: %v", TriggerUUID, sarama.ErrNonEmptyGroup),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withRecordedKafkaCluster(triggerBootstrapServers, ""),
+					),
+				},
+			},
+		},
+		{
+			Name: "Broker not found, auth secret of recorded Kafka cluster not found",
+			Objects: []runtime.Object{
+				newTrigger(withRecordedKafkaCluster(triggerBootstrapServers, triggerNamespace+"/kafka-auth")),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+						withRecordedKafkaCluster("", ""),
+					),
+				},
+			},
+		},
+		{
+			Name: "Broker deleted, trigger in config map",
+			Objects: []runtime.Object{
+				newTrigger(),
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID + "a",
+								},
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
+								},
+							},
+						},
+					},
+					VolumeGeneration: 8,
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			Key: testKey,
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID + "a",
+								},
+							},
+						},
+					},
+					VolumeGeneration: 9,
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "9",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+					),
+				},
+			},
+		},
+		{
+			Name: "Broker deleted, consumer group still active",
+			Objects: []runtime.Object{
+				newTrigger(),
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
+						{
+							Id:    BrokerUUID,
+							Topic: GetTopic(),
+							Triggers: []*coreconfig.Trigger{
+								{
+									Destination: ServiceURL,
+									Id:          TriggerUUID + "a",
 								},
 								{
-									Destination: "http://example.com/3",
-									Id:          "3",
-									Attributes: map[string]string{
-										"source": "source3",
-									},
+									Destination: ServiceURL,
+									Id:          TriggerUUID,
 								},
 							},
 						},
 					},
+					VolumeGeneration: 8,
 				}, &configs),
 				NewDispatcherPod(configs.SystemNamespace, nil),
 			},
-			Key: testKey,
-			WantEvents: []string{
-				finalizerUpdatedEvent,
-			},
 			WantPatches: []clientgotesting.PatchActionImpl{
 				patchFinalizers(),
 			},
+			Key:     testKey,
+			WantErr: true,
+			OtherTestData: map[string]interface{}{
+				deleteConsumerGroupError: sarama.ErrNonEmptyGroup,
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(
+					corev1.EventTypeWarning,
+					"InternalError",
+					fmt.Sprintf("consumer group %s still has active members: %v", TriggerUUID, sarama.ErrNonEmptyGroup),
+				),
+			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{
 				ConfigMapUpdate(&configs, &coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
 						{
-							Id:    BrokerUUID + "a",
+							Id:    BrokerUUID,
 							Topic: GetTopic(),
-							Path:  broker.Path(BrokerNamespace, BrokerName),
 							Triggers: []*coreconfig.Trigger{
 								{
 									Destination: ServiceURL,
-									Id:          TriggerUUID,
-								},
-								{
-									Destination: "http://example.com/1",
-									Id:          "1",
-								},
-								{
-									Destination: "http://example.com/2",
-									Id:          "2",
-									Attributes: map[string]string{
-										"source": "source2",
-									},
-								},
-								{
-									Destination: "http://example.com/3",
-									Id:          "3",
-									Attributes: map[string]string{
-										"source": "source3",
-									},
+									Id:          TriggerUUID + "a",
 								},
 							},
 						},
+					},
+					VolumeGeneration: 9,
+				}),
+				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
+					base.VolumeGenerationAnnotationKey: "9",
+				}),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: newTrigger(
+						withInitKafkaTriggerConditions,
+					),
+				},
+			},
+		},
+		{
+			Name: "Broker deleted, consumer group not found",
+			Objects: []runtime.Object{
+				newTrigger(),
+				NewDeletedBroker(),
+				NewConfigMapFromBrokers(&coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
 						{
 							Id:    BrokerUUID,
 							Topic: GetTopic(),
-							Path:  broker.Path(BrokerNamespace, BrokerName),
 							Triggers: []*coreconfig.Trigger{
 								{
-									Destination: "http://example.com/1",
-									Id:          "1",
-								},
-								{
-									Destination: "http://example.com/2",
-									Id:          "2",
-									Attributes: map[string]string{
-										"source": "source2",
-									},
+									Destination: ServiceURL,
+									Id:          TriggerUUID + "a",
 								},
 								{
 									Destination: ServiceURL,
 									Id:          TriggerUUID,
 								},
-								{
-									Destination: "http://example.com/3",
-									Id:          "3",
-									Attributes: map[string]string{
-										"source": "source3",
-									},
-								},
 							},
 						},
+					},
+					VolumeGeneration: 8,
+				}, &configs),
+				NewDispatcherPod(configs.SystemNamespace, nil),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			Key: testKey,
+			OtherTestData: map[string]interface{}{
+				deleteConsumerGroupError: sarama.ErrGroupIDNotFound,
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				ConfigMapUpdate(&configs, &coreconfig.Brokers{
+					Brokers: []*coreconfig.Broker{
 						{
-							Id:    BrokerUUID + "a",
+							Id:    BrokerUUID,
 							Topic: GetTopic(),
-							Path:  broker.Path(BrokerNamespace, BrokerName),
 							Triggers: []*coreconfig.Trigger{
 								{
 									Destination: ServiceURL,
-									Id:          TriggerUUID,
-								},
-								{
-									Destination: "http://example.com/1",
-									Id:          "1",
-								},
-								{
-									Destination: "http://example.com/2",
-									Id:          "2",
-									Attributes: map[string]string{
-										"source": "source2",
-									},
-								},
-								{
-									Destination: "http://example.com/3",
-									Id:          "3",
-									Attributes: map[string]string{
-										"source": "source3",
-									},
+									Id:          TriggerUUID + "a",
 								},
 							},
 						},
 					},
-					VolumeGeneration: 1,
+					VolumeGeneration: 9,
 				}),
 				DispatcherPodUpdate(configs.SystemNamespace, map[string]string{
-					base.VolumeGenerationAnnotationKey: "1",
+					base.VolumeGenerationAnnotationKey: "9",
 				}),
 			},
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
-						reconcilertesting.WithTriggerSubscribed(),
-						withSubscriberURI,
-						reconcilertesting.WithTriggerDependencyReady(),
-						reconcilertesting.WithTriggerBrokerReady(),
-						reconcilertesting.WithTriggerSubscriberResolvedSucceeded(),
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
 		},
-	}
-
-	for i := range table {
-		table[i].Name = table[i].Name + " - " + format
-	}
-
-	useTable(t, table, &configs)
-}
-
-func TestTriggerFinalizer(t *testing.T) {
-
-	t.Parallel()
-
-	for _, f := range Formats {
-		triggerFinalizer(t, f, *DefaultConfigs)
-	}
-
-}
-
-func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
-
-	testKey := fmt.Sprintf("%s/%s", triggerNamespace, triggerName)
-
-	configs.DataPlaneConfigFormat = format
-
-	table := TableTest{
 		{
-			Name: "Broker deleted, trigger in config map",
+			Name: "Broker deleted, keep consumer group",
 			Objects: []runtime.Object{
-				newTrigger(),
+				newTrigger(withKeepConsumerGroup),
 				NewDeletedBroker(),
 				NewConfigMapFromBrokers(&coreconfig.Brokers{
 					Brokers: []*coreconfig.Broker{
@@ -1143,6 +2337,9 @@ func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
 				patchFinalizers(),
 			},
 			Key: testKey,
+			OtherTestData: map[string]interface{}{
+				deleteConsumerGroupError: errors.New("consumer group deleted"),
+			},
 			WantEvents: []string{
 				finalizerUpdatedEvent,
 			},
@@ -1169,7 +2366,8 @@ func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withKeepConsumerGroup,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -1199,7 +2397,7 @@ func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -1223,7 +2421,7 @@ func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -1374,7 +2572,7 @@ func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -1525,7 +2723,7 @@ func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -1734,7 +2932,7 @@ func triggerFinalizer(t *testing.T, format string, configs broker.Configs) {
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 				{
 					Object: newTrigger(
-						reconcilertesting.WithInitTriggerConditions,
+						withInitKafkaTriggerConditions,
 					),
 				},
 			},
@@ -1762,7 +2960,51 @@ func useTable(t *testing.T, table TableTest, configs *broker.Configs) {
 			BrokerLister:   listers.GetBrokerLister(),
 			EventingClient: eventingclient.Get(ctx),
 			Resolver:       nil,
-			Configs:        &configs.EnvConfigs,
+			NewClusterAdmin: func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+				state := ConsumerGroupStable
+				if s, ok := row.OtherTestData[consumerGroupState]; ok {
+					state = s.(string)
+				}
+				var onDescribeConsumerGroupsError error
+				if err, ok := row.OtherTestData[describeConsumerGroupsError]; ok {
+					onDescribeConsumerGroupsError = err.(error)
+				}
+
+				offsets, _ := row.OtherTestData[consumerGroupOffsets].(*sarama.OffsetFetchResponse)
+				onDeleteConsumerGroupError, _ := row.OtherTestData[deleteConsumerGroupError].(error)
+
+				return &MockKafkaClusterAdmin{
+					ExpectedTopicName:      GetTopic(),
+					ExpectedConsumerGroups: []string{TriggerUUID},
+					ConsumerGroupsDescriptions: []*sarama.GroupDescription{
+						{GroupId: TriggerUUID, State: state},
+					},
+					ErrorOnDescribeConsumerGroups: onDescribeConsumerGroupsError,
+					ConsumerGroupOffsets:          offsets,
+					ErrorOnDeleteConsumerGroup:    onDeleteConsumerGroupError,
+					T:                             t,
+				}, nil
+			},
+			NewClient: func(addrs []string, config *sarama.Config) (sarama.Client, error) {
+				offsets, _ := row.OtherTestData[endOffsets].(map[int32]int64)
+				onGetOffsetError, _ := row.OtherTestData[getOffsetError].(error)
+
+				var coordinator *sarama.Broker
+				if _, ok := row.OtherTestData[commitOffsets]; ok {
+					coordinator = newCoordinator(t)
+				}
+
+				return &MockKafkaClient{
+					ExpectedTopicName: GetTopic(),
+					EndOffsets:        offsets,
+					ErrorOnGetOffset:  onGetOffsetError,
+					CoordinatorBroker: coordinator,
+					T:                 t,
+				}, nil
+			},
+			EnqueueAfter: func(key types.NamespacedName, delay time.Duration) {},
+			Now:          func() time.Time { return now },
+			Configs:      &configs.EnvConfigs,
 		}
 
 		reconciler.Resolver = resolver.NewURIResolver(ctx, func(name types.NamespacedName) {})
@@ -1798,6 +3040,86 @@ func newTrigger(options ...reconcilertesting.TriggerOption) runtime.Object {
 	)
 }
 
+func withReplay(replay string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		trigger.Annotations = map[string]string{ReplayAnnotation: replay}
+	}
+}
+
+func withReplayNotReady(state string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		ConditionSet.Manage(&trigger.Status).MarkUnknown(
+			ConditionConsumerGroupReady,
+			"Waiting for replay",
+			"consumer group %s is %s, offsets are reset once dispatcher pods leave it",
+			TriggerUUID,
+			state,
+		)
+	}
+}
+
+func withReplayed(replay string, at time.Time) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		trigger.Status.Annotations = map[string]string{
+			ReplayStatusAnnotation: replay,
+			ReplayTimeAnnotation:   at.Format(time.RFC3339),
+		}
+	}
+}
+
+// newCoordinator returns a sarama Broker connected to a mock broker accepting offset commits.
+func newCoordinator(t *testing.T) *sarama.Broker {
+	mockBroker := sarama.NewMockBroker(t, 1)
+	t.Cleanup(mockBroker.Close)
+
+	mockBroker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+	})
+
+	coordinator := sarama.NewBroker(mockBroker.Addr())
+	if err := coordinator.Open(sarama.NewConfig()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = coordinator.Close() })
+
+	return coordinator
+}
+
+func withDelivery(delivery string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		trigger.Annotations = map[string]string{DeliveryAnnotation: delivery}
+	}
+}
+
+func withDeliveryOrder(order string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		trigger.Annotations = map[string]string{DeliveryOrderAnnotation: order}
+	}
+}
+
+func withFilters(filters string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		trigger.Annotations = map[string]string{FiltersAnnotation: filters}
+	}
+}
+
+// withRecordedKafkaCluster records the given Kafka cluster on the Trigger status, empty values aren't recorded.
+func withRecordedKafkaCluster(bootstrapServers, authSecretRef string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		trigger.Status.Annotations = map[string]string{}
+		if bootstrapServers != "" {
+			trigger.Status.Annotations[BootstrapServersStatusAnnotation] = bootstrapServers
+		}
+		if authSecretRef != "" {
+			trigger.Status.Annotations[AuthSecretRefStatusAnnotation] = authSecretRef
+		}
+	}
+}
+
+func withKeepConsumerGroup(trigger *eventing.Trigger) {
+	trigger.Annotations = map[string]string{DeleteConsumerGroupAnnotation: "false"}
+}
+
 func withAttributes(attributes eventing.TriggerFilterAttributes) func(*eventing.Trigger) {
 	return func(e *eventing.Trigger) {
 		e.Spec.Filter = &eventing.TriggerFilter{
@@ -1806,6 +3128,135 @@ func withAttributes(attributes eventing.TriggerFilterAttributes) func(*eventing.
 	}
 }
 
+func withInitKafkaTriggerConditions(trigger *eventing.Trigger) {
+	reconcilertesting.WithInitTriggerConditions(trigger)
+
+	manager := ConditionSet.Manage(&trigger.Status)
+	manager.InitializeConditions()
+	_ = manager.ClearCondition(eventing.TriggerConditionSubscribed)
+	_ = manager.ClearCondition(eventing.TriggerConditionDependency)
+}
+
+func withBrokerReady(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkTrue(ConditionBrokerReady)
+}
+
+func withBrokerFailed(reason, message string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		ConditionSet.Manage(&trigger.Status).MarkFalse(ConditionBrokerReady, reason, message)
+	}
+}
+
+func withConfigParsed(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkTrue(ConditionConfigParsed)
+}
+
+func withConfigNotParsed(err string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		ConditionSet.Manage(&trigger.Status).MarkFalse(
+			ConditionConfigParsed,
+			"Invalid trigger config",
+			"%s",
+			err,
+		)
+	}
+}
+
+func withSubscriberResolved(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkTrue(ConditionSubscriberResolved)
+}
+
+func withSubscriberNotResolved(err string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		ConditionSet.Manage(&trigger.Status).MarkFalse(
+			ConditionSubscriberResolved,
+			"Failed to resolve trigger config",
+			"%s",
+			err,
+		)
+	}
+}
+
+func withContractUpdated(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkTrue(ConditionContractUpdated)
+}
+
+func withDataPlaneReady(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkTrue(ConditionDataPlaneReady)
+}
+
+func withNoDataPlanePods(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkUnknown(
+		ConditionDataPlaneReady,
+		"Waiting for dispatcher pods",
+		"no running dispatcher pods",
+	)
+	withConsumerGroupWaitingForDataPlane(trigger)
+}
+
+func withDataPlaneNotReady(volumeGeneration uint64, acked, total int) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		ConditionSet.Manage(&trigger.Status).MarkUnknown(
+			ConditionDataPlaneReady,
+			"Waiting for dispatcher pods",
+			"%d of %d dispatcher pods applied volume generation %d",
+			acked,
+			total,
+			volumeGeneration,
+		)
+		withConsumerGroupWaitingForDataPlane(trigger)
+	}
+}
+
+func withConsumerGroupWaitingForDataPlane(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkUnknown(
+		ConditionConsumerGroupReady,
+		"Waiting for dispatcher pods",
+		"dispatcher pods join the consumer group once they apply the Trigger",
+	)
+}
+
+func withConsumerGroupReady(trigger *eventing.Trigger) {
+	ConditionSet.Manage(&trigger.Status).MarkTrue(ConditionConsumerGroupReady)
+}
+
+func withConsumerLag(total, max, committed int64, lastCommit time.Time) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		trigger.Status.Annotations = map[string]string{
+			ConsumerLagAnnotation:             fmt.Sprintf("%d", total),
+			ConsumerMaxPartitionLagAnnotation: fmt.Sprintf("%d", max),
+			ConsumerCommittedOffsetAnnotation: fmt.Sprintf("%d", committed),
+			ConsumerLastCommitAnnotation:      lastCommit.Format(time.RFC3339),
+		}
+	}
+}
+
+func offsetFetchResponse(offsets map[int32]int64) *sarama.OffsetFetchResponse {
+	response := &sarama.OffsetFetchResponse{}
+	for partition, offset := range offsets {
+		response.AddBlock(GetTopic(), partition, &sarama.OffsetFetchResponseBlock{Offset: offset})
+	}
+	return response
+}
+
+func withConsumerGroupFailed(reason, message string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		ConditionSet.Manage(&trigger.Status).MarkFalse(ConditionConsumerGroupReady, reason, message)
+	}
+}
+
+func withConsumerGroupNotReady(state string) func(*eventing.Trigger) {
+	return func(trigger *eventing.Trigger) {
+		ConditionSet.Manage(&trigger.Status).MarkUnknown(
+			ConditionConsumerGroupReady,
+			"Waiting for consumer group",
+			"consumer group %s is %s",
+			TriggerUUID,
+			state,
+		)
+	}
+}
+
 func withSubscriberURI(trigger *eventing.Trigger) {
 	u, err := apis.ParseURL(ServiceURL)
 	if err != nil {
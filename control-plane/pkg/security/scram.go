@@ -0,0 +1,55 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"github.com/Shopify/sarama"
+	"github.com/xdg/scram"
+)
+
+var _ sarama.SCRAMClient = &scramClient{}
+
+// scramClient adapts the xdg SCRAM client to sarama for SASL/SCRAM authentication.
+type scramClient struct {
+	hashGenerator scram.HashGeneratorFcn
+
+	// nonceGenerator overrides the nonce generator of the xdg client when not nil.
+	nonceGenerator func() string
+
+	*scram.ClientConversation
+}
+
+func (c *scramClient) Begin(user, password, authzID string) error {
+	client, err := c.hashGenerator.NewClient(user, password, authzID)
+	if err != nil {
+		return err
+	}
+	if c.nonceGenerator != nil {
+		client = client.WithNonceGenerator(c.nonceGenerator)
+	}
+
+	c.ClientConversation = client.NewConversation()
+	return nil
+}
+
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.ClientConversation.Step(challenge)
+}
+
+func (c *scramClient) Done() bool {
+	return c.ClientConversation.Done()
+}
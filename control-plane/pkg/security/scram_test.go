@@ -0,0 +1,108 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rfc7677Nonce() string {
+	return "rOprNGfwEbeRWgbNEkqO"
+}
+
+// Test vector from RFC 7677 section 3.
+func TestScramClientSHA256(t *testing.T) {
+
+	c := &scramClient{
+		hashGenerator:  sha256.New,
+		nonceGenerator: rfc7677Nonce,
+	}
+
+	assert.Nil(t, c.Begin("user", "pencil", ""))
+
+	clientFirst, err := c.Step("")
+	assert.Nil(t, err)
+	assert.Equal(t, "n,,n=user,r=rOprNGfwEbeRWgbNEkqO", clientFirst)
+	assert.False(t, c.Done())
+
+	clientFinal, err := c.Step("r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096")
+	assert.Nil(t, err)
+	assert.Equal(t, "c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=", clientFinal)
+	assert.False(t, c.Done())
+
+	msg, err := c.Step("v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=")
+	assert.Nil(t, err)
+	assert.Equal(t, "", msg)
+	assert.True(t, c.Done())
+}
+
+func TestScramClientInvalidServerSignature(t *testing.T) {
+
+	c := &scramClient{
+		hashGenerator:  sha256.New,
+		nonceGenerator: rfc7677Nonce,
+	}
+
+	assert.Nil(t, c.Begin("user", "pencil", ""))
+
+	_, err := c.Step("")
+	assert.Nil(t, err)
+
+	_, err = c.Step("r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096")
+	assert.Nil(t, err)
+
+	_, err = c.Step("v=aW52YWxpZA==")
+	assert.NotNil(t, err)
+}
+
+func TestScramClientInvalidServerNonce(t *testing.T) {
+
+	c := &scramClient{
+		hashGenerator:  sha256.New,
+		nonceGenerator: rfc7677Nonce,
+	}
+
+	assert.Nil(t, c.Begin("user", "pencil", ""))
+
+	_, err := c.Step("")
+	assert.Nil(t, err)
+
+	_, err = c.Step("r=another-nonce,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096")
+	assert.NotNil(t, err)
+}
+
+func TestScramClientServerError(t *testing.T) {
+
+	c := &scramClient{
+		hashGenerator:  sha256.New,
+		nonceGenerator: rfc7677Nonce,
+	}
+
+	assert.Nil(t, c.Begin("user", "pencil", ""))
+
+	_, err := c.Step("")
+	assert.Nil(t, err)
+
+	_, err = c.Step("r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096")
+	assert.Nil(t, err)
+
+	_, err = c.Step("e=invalid-proof")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/Shopify/sarama"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// Secret keys.
+	ProtocolKey      = "protocol"
+	CaCertificateKey = "ca.crt"
+	UserCertificate  = "user.crt"
+	UserKey          = "user.key"
+	SaslMechanismKey = "sasl.mechanism"
+	SaslUserKey      = "user"
+	SaslPasswordKey  = "password"
+
+	// Supported protocols.
+	ProtocolPlaintext     = "PLAINTEXT"
+	ProtocolSSL           = "SSL"
+	ProtocolSASLPlaintext = "SASL_PLAINTEXT"
+	ProtocolSASLSSL       = "SASL_SSL"
+
+	// Supported SASL mechanisms.
+	SaslPlain       = sarama.SASLTypePlaintext
+	SaslScramSha256 = sarama.SASLTypeSCRAMSHA256
+	SaslScramSha512 = sarama.SASLTypeSCRAMSHA512
+)
+
+// ConfigureSarama configures TLS and SASL of the given sarama config using the given secret.
+//
+// The secret has the following keys:
+// - protocol: one of PLAINTEXT, SSL, SASL_PLAINTEXT, SASL_SSL (default PLAINTEXT).
+// - ca.crt: PEM encoded CA certificates used to verify Kafka brokers certificates (SSL and SASL_SSL, optional).
+// - user.crt, user.key: PEM encoded client certificate and key (SSL and SASL_SSL, optional, used for mTLS).
+// - sasl.mechanism: one of PLAIN, SCRAM-SHA-256, SCRAM-SHA-512 (SASL_PLAINTEXT and SASL_SSL, default PLAIN).
+// - user, password: SASL credentials (SASL_PLAINTEXT and SASL_SSL).
+func ConfigureSarama(config *sarama.Config, secret *corev1.Secret) error {
+	if secret == nil {
+		return nil
+	}
+
+	protocol := string(secret.Data[ProtocolKey])
+	if protocol == "" {
+		protocol = ProtocolPlaintext
+	}
+
+	switch protocol {
+	case ProtocolPlaintext:
+		return nil
+	case ProtocolSSL:
+		return configureTLS(config, secret)
+	case ProtocolSASLPlaintext:
+		return configureSASL(config, secret)
+	case ProtocolSASLSSL:
+		if err := configureTLS(config, secret); err != nil {
+			return err
+		}
+		return configureSASL(config, secret)
+	default:
+		return fmt.Errorf(
+			"secret %s/%s: unsupported protocol %s - supported protocols: %s, %s, %s, %s",
+			secret.Namespace,
+			secret.Name,
+			protocol,
+			ProtocolPlaintext,
+			ProtocolSSL,
+			ProtocolSASLPlaintext,
+			ProtocolSASLSSL,
+		)
+	}
+}
+
+func configureTLS(config *sarama.Config, secret *corev1.Secret) error {
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caCert, ok := secret.Data[CaCertificateKey]; ok && len(caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return fmt.Errorf("secret %s/%s: failed to parse %s", secret.Namespace, secret.Name, CaCertificateKey)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	userCert, hasUserCert := secret.Data[UserCertificate]
+	userKey, hasUserKey := secret.Data[UserKey]
+	if hasUserCert != hasUserKey {
+		return fmt.Errorf(
+			"secret %s/%s: %s and %s must be specified together",
+			secret.Namespace,
+			secret.Name,
+			UserCertificate,
+			UserKey,
+		)
+	}
+	if hasUserCert {
+		certificate, err := tls.X509KeyPair(userCert, userKey)
+		if err != nil {
+			return fmt.Errorf("secret %s/%s: failed to parse client certificate: %w", secret.Namespace, secret.Name, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	config.Net.TLS.Enable = true
+	config.Net.TLS.Config = tlsConfig
+
+	return nil
+}
+
+func configureSASL(config *sarama.Config, secret *corev1.Secret) error {
+
+	user := string(secret.Data[SaslUserKey])
+	password := string(secret.Data[SaslPasswordKey])
+	if user == "" || password == "" {
+		return fmt.Errorf(
+			"secret %s/%s: %s and %s are required for SASL",
+			secret.Namespace,
+			secret.Name,
+			SaslUserKey,
+			SaslPasswordKey,
+		)
+	}
+
+	mechanism := string(secret.Data[SaslMechanismKey])
+	if mechanism == "" {
+		mechanism = SaslPlain
+	}
+
+	switch mechanism {
+	case SaslPlain:
+	case SaslScramSha256:
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: sha256.New}
+		}
+	case SaslScramSha512:
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: sha512.New}
+		}
+	default:
+		return fmt.Errorf(
+			"secret %s/%s: unsupported SASL mechanism %s - supported mechanisms: %s, %s, %s",
+			secret.Namespace,
+			secret.Name,
+			mechanism,
+			SaslPlain,
+			SaslScramSha256,
+			SaslScramSha512,
+		)
+	}
+
+	config.Net.SASL.Enable = true
+	config.Net.SASL.Handshake = true
+	config.Net.SASL.Version = sarama.SASLHandshakeV1
+	config.Net.SASL.Mechanism = sarama.SASLMechanism(mechanism)
+	config.Net.SASL.User = user
+	config.Net.SASL.Password = password
+
+	return nil
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConfigureSarama(t *testing.T) {
+
+	cert, key := generateCertificate(t)
+
+	tests := []struct {
+		name    string
+		data    map[string][]byte
+		wantErr bool
+		check   func(t *testing.T, config *sarama.Config)
+	}{
+		{
+			name: "no protocol",
+			data: map[string][]byte{},
+			check: func(t *testing.T, config *sarama.Config) {
+				assert.False(t, config.Net.TLS.Enable)
+				assert.False(t, config.Net.SASL.Enable)
+			},
+		},
+		{
+			name: "SSL with CA certificate",
+			data: map[string][]byte{
+				ProtocolKey:      []byte(ProtocolSSL),
+				CaCertificateKey: cert,
+			},
+			check: func(t *testing.T, config *sarama.Config) {
+				assert.True(t, config.Net.TLS.Enable)
+				assert.NotNil(t, config.Net.TLS.Config.RootCAs)
+				assert.Empty(t, config.Net.TLS.Config.Certificates)
+				assert.False(t, config.Net.SASL.Enable)
+			},
+		},
+		{
+			name: "SSL with client certificate",
+			data: map[string][]byte{
+				ProtocolKey:      []byte(ProtocolSSL),
+				CaCertificateKey: cert,
+				UserCertificate:  cert,
+				UserKey:          key,
+			},
+			check: func(t *testing.T, config *sarama.Config) {
+				assert.True(t, config.Net.TLS.Enable)
+				assert.Len(t, config.Net.TLS.Config.Certificates, 1)
+			},
+		},
+		{
+			name: "SSL with client certificate without key",
+			data: map[string][]byte{
+				ProtocolKey:     []byte(ProtocolSSL),
+				UserCertificate: cert,
+			},
+			wantErr: true,
+		},
+		{
+			name: "SSL with invalid CA certificate",
+			data: map[string][]byte{
+				ProtocolKey:      []byte(ProtocolSSL),
+				CaCertificateKey: []byte("not a certificate"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "SASL_PLAINTEXT default mechanism",
+			data: map[string][]byte{
+				ProtocolKey:     []byte(ProtocolSASLPlaintext),
+				SaslUserKey:     []byte("user"),
+				SaslPasswordKey: []byte("password"),
+			},
+			check: func(t *testing.T, config *sarama.Config) {
+				assert.False(t, config.Net.TLS.Enable)
+				assert.True(t, config.Net.SASL.Enable)
+				assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypePlaintext), config.Net.SASL.Mechanism)
+				assert.Equal(t, "user", config.Net.SASL.User)
+				assert.Equal(t, "password", config.Net.SASL.Password)
+			},
+		},
+		{
+			name: "SASL_SSL SCRAM-SHA-512",
+			data: map[string][]byte{
+				ProtocolKey:      []byte(ProtocolSASLSSL),
+				CaCertificateKey: cert,
+				SaslMechanismKey: []byte(SaslScramSha512),
+				SaslUserKey:      []byte("user"),
+				SaslPasswordKey:  []byte("password"),
+			},
+			check: func(t *testing.T, config *sarama.Config) {
+				assert.True(t, config.Net.TLS.Enable)
+				assert.True(t, config.Net.SASL.Enable)
+				assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), config.Net.SASL.Mechanism)
+				assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc)
+				assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc())
+			},
+		},
+		{
+			name: "SASL without password",
+			data: map[string][]byte{
+				ProtocolKey: []byte(ProtocolSASLPlaintext),
+				SaslUserKey: []byte("user"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported SASL mechanism",
+			data: map[string][]byte{
+				ProtocolKey:      []byte(ProtocolSASLPlaintext),
+				SaslMechanismKey: []byte("GSSAPI"),
+				SaslUserKey:      []byte("user"),
+				SaslPasswordKey:  []byte("password"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported protocol",
+			data: map[string][]byte{
+				ProtocolKey: []byte("SSH"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "name",
+				},
+				Data: tt.data,
+			}
+
+			config := sarama.NewConfig()
+			config.Version = sarama.MaxVersion
+
+			err := ConfigureSarama(config, secret)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Nil(t, config.Validate())
+			tt.check(t, config)
+		})
+	}
+}
+
+func TestConfigureSaramaNoSecret(t *testing.T) {
+	config := sarama.NewConfig()
+
+	assert.Nil(t, ConfigureSarama(config, nil))
+	assert.False(t, config.Net.TLS.Enable)
+	assert.False(t, config.Net.SASL.Enable)
+}
+
+func generateCertificate(t *testing.T) ([]byte, []byte) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kafka"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
@@ -16,6 +16,8 @@
 
 package dev.knative.eventing.kafka.broker.core;
 
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy;
+
 /**
  * Broker interface represents the Broker object.
  *
@@ -63,4 +65,46 @@ public interface Broker {
    * @return request path associated with this Broker.
    */
   String path();
+
+  /**
+   * Get the namespace of the secret holding the credentials to connect to the Kafka cluster.
+   *
+   * @return secret namespace or an empty string if the Kafka cluster doesn't require auth.
+   */
+  String authSecretNamespace();
+
+  /**
+   * Get the name of the secret holding the credentials to connect to the Kafka cluster.
+   *
+   * @return secret name or an empty string if the Kafka cluster doesn't require auth.
+   */
+  String authSecretName();
+
+  /**
+   * Get the version of the credentials in the secret, it changes when credentials are rotated.
+   *
+   * @return secret version or an empty string if the Kafka cluster doesn't require auth.
+   */
+  String authSecretVersion();
+
+  /**
+   * Get the number of retries before sending an event to the dead letter sink.
+   *
+   * @return number of retries or 0 if events aren't retried.
+   */
+  int retry();
+
+  /**
+   * Get the backoff policy applied between retries.
+   *
+   * @return backoff policy.
+   */
+  BackoffPolicy backoffPolicy();
+
+  /**
+   * Get the delay before retrying in milliseconds.
+   *
+   * @return backoff delay.
+   */
+  long backoffDelay();
 }
@@ -16,6 +16,7 @@
 
 package dev.knative.eventing.kafka.broker.core;
 
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker;
 import java.util.Objects;
 
@@ -62,6 +63,36 @@ public class BrokerWrapper implements dev.knative.eventing.kafka.broker.core.Bro
     return broker.getPath();
   }
 
+  @Override
+  public String authSecretNamespace() {
+    return broker.getAuthSecret().getNamespace();
+  }
+
+  @Override
+  public String authSecretName() {
+    return broker.getAuthSecret().getName();
+  }
+
+  @Override
+  public String authSecretVersion() {
+    return broker.getAuthSecret().getVersion();
+  }
+
+  @Override
+  public int retry() {
+    return broker.getEgressConfig().getRetry();
+  }
+
+  @Override
+  public BackoffPolicy backoffPolicy() {
+    return broker.getEgressConfig().getBackoffPolicy();
+  }
+
+  @Override
+  public long backoffDelay() {
+    return broker.getEgressConfig().getBackoffDelay();
+  }
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -76,7 +107,13 @@ public class BrokerWrapper implements dev.knative.eventing.kafka.broker.core.Bro
       && broker.getDeadLetterSink().equals(that.deadLetterSink())
       && broker.getTopic().equals(that.topic())
       && broker.getBootstrapServers().equals(that.bootstrapServers())
-      && broker.getPath().equals(that.path());
+      && broker.getPath().equals(that.path())
+      && broker.getAuthSecret().getNamespace().equals(that.authSecretNamespace())
+      && broker.getAuthSecret().getName().equals(that.authSecretName())
+      && broker.getAuthSecret().getVersion().equals(that.authSecretVersion())
+      && broker.getEgressConfig().getRetry() == that.retry()
+      && broker.getEgressConfig().getBackoffPolicy().equals(that.backoffPolicy())
+      && broker.getEgressConfig().getBackoffDelay() == that.backoffDelay();
   }
 
   @Override
@@ -86,7 +123,13 @@ public class BrokerWrapper implements dev.knative.eventing.kafka.broker.core.Bro
       broker.getDeadLetterSink(),
       broker.getTopic(),
       broker.getBootstrapServers(),
-      path()
+      path(),
+      authSecretNamespace(),
+      authSecretName(),
+      authSecretVersion(),
+      retry(),
+      backoffPolicy(),
+      backoffDelay()
     );
   }
 
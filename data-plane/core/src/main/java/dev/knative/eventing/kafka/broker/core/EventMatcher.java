@@ -26,6 +26,7 @@ import java.util.AbstractMap.SimpleImmutableEntry;
 import java.util.List;
 import java.util.Map;
 import java.util.Map.Entry;
+import java.util.function.BiPredicate;
 import java.util.function.Function;
 import java.util.stream.Collectors;
 
@@ -52,12 +53,23 @@ public class EventMatcher implements Filter<CloudEvent> {
   // f(event) -> event.getSpecVersion().toString() -> 1.0
   private final List<Entry<Function<CloudEvent, String>, String>> attributes;
 
+  // the first argument is the value of the event attribute, the second one is the value to match.
+  private final BiPredicate<String, String> matcher;
+
   /**
-   * All args constructor.
+   * Create an event matcher matching attributes by exact match.
    *
    * @param attributes attributes to match to pass filter.
    */
   public EventMatcher(final Map<String, String> attributes) {
+    this(attributes, (value, expected) -> expected.equals(value));
+  }
+
+  private EventMatcher(
+    final Map<String, String> attributes,
+    final BiPredicate<String, String> matcher) {
+
+    this.matcher = matcher;
     this.attributes = attributes.entrySet().stream()
       .filter(entry -> isNotEmpty(entry.getValue()))
       .map(entry -> new SimpleImmutableEntry<>(
@@ -76,6 +88,26 @@ public class EventMatcher implements Filter<CloudEvent> {
       .collect(Collectors.toUnmodifiableList());
   }
 
+  /**
+   * Create an event matcher matching events whose attributes values start with the given values.
+   *
+   * @param attributes attributes prefixes to match to pass filter.
+   * @return event matcher.
+   */
+  public static EventMatcher prefix(final Map<String, String> attributes) {
+    return new EventMatcher(attributes, (value, prefix) -> value != null && value.startsWith(prefix));
+  }
+
+  /**
+   * Create an event matcher matching events whose attributes values end with the given values.
+   *
+   * @param attributes attributes suffixes to match to pass filter.
+   * @return event matcher.
+   */
+  public static EventMatcher suffix(final Map<String, String> attributes) {
+    return new EventMatcher(attributes, (value, suffix) -> value != null && value.endsWith(suffix));
+  }
+
   /**
    * Attributes filters events by exact match on event context attributes. Each key in the map is
    * compared with the equivalent key in the event context. An event passes the filter if all values
@@ -89,7 +121,7 @@ public class EventMatcher implements Filter<CloudEvent> {
   public boolean match(final CloudEvent event) {
 
     for (final var entry : attributes) {
-      if (!entry.getKey().apply(event).equals(entry.getValue())) {
+      if (!matcher.test(entry.getKey().apply(event), entry.getValue())) {
         return false;
       }
     }
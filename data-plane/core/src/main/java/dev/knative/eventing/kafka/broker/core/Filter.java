@@ -16,6 +16,8 @@
 
 package dev.knative.eventing.kafka.broker.core;
 
+import java.util.List;
+
 /**
  * Filter interface abstract the filtering logic.
  *
@@ -25,4 +27,37 @@ package dev.knative.eventing.kafka.broker.core;
 public interface Filter<T> {
 
   boolean match(final T event);
+
+  /**
+   * Create a filter matching objects that match all the given filters.
+   *
+   * @param filters filters to match.
+   * @param <T>     type of objects to filter.
+   * @return filter matching objects that match all the given filters.
+   */
+  static <T> Filter<T> all(final List<Filter<T>> filters) {
+    return event -> filters.stream().allMatch(filter -> filter.match(event));
+  }
+
+  /**
+   * Create a filter matching objects that match at least one of the given filters.
+   *
+   * @param filters filters to match.
+   * @param <T>     type of objects to filter.
+   * @return filter matching objects that match at least one of the given filters.
+   */
+  static <T> Filter<T> any(final List<Filter<T>> filters) {
+    return event -> filters.stream().anyMatch(filter -> filter.match(event));
+  }
+
+  /**
+   * Create a filter matching objects that don't match the given filter.
+   *
+   * @param filter filter to negate.
+   * @param <T>    type of objects to filter.
+   * @return filter matching objects that don't match the given filter.
+   */
+  static <T> Filter<T> not(final Filter<T> filter) {
+    return event -> !filter.match(event);
+  }
 }
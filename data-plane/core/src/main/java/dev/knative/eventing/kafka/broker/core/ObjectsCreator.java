@@ -20,14 +20,13 @@ import static net.logstash.logback.argument.StructuredArguments.keyValue;
 
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers;
 import io.cloudevents.CloudEvent;
+import io.vertx.core.Future;
 import java.util.HashMap;
 import java.util.HashSet;
 import java.util.Map;
 import java.util.Objects;
 import java.util.Set;
-import java.util.concurrent.CountDownLatch;
-import java.util.concurrent.TimeUnit;
-import java.util.function.Consumer;
+import java.util.function.Function;
 import org.slf4j.Logger;
 import org.slf4j.LoggerFactory;
 
@@ -35,12 +34,10 @@ import org.slf4j.LoggerFactory;
  * ObjectsCreator receives updates and converts protobuf objects to core objects often by wrapping
  * protobuf objects by means of wrapper objects.
  */
-public class ObjectsCreator implements Consumer<Brokers> {
+public class ObjectsCreator implements Function<Brokers, Future<Void>> {
 
   private static final Logger logger = LoggerFactory.getLogger(ObjectsCreator.class);
 
-  private static final int WAIT_TIMEOUT = 1;
-
   private final ObjectsReconciler<CloudEvent> objectsReconciler;
 
   /**
@@ -58,9 +55,10 @@ public class ObjectsCreator implements Consumer<Brokers> {
    * Capture new changes.
    *
    * @param brokers new brokers config.
+   * @return the reconciliation result, failed if any object failed to be reconciled.
    */
   @Override
-  public void accept(final Brokers brokers) {
+  public Future<Void> apply(final Brokers brokers) {
 
     final Map<Broker, Set<Trigger<CloudEvent>>> objects = new HashMap<>();
 
@@ -69,6 +67,10 @@ public class ObjectsCreator implements Consumer<Brokers> {
         broker.getTriggersCount()
       );
       for (final var trigger : broker.getTriggersList()) {
+        // Paused triggers aren't consumed, so that their consumer group becomes empty.
+        if (trigger.getPaused()) {
+          continue;
+        }
         triggers.add(new TriggerWrapper(trigger));
       }
 
@@ -76,22 +78,16 @@ public class ObjectsCreator implements Consumer<Brokers> {
     }
 
     try {
-      final var latch = new CountDownLatch(1);
-      objectsReconciler.reconcile(objects).onComplete(result -> {
+      return objectsReconciler.reconcile(objects).onComplete(result -> {
         if (result.succeeded()) {
           logger.info("reconciled objects {}", keyValue("brokers", brokers));
         } else {
           logger.error("failed to reconcile {}", keyValue("brokers", brokers), result.cause());
         }
-
-        latch.countDown();
       });
-
-      // wait the reconcilation
-      latch.await(WAIT_TIMEOUT, TimeUnit.MINUTES);
-
     } catch (final Exception ex) {
-      logger.error("{}", keyValue("oobjects", objects), ex);
+      logger.error("{}", keyValue("objects", objects), ex);
+      return Future.failedFuture(ex);
     }
   }
 }
@@ -16,6 +16,9 @@
 
 package dev.knative.eventing.kafka.broker.core;
 
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig;
+
 /**
  * Trigger interface represents the Trigger object.
  *
@@ -48,4 +51,25 @@ public interface Trigger<T> {
    * @return destination URI.
    */
   String destination();
+
+  /**
+   * Get trigger dead letter sink.
+   *
+   * @return dead letter sink or an empty string if the dead letter sink of the broker is used.
+   */
+  String deadLetterSink();
+
+  /**
+   * Get the delivery guarantees applied to events sent to the trigger destination.
+   *
+   * @return egress config or null if the egress config of the broker is used.
+   */
+  EgressConfig egressConfig();
+
+  /**
+   * Get the order in which events are sent to the trigger destination.
+   *
+   * @return delivery order.
+   */
+  DeliveryOrder deliveryOrder();
 }
@@ -16,8 +16,12 @@
 
 package dev.knative.eventing.kafka.broker.core;
 
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger;
 import io.cloudevents.CloudEvent;
+import java.util.ArrayList;
+import java.util.List;
 import java.util.Map;
 import java.util.Objects;
 
@@ -46,7 +50,15 @@ public class TriggerWrapper implements dev.knative.eventing.kafka.broker.core.Tr
 
   @Override
   public Filter<CloudEvent> filter() {
-    return new EventMatcher(trigger.getAttributesMap());
+    final var attributes = new EventMatcher(trigger.getAttributesMap());
+    if (trigger.getFiltersCount() == 0) {
+      return attributes;
+    }
+
+    final var filters = new ArrayList<Filter<CloudEvent>>(trigger.getFiltersCount() + 1);
+    filters.add(attributes);
+    filters.addAll(filters(trigger.getFiltersList()));
+    return Filter.all(filters);
   }
 
   @Override
@@ -54,6 +66,21 @@ public class TriggerWrapper implements dev.knative.eventing.kafka.broker.core.Tr
     return trigger.getDestination();
   }
 
+  @Override
+  public String deadLetterSink() {
+    return trigger.getDeadLetterSink();
+  }
+
+  @Override
+  public EgressConfig egressConfig() {
+    return trigger.hasEgressConfig() ? trigger.getEgressConfig() : null;
+  }
+
+  @Override
+  public DeliveryOrder deliveryOrder() {
+    return trigger.getDeliveryOrder();
+  }
+
   @Override
   public boolean equals(Object object) {
     if (!(object instanceof TriggerWrapper)) {
@@ -62,6 +89,10 @@ public class TriggerWrapper implements dev.knative.eventing.kafka.broker.core.Tr
     final var t = (TriggerWrapper) object;
     return t.trigger.getId().equals(trigger.getId())
       && t.trigger.getDestination().equals(trigger.getDestination())
+      && t.trigger.getDeadLetterSink().equals(trigger.getDeadLetterSink())
+      && Objects.equals(egressConfig(), t.egressConfig())
+      && t.trigger.getDeliveryOrder() == trigger.getDeliveryOrder()
+      && t.trigger.getFiltersList().equals(trigger.getFiltersList())
       && mapEquals(t.trigger.getAttributesMap(), trigger.getAttributesMap());
   }
 
@@ -74,10 +105,45 @@ public class TriggerWrapper implements dev.knative.eventing.kafka.broker.core.Tr
     return Objects.hash(
       trigger.getId(),
       trigger.getDestination(),
+      trigger.getDeadLetterSink(),
+      egressConfig(),
+      trigger.getDeliveryOrder(),
+      trigger.getFiltersList(),
       hashAttributes
     );
   }
 
+  private static List<Filter<CloudEvent>> filters(
+    final List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> filters) {
+
+    final var result = new ArrayList<Filter<CloudEvent>>(filters.size());
+    for (final var filter : filters) {
+      result.add(filter(filter));
+    }
+    return result;
+  }
+
+  private static Filter<CloudEvent> filter(
+    final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter filter) {
+
+    switch (filter.getDialect()) {
+      case Exact:
+        return new EventMatcher(filter.getAttributesMap());
+      case Prefix:
+        return EventMatcher.prefix(filter.getAttributesMap());
+      case Suffix:
+        return EventMatcher.suffix(filter.getAttributesMap());
+      case All:
+        return Filter.all(filters(filter.getFiltersList()));
+      case Any:
+        return Filter.any(filters(filter.getFiltersList()));
+      case Not:
+        return Filter.not(filters(filter.getFiltersList()).get(0));
+      default:
+        throw new IllegalArgumentException("unknown filter dialect " + filter.getDialectValue());
+    }
+  }
+
   // TODO re-evaluate hashcode and equals
   private static boolean mapEquals(final Map<String, String> m1, final Map<String, String> m2) {
     final var count = m1.entrySet().stream()
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.file;
+
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers;
+import java.io.ByteArrayInputStream;
+import java.io.ByteArrayOutputStream;
+import java.io.IOException;
+import java.util.zip.GZIPInputStream;
+import java.util.zip.GZIPOutputStream;
+
+/**
+ * CompressedBrokers encodes and decodes compressed brokers written by the control plane when the
+ * data plane config format is protobuf-gzip.
+ *
+ * <p>Compressed brokers start with a header (0x00 'k' 'b') followed by a byte identifying the
+ * codec and by the compressed protobuf encoded brokers. The first byte can't be the first byte
+ * of JSON or protobuf encoded brokers.
+ */
+public final class CompressedBrokers {
+
+  public static final byte CODEC_GZIP = 1;
+
+  private static final byte[] HEADER = new byte[]{0x00, 'k', 'b'};
+
+  private CompressedBrokers() {
+  }
+
+  /**
+   * Check whether the given data are compressed brokers.
+   *
+   * @param data data.
+   * @return true if data are compressed brokers.
+   */
+  public static boolean isCompressed(final byte[] data) {
+    if (data.length <= HEADER.length) {
+      return false;
+    }
+    for (int i = 0; i < HEADER.length; i++) {
+      if (data[i] != HEADER[i]) {
+        return false;
+      }
+    }
+    return true;
+  }
+
+  /**
+   * Decode the given compressed brokers.
+   *
+   * @param data compressed brokers.
+   * @return brokers.
+   * @throws IOException              data can't be decompressed or parsed.
+   * @throws IllegalArgumentException data aren't compressed brokers or the codec is unknown.
+   */
+  public static Brokers decode(final byte[] data) throws IOException {
+    if (!isCompressed(data)) {
+      throw new IllegalArgumentException("data aren't compressed brokers");
+    }
+
+    final var codec = data[HEADER.length];
+    final var offset = HEADER.length + 1;
+
+    if (codec == CODEC_GZIP) {
+      try (final var in = new GZIPInputStream(
+        new ByteArrayInputStream(data, offset, data.length - offset))) {
+        return Brokers.parseFrom(in);
+      }
+    }
+
+    throw new IllegalArgumentException("unknown codec " + codec);
+  }
+
+  /**
+   * Encode the given brokers with the given codec.
+   *
+   * @param brokers brokers.
+   * @param codec   codec.
+   * @return compressed brokers.
+   * @throws IOException              brokers can't be compressed.
+   * @throws IllegalArgumentException the codec is unknown.
+   */
+  public static byte[] encode(final Brokers brokers, final byte codec) throws IOException {
+    if (codec != CODEC_GZIP) {
+      throw new IllegalArgumentException("unknown codec " + codec);
+    }
+
+    final var out = new ByteArrayOutputStream();
+    out.write(HEADER);
+    out.write(codec);
+    try (final var gzip = new GZIPOutputStream(out)) {
+      brokers.writeTo(gzip);
+    }
+    return out.toByteArray();
+  }
+}
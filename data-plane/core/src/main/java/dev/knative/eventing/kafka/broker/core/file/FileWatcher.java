@@ -24,61 +24,129 @@ import static java.nio.file.StandardWatchEventKinds.OVERFLOW;
 import com.google.protobuf.InvalidProtocolBufferException;
 import com.google.protobuf.util.JsonFormat;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers;
+import io.vertx.core.Future;
 import java.io.BufferedInputStream;
-import java.io.BufferedReader;
 import java.io.File;
-import java.io.FileReader;
 import java.io.IOException;
 import java.io.Reader;
-import java.nio.file.Path;
+import java.io.StringReader;
+import java.nio.charset.StandardCharsets;
+import java.nio.file.Files;
 import java.nio.file.WatchService;
+import java.util.ArrayList;
+import java.util.HashSet;
+import java.util.List;
 import java.util.Objects;
-import java.util.function.Consumer;
+import java.util.concurrent.CountDownLatch;
+import java.util.concurrent.TimeUnit;
+import java.util.concurrent.atomic.AtomicBoolean;
+import java.util.function.Function;
+import java.util.stream.Collectors;
 import org.slf4j.Logger;
 import org.slf4j.LoggerFactory;
 
 /**
  * FileWatcher is the class responsible for watching a given file and reports update.
+ *
+ * <p>When the data plane config is sharded, it watches every shard and reports the brokers of all
+ * shards.
+ *
+ * <p>Files are JSON encoded, or compressed protobuf encoded (see {@link CompressedBrokers}).
+ *
+ * <p>Once brokers are reconciled successfully, the volume generation of every shard is acknowledged
+ * (see {@link VolumeGenerationAcknowledger}). Volume generations of brokers that failed to be
+ * reconciled aren't acknowledged, so that the control plane doesn't consider them ready.
  */
 public class FileWatcher {
 
   private static final Logger logger = LoggerFactory.getLogger(FileWatcher.class);
 
-  private final Consumer<Brokers> brokersConsumer;
+  private static final int WAIT_TIMEOUT = 1;
+
+  private final Function<Brokers, Future<Void>> brokersConsumer;
+  private final VolumeGenerationAcknowledger acknowledger;
 
   private final WatchService watcher;
-  private final File toWatch;
+  private final List<File> toWatch;
 
   /**
    * All args constructor.
    *
    * @param watcher         watch service
-   * @param brokersConsumer updates receiver.
+   * @param brokersConsumer updates receiver, it returns the reconciliation result.
    * @param file            file to watch
    * @throws IOException watch service cannot be registered.
    */
   public FileWatcher(
     final WatchService watcher,
-    final Consumer<Brokers> brokersConsumer,
+    final Function<Brokers, Future<Void>> brokersConsumer,
     final File file)
     throws IOException {
 
+    this(watcher, brokersConsumer, List.of(Objects.requireNonNull(file, "provide file")));
+  }
+
+  /**
+   * Create a watcher of the given shards.
+   *
+   * <p>The first file is required, other shards might be missing, since their config maps might
+   * not exist yet.
+   *
+   * @param watcher         watch service
+   * @param brokersConsumer updates receiver, it returns the reconciliation result.
+   * @param files           shards to watch
+   * @throws IOException watch service cannot be registered.
+   */
+  public FileWatcher(
+    final WatchService watcher,
+    final Function<Brokers, Future<Void>> brokersConsumer,
+    final List<File> files)
+    throws IOException {
+
+    this(watcher, brokersConsumer, files, VolumeGenerationAcknowledger.noop());
+  }
+
+  /**
+   * Create a watcher of the given shards, that acknowledges the volume generation of every shard
+   * once brokers have been reconciled.
+   *
+   * @param watcher         watch service
+   * @param brokersConsumer updates receiver, it returns the reconciliation result.
+   * @param files           shards to watch
+   * @param acknowledger    volume generations acknowledger.
+   * @throws IOException watch service cannot be registered.
+   */
+  public FileWatcher(
+    final WatchService watcher,
+    final Function<Brokers, Future<Void>> brokersConsumer,
+    final List<File> files,
+    final VolumeGenerationAcknowledger acknowledger)
+    throws IOException {
+
     Objects.requireNonNull(brokersConsumer, "provide consumer");
-    Objects.requireNonNull(file, "provide file");
+    Objects.requireNonNull(acknowledger, "provide acknowledger");
+    Objects.requireNonNull(files, "provide files");
+    if (files.isEmpty()) {
+      throw new IllegalArgumentException("provide at least one file");
+    }
 
     // register the given watch service.
     // Note: this watch a directory and not the single file we're interested in, so that's the
     // reason in #watch() we filter watch service events based on the updated file.
 
     this.brokersConsumer = brokersConsumer;
-    toWatch = file.getAbsoluteFile();
+    this.acknowledger = acknowledger;
+    this.toWatch = files.stream().map(File::getAbsoluteFile).collect(Collectors.toList());
     logger.info("start watching {}", toWatch);
 
-    Path toWatchParentPath = file.getParentFile().toPath();
-
     this.watcher = watcher;
 
-    toWatchParentPath.register(watcher, ENTRY_CREATE, ENTRY_DELETE, ENTRY_MODIFY);
+    final var parents = new HashSet<File>();
+    for (final var file : toWatch) {
+      if (parents.add(file.getParentFile())) {
+        file.getParentFile().toPath().register(watcher, ENTRY_CREATE, ENTRY_DELETE, ENTRY_MODIFY);
+      }
+    }
   }
 
   /**
@@ -128,24 +196,98 @@ public class FileWatcher {
     }
   }
 
-  private void update() throws IOException {
-    try (
-      final var fileReader = new FileReader(toWatch);
-      final var bufferedReader = new BufferedReader(fileReader)) {
-      parseFromJson(bufferedReader);
+  private void update() throws IOException, InterruptedException {
+    if (toWatch.size() == 1) {
+      final var brokers = parse(Files.readAllBytes(toWatch.get(0).toPath()));
+      if (brokers != null) {
+        reconcile(brokers, List.of(brokers.getVolumeGeneration()));
+      }
+      return;
+    }
+
+    // Volume generations are per shard, so only brokers are merged.
+    final var brokers = Brokers.newBuilder();
+    final var volumeGenerations = new ArrayList<Long>(toWatch.size());
+
+    for (int i = 0; i < toWatch.size(); i++) {
+      volumeGenerations.add(0L);
+
+      final var file = toWatch.get(i);
+      if (i > 0 && !file.exists()) {
+        continue;
+      }
+
+      final var content = Files.readAllBytes(file.toPath());
+      if (new String(content, StandardCharsets.UTF_8).isBlank()) {
+        // Shards are created empty.
+        continue;
+      }
+
+      final var shard = parse(content);
+      if (shard == null) {
+        // Don't report a partial update, since brokers of the unparsable shard would be deleted.
+        return;
+      }
+      brokers.addAllBrokers(shard.getBrokersList());
+      volumeGenerations.set(i, shard.getVolumeGeneration());
+    }
+
+    reconcile(brokers.build(), volumeGenerations);
+  }
+
+  private void reconcile(final Brokers brokers, final List<Long> volumeGenerations)
+    throws InterruptedException {
+
+    // Wait the reconciliation, so that updates are reconciled (and acknowledged) in order.
+    final var latch = new CountDownLatch(1);
+    final var succeeded = new AtomicBoolean(false);
+    brokersConsumer.apply(brokers).onComplete(result -> {
+      succeeded.set(result.succeeded());
+      latch.countDown();
+    });
+
+    if (!latch.await(WAIT_TIMEOUT, TimeUnit.MINUTES)) {
+      logger.warn("timeout waiting brokers reconciliation, skip acknowledgment {}",
+        volumeGenerations);
+      return;
+    }
+    if (!succeeded.get()) {
+      logger.warn("failed to reconcile brokers, skip acknowledgment {}", volumeGenerations);
+      return;
+    }
+
+    acknowledger.acknowledge(volumeGenerations);
+  }
+
+  private Brokers parse(final byte[] content) throws IOException {
+    if (CompressedBrokers.isCompressed(content)) {
+      return parseCompressed(content);
+    }
+    return parseFromJson(new StringReader(new String(content, StandardCharsets.UTF_8)));
+  }
+
+  private Brokers parseCompressed(final byte[] content) {
+    try {
+
+      return CompressedBrokers.decode(content);
+
+    } catch (final IOException | IllegalArgumentException ex) {
+      logger.warn("failed to parse compressed brokers", ex);
+      return null;
     }
   }
 
-  private void parseFromJson(final Reader content) throws IOException {
+  private Brokers parseFromJson(final Reader content) throws IOException {
     try {
 
       final var brokers = Brokers.newBuilder();
       JsonFormat.parser().merge(content, brokers);
 
-      brokersConsumer.accept(brokers.build());
+      return brokers.build();
 
     } catch (final InvalidProtocolBufferException ex) {
       logger.warn("failed to parse from JSON", ex);
+      return null;
     }
   }
 }
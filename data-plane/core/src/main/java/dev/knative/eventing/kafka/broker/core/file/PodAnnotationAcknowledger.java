@@ -0,0 +1,126 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package dev.knative.eventing.kafka.broker.core.file;
+
+import static net.logstash.logback.argument.StructuredArguments.keyValue;
+
+import dev.knative.eventing.kafka.broker.core.utils.KubernetesClient;
+import io.vertx.core.Promise;
+import io.vertx.core.Vertx;
+import io.vertx.core.buffer.Buffer;
+import io.vertx.core.http.HttpMethod;
+import io.vertx.core.json.JsonObject;
+import io.vertx.ext.web.client.HttpResponse;
+import java.util.List;
+import java.util.Objects;
+import java.util.concurrent.atomic.AtomicReference;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+
+/**
+ * PodAnnotationAcknowledger acknowledges volume generations by annotating the pod with the
+ * volumeGenerationAck annotation, volumeGenerationAck-&lt;shard&gt; for shards other than the first
+ * one, which is the naming convention used by the control plane for shards.
+ *
+ * <p>Failed acknowledgments are retried, unless newer volume generations are acknowledged.
+ */
+class PodAnnotationAcknowledger implements VolumeGenerationAcknowledger {
+
+  private static final Logger logger = LoggerFactory.getLogger(PodAnnotationAcknowledger.class);
+
+  static final String VOLUME_GENERATION_ACK_ANNOTATION = "volumeGenerationAck";
+
+  private static final String MERGE_PATCH_CONTENT_TYPE = "application/merge-patch+json";
+  private static final long RETRY_DELAY_MS = 1000;
+
+  private final Vertx vertx;
+  private final KubernetesClient client;
+  private final String uri;
+
+  private final AtomicReference<List<Long>> pending;
+
+  PodAnnotationAcknowledger(
+    final Vertx vertx,
+    final KubernetesClient client,
+    final String namespace,
+    final String name) {
+
+    Objects.requireNonNull(vertx, "provide vertx");
+    Objects.requireNonNull(client, "provide client");
+    Objects.requireNonNull(namespace, "provide namespace");
+    Objects.requireNonNull(name, "provide name");
+
+    this.vertx = vertx;
+    this.client = client;
+    this.uri = "/api/v1/namespaces/" + namespace + "/pods/" + name;
+    this.pending = new AtomicReference<>();
+  }
+
+  @Override
+  public void acknowledge(final List<Long> volumeGenerations) {
+    final var generations = List.copyOf(volumeGenerations);
+    pending.set(generations);
+    send(generations);
+  }
+
+  private void send(final List<Long> volumeGenerations) {
+    client.request(HttpMethod.PATCH, uri)
+      .compose(request -> {
+        final Promise<HttpResponse<Buffer>> promise = Promise.promise();
+        request
+          .putHeader("Content-Type", MERGE_PATCH_CONTENT_TYPE)
+          .sendJsonObject(patch(volumeGenerations), promise);
+        return promise.future();
+      })
+      .onSuccess(response -> {
+        if (response.statusCode() / 100 == 2) {
+          logger.debug("acknowledged {}", keyValue("volumeGenerations", volumeGenerations));
+          return;
+        }
+        retry(volumeGenerations, "status code " + response.statusCode());
+      })
+      .onFailure(cause -> retry(volumeGenerations, cause.getMessage()));
+  }
+
+  private void retry(final List<Long> volumeGenerations, final String cause) {
+    logger.warn("failed to acknowledge {} {}",
+      keyValue("volumeGenerations", volumeGenerations),
+      keyValue("cause", cause)
+    );
+
+    vertx.setTimer(RETRY_DELAY_MS, timer -> {
+      // Don't retry when newer volume generations have been acknowledged in the meantime.
+      if (pending.get().equals(volumeGenerations)) {
+        send(volumeGenerations);
+      }
+    });
+  }
+
+  static JsonObject patch(final List<Long> volumeGenerations) {
+    final var annotations = new JsonObject();
+    for (int shard = 0; shard < volumeGenerations.size(); shard++) {
+      final var key = shard == 0
+        ? VOLUME_GENERATION_ACK_ANNOTATION
+        : VOLUME_GENERATION_ACK_ANNOTATION + "-" + shard;
+      // Volume generations are unsigned.
+      annotations.put(key, Long.toUnsignedString(volumeGenerations.get(shard)));
+    }
+
+    return new JsonObject().put("metadata", new JsonObject().put("annotations", annotations));
+  }
+}
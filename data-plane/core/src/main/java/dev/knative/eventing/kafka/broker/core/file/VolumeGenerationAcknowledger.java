@@ -0,0 +1,64 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package dev.knative.eventing.kafka.broker.core.file;
+
+import dev.knative.eventing.kafka.broker.core.utils.KubernetesClient;
+import io.vertx.core.Vertx;
+import java.util.List;
+
+/**
+ * VolumeGenerationAcknowledger reports the volume generations of the data plane config that have
+ * been applied, so that the control plane knows when resources are ready.
+ */
+@FunctionalInterface
+public interface VolumeGenerationAcknowledger {
+
+  /**
+   * Acknowledge applied volume generations.
+   *
+   * @param volumeGenerations volume generation of each data plane config shard.
+   */
+  void acknowledge(List<Long> volumeGenerations);
+
+  /**
+   * Create a VolumeGenerationAcknowledger that annotates the given pod with the applied volume
+   * generations.
+   *
+   * @param vertx     vertx instance.
+   * @param namespace pod namespace.
+   * @param name      pod name.
+   * @return volume generation acknowledger.
+   */
+  static VolumeGenerationAcknowledger kubernetes(
+    final Vertx vertx,
+    final String namespace,
+    final String name) {
+
+    return new PodAnnotationAcknowledger(vertx, new KubernetesClient(vertx), namespace, name);
+  }
+
+  /**
+   * Create a VolumeGenerationAcknowledger that doesn't report volume generations.
+   *
+   * @return volume generation acknowledger.
+   */
+  static VolumeGenerationAcknowledger noop() {
+    return volumeGenerations -> {
+    };
+  }
+}
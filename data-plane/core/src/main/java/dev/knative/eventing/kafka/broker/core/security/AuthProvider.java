@@ -0,0 +1,58 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.security;
+
+import io.vertx.core.Future;
+import io.vertx.core.Vertx;
+
+/**
+ * AuthProvider provides credentials referenced by Brokers.
+ */
+@FunctionalInterface
+public interface AuthProvider {
+
+  /**
+   * Get credentials stored in the given secret.
+   *
+   * @param namespace secret namespace.
+   * @param name      secret name.
+   * @return credentials.
+   */
+  Future<Credentials> getCredentials(String namespace, String name);
+
+  /**
+   * Create an AuthProvider that reads secrets using the Kubernetes API, with the pod service
+   * account.
+   *
+   * @param vertx vertx instance.
+   * @return auth provider.
+   */
+  static AuthProvider kubernetes(final Vertx vertx) {
+    return new KubernetesAuthProvider(vertx);
+  }
+
+  /**
+   * Create an AuthProvider that fails for every secret.
+   *
+   * @return auth provider.
+   */
+  static AuthProvider noAuth() {
+    return (namespace, name) -> Future.failedFuture(
+      "no auth provider configured, secret " + namespace + "/" + name + " cannot be loaded"
+    );
+  }
+}
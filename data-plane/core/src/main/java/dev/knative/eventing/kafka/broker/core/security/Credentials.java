@@ -0,0 +1,72 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.security;
+
+/**
+ * Credentials represents the TLS and SASL settings to connect to a Kafka cluster.
+ */
+public interface Credentials {
+
+  /**
+   * Get the security protocol.
+   *
+   * @return one of PLAINTEXT, SSL, SASL_PLAINTEXT, SASL_SSL.
+   */
+  String securityProtocol();
+
+  /**
+   * Get the PEM encoded CA certificates used to verify Kafka brokers certificates.
+   *
+   * @return CA certificates or null.
+   */
+  String caCertificates();
+
+  /**
+   * Get the PEM encoded client certificate.
+   *
+   * @return client certificate or null.
+   */
+  String userCertificate();
+
+  /**
+   * Get the PEM encoded (PKCS #8) client key.
+   *
+   * @return client key or null.
+   */
+  String userKey();
+
+  /**
+   * Get the SASL mechanism.
+   *
+   * @return one of PLAIN, SCRAM-SHA-256, SCRAM-SHA-512.
+   */
+  String saslMechanism();
+
+  /**
+   * Get the SASL username.
+   *
+   * @return SASL username or null.
+   */
+  String saslUsername();
+
+  /**
+   * Get the SASL password.
+   *
+   * @return SASL password or null.
+   */
+  String saslPassword();
+}
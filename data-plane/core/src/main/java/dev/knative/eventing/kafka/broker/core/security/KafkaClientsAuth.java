@@ -0,0 +1,207 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.security;
+
+import java.io.ByteArrayInputStream;
+import java.io.IOException;
+import java.nio.charset.StandardCharsets;
+import java.nio.file.Files;
+import java.security.GeneralSecurityException;
+import java.security.KeyFactory;
+import java.security.KeyStore;
+import java.security.PrivateKey;
+import java.security.SecureRandom;
+import java.security.cert.Certificate;
+import java.security.cert.CertificateFactory;
+import java.security.spec.PKCS8EncodedKeySpec;
+import java.util.Base64;
+import java.util.Properties;
+import org.apache.kafka.clients.CommonClientConfigs;
+import org.apache.kafka.common.config.SaslConfigs;
+import org.apache.kafka.common.config.SslConfigs;
+
+/**
+ * KafkaClientsAuth configures Kafka clients (producers and consumers) with the given credentials.
+ *
+ * <p>Kafka clients read trust stores and key stores from files, so certificates are stored in
+ * PKCS #12 files created in the temporary directory.
+ */
+public final class KafkaClientsAuth {
+
+  private static final String PROTOCOL_PLAINTEXT = "PLAINTEXT";
+  private static final String PROTOCOL_SSL = "SSL";
+  private static final String PROTOCOL_SASL_PLAINTEXT = "SASL_PLAINTEXT";
+  private static final String PROTOCOL_SASL_SSL = "SASL_SSL";
+
+  private static final String SASL_PLAIN = "PLAIN";
+  private static final String SASL_SCRAM_SHA_256 = "SCRAM-SHA-256";
+  private static final String SASL_SCRAM_SHA_512 = "SCRAM-SHA-512";
+
+  private static final String KEY_STORE_TYPE = "PKCS12";
+
+  private KafkaClientsAuth() {
+  }
+
+  /**
+   * Attach the given credentials to the given Kafka client configurations.
+   *
+   * @param configs     Kafka client configurations.
+   * @param credentials credentials.
+   * @throws IllegalArgumentException when credentials are not valid.
+   */
+  public static void attachCredentials(final Properties configs, final Credentials credentials) {
+    final var protocol = credentials.securityProtocol();
+
+    switch (protocol) {
+      case PROTOCOL_PLAINTEXT:
+        break;
+      case PROTOCOL_SSL:
+        attachSSL(configs, credentials);
+        break;
+      case PROTOCOL_SASL_PLAINTEXT:
+        attachSASL(configs, credentials);
+        break;
+      case PROTOCOL_SASL_SSL:
+        attachSSL(configs, credentials);
+        attachSASL(configs, credentials);
+        break;
+      default:
+        throw new IllegalArgumentException("unsupported security protocol " + protocol);
+    }
+
+    configs.setProperty(CommonClientConfigs.SECURITY_PROTOCOL_CONFIG, protocol);
+  }
+
+  private static void attachSSL(final Properties configs, final Credentials credentials) {
+    try {
+      final var caCertificates = credentials.caCertificates();
+      if (caCertificates != null && !caCertificates.isBlank()) {
+        final var password = password();
+        final var trustStore = KeyStore.getInstance(KEY_STORE_TYPE);
+        trustStore.load(null, null);
+
+        var i = 0;
+        for (final var certificate : certificates(caCertificates)) {
+          trustStore.setCertificateEntry("ca-" + i++, certificate);
+        }
+
+        configs.setProperty(SslConfigs.SSL_TRUSTSTORE_TYPE_CONFIG, KEY_STORE_TYPE);
+        configs.setProperty(SslConfigs.SSL_TRUSTSTORE_LOCATION_CONFIG, store(trustStore, password));
+        configs.setProperty(SslConfigs.SSL_TRUSTSTORE_PASSWORD_CONFIG, password);
+      }
+
+      final var userCertificate = credentials.userCertificate();
+      final var userKey = credentials.userKey();
+      if ((userCertificate == null) != (userKey == null)) {
+        throw new IllegalArgumentException("client certificate and key must be specified together");
+      }
+      if (userCertificate != null) {
+        final var password = password();
+        final var keyStore = KeyStore.getInstance(KEY_STORE_TYPE);
+        keyStore.load(null, null);
+        keyStore.setKeyEntry(
+          "user",
+          privateKey(userKey),
+          password.toCharArray(),
+          certificates(userCertificate)
+        );
+
+        configs.setProperty(SslConfigs.SSL_KEYSTORE_TYPE_CONFIG, KEY_STORE_TYPE);
+        configs.setProperty(SslConfigs.SSL_KEYSTORE_LOCATION_CONFIG, store(keyStore, password));
+        configs.setProperty(SslConfigs.SSL_KEYSTORE_PASSWORD_CONFIG, password);
+        configs.setProperty(SslConfigs.SSL_KEY_PASSWORD_CONFIG, password);
+      }
+    } catch (final GeneralSecurityException | IOException ex) {
+      throw new IllegalArgumentException("failed to configure TLS", ex);
+    }
+  }
+
+  private static void attachSASL(final Properties configs, final Credentials credentials) {
+    final var username = credentials.saslUsername();
+    final var password = credentials.saslPassword();
+    if (username == null || username.isBlank() || password == null || password.isBlank()) {
+      throw new IllegalArgumentException("SASL username and password are required");
+    }
+
+    final String loginModule;
+    final var mechanism = credentials.saslMechanism();
+    switch (mechanism) {
+      case SASL_PLAIN:
+        loginModule = "org.apache.kafka.common.security.plain.PlainLoginModule";
+        break;
+      case SASL_SCRAM_SHA_256:
+      case SASL_SCRAM_SHA_512:
+        loginModule = "org.apache.kafka.common.security.scram.ScramLoginModule";
+        break;
+      default:
+        throw new IllegalArgumentException("unsupported SASL mechanism " + mechanism);
+    }
+
+    configs.setProperty(SaslConfigs.SASL_MECHANISM, mechanism);
+    configs.setProperty(SaslConfigs.SASL_JAAS_CONFIG, String.format(
+      "%s required username=\"%s\" password=\"%s\";",
+      loginModule,
+      escape(username),
+      escape(password)
+    ));
+  }
+
+  private static Certificate[] certificates(final String pem) throws GeneralSecurityException {
+    final var factory = CertificateFactory.getInstance("X.509");
+    return factory
+      .generateCertificates(new ByteArrayInputStream(pem.getBytes(StandardCharsets.UTF_8)))
+      .toArray(new Certificate[0]);
+  }
+
+  private static PrivateKey privateKey(final String pem) throws GeneralSecurityException {
+    final var encoded = pem
+      .replaceAll("-----(BEGIN|END) PRIVATE KEY-----", "")
+      .replaceAll("\\s", "");
+    final var keySpec = new PKCS8EncodedKeySpec(Base64.getDecoder().decode(encoded));
+
+    GeneralSecurityException last = null;
+    for (final var algorithm : new String[]{"RSA", "EC"}) {
+      try {
+        return KeyFactory.getInstance(algorithm).generatePrivate(keySpec);
+      } catch (final GeneralSecurityException ex) {
+        last = ex;
+      }
+    }
+    throw last;
+  }
+
+  private static String store(final KeyStore keyStore, final String password)
+    throws GeneralSecurityException, IOException {
+
+    final var file = Files.createTempFile("kafka-", ".p12");
+    file.toFile().deleteOnExit();
+    try (final var out = Files.newOutputStream(file)) {
+      keyStore.store(out, password.toCharArray());
+    }
+    return file.toAbsolutePath().toString();
+  }
+
+  private static String password() {
+    final var bytes = new byte[32];
+    new SecureRandom().nextBytes(bytes);
+    return Base64.getEncoder().encodeToString(bytes);
+  }
+
+  private static String escape(final String s) {
+    return s.replace("\\", "\\\\").replace("\"", "\\\"");
+  }
+}
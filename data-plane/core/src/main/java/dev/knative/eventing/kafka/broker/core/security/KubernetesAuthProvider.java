@@ -0,0 +1,101 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.security;
+
+import static net.logstash.logback.argument.StructuredArguments.keyValue;
+
+import dev.knative.eventing.kafka.broker.core.utils.KubernetesClient;
+import io.vertx.core.Future;
+import io.vertx.core.Promise;
+import io.vertx.core.Vertx;
+import io.vertx.core.buffer.Buffer;
+import io.vertx.core.http.HttpMethod;
+import io.vertx.core.json.JsonObject;
+import io.vertx.ext.web.client.HttpResponse;
+import java.nio.charset.StandardCharsets;
+import java.util.Base64;
+import java.util.HashMap;
+import java.util.Objects;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+
+/**
+ * KubernetesAuthProvider reads secrets from the Kubernetes API server using the in-cluster
+ * configuration (see {@link KubernetesClient}).
+ */
+public class KubernetesAuthProvider implements AuthProvider {
+
+  private static final Logger logger = LoggerFactory.getLogger(KubernetesAuthProvider.class);
+
+  private final KubernetesClient client;
+
+  /**
+   * All args constructor.
+   *
+   * @param vertx vertx instance.
+   */
+  public KubernetesAuthProvider(final Vertx vertx) {
+    Objects.requireNonNull(vertx, "provide vertx");
+
+    this.client = new KubernetesClient(vertx);
+  }
+
+  @Override
+  public Future<Credentials> getCredentials(final String namespace, final String name) {
+    return getSecret(namespace, name)
+      .map(KubernetesAuthProvider::toCredentials)
+      .onFailure(cause -> logger.error("failed to get secret {} {}",
+        keyValue("namespace", namespace),
+        keyValue("name", name),
+        cause
+      ));
+  }
+
+  private Future<JsonObject> getSecret(final String namespace, final String name) {
+
+    return client
+      .request(HttpMethod.GET, "/api/v1/namespaces/" + namespace + "/secrets/" + name)
+      .compose(request -> {
+        final Promise<HttpResponse<Buffer>> promise = Promise.promise();
+        request.send(promise);
+        return promise.future();
+      })
+      .compose(response -> {
+        if (response.statusCode() != 200) {
+          return Future.failedFuture(String.format(
+            "failed to get secret %s/%s: status code %d",
+            namespace,
+            name,
+            response.statusCode()
+          ));
+        }
+        return Future.succeededFuture(response.bodyAsJsonObject());
+      });
+  }
+
+  private static Credentials toCredentials(final JsonObject secret) {
+    final var data = new HashMap<String, String>();
+
+    final var secretData = secret.getJsonObject("data", new JsonObject());
+    for (final var key : secretData.fieldNames()) {
+      final var decoded = Base64.getDecoder().decode(secretData.getString(key));
+      data.put(key, new String(decoded, StandardCharsets.UTF_8));
+    }
+
+    return new KubernetesCredentials(data);
+  }
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.security;
+
+import java.util.Map;
+import java.util.Objects;
+
+/**
+ * KubernetesCredentials reads credentials from the (decoded) data of a Kubernetes secret.
+ *
+ * <p>Secret keys are the same keys read by the control plane.
+ */
+public class KubernetesCredentials implements Credentials {
+
+  public static final String PROTOCOL_KEY = "protocol";
+  public static final String CA_CERTIFICATE_KEY = "ca.crt";
+  public static final String USER_CERTIFICATE_KEY = "user.crt";
+  public static final String USER_KEY_KEY = "user.key";
+  public static final String SASL_MECHANISM_KEY = "sasl.mechanism";
+  public static final String SASL_USERNAME_KEY = "user";
+  public static final String SASL_PASSWORD_KEY = "password";
+
+  private static final String DEFAULT_PROTOCOL = "PLAINTEXT";
+  private static final String DEFAULT_SASL_MECHANISM = "PLAIN";
+
+  private final Map<String, String> data;
+
+  /**
+   * All args constructor.
+   *
+   * @param data decoded secret data.
+   */
+  public KubernetesCredentials(final Map<String, String> data) {
+    Objects.requireNonNull(data, "provide data");
+
+    this.data = data;
+  }
+
+  @Override
+  public String securityProtocol() {
+    return getOrDefault(PROTOCOL_KEY, DEFAULT_PROTOCOL);
+  }
+
+  @Override
+  public String caCertificates() {
+    return data.get(CA_CERTIFICATE_KEY);
+  }
+
+  @Override
+  public String userCertificate() {
+    return data.get(USER_CERTIFICATE_KEY);
+  }
+
+  @Override
+  public String userKey() {
+    return data.get(USER_KEY_KEY);
+  }
+
+  @Override
+  public String saslMechanism() {
+    return getOrDefault(SASL_MECHANISM_KEY, DEFAULT_SASL_MECHANISM);
+  }
+
+  @Override
+  public String saslUsername() {
+    return data.get(SASL_USERNAME_KEY);
+  }
+
+  @Override
+  public String saslPassword() {
+    return data.get(SASL_PASSWORD_KEY);
+  }
+
+  private String getOrDefault(final String key, final String defaultValue) {
+    final var value = data.get(key);
+    if (value == null || value.isBlank()) {
+      return defaultValue;
+    }
+    return value;
+  }
+
+  @Override
+  public String toString() {
+    // Never log credentials.
+    return "KubernetesCredentials{"
+      + "securityProtocol=" + securityProtocol()
+      + ", saslMechanism=" + saslMechanism()
+      + '}';
+  }
+}
@@ -2,19 +2,33 @@ package dev.knative.eventing.kafka.broker.core.utils;
 
 import static java.util.Objects.requireNonNull;
 
+import java.util.ArrayList;
+import java.util.List;
 import java.util.function.Function;
 
 public abstract class BaseEnv {
 
   public static final String PRODUCER_CONFIG_FILE_PATH = "PRODUCER_CONFIG_FILE_PATH";
   public static final String DATA_PLANE_CONFIG_FILE_PATH = "DATA_PLANE_CONFIG_FILE_PATH";
+  public static final String DATA_PLANE_CONFIG_SHARDS = "DATA_PLANE_CONFIG_SHARDS";
+  public static final String POD_NAME = "POD_NAME";
+  public static final String POD_NAMESPACE = "POD_NAMESPACE";
 
   private final String producerConfigFilePath;
   private final String dataPlaneConfigFilePath;
+  private final int dataPlaneConfigShards;
+  private final String podName;
+  private final String podNamespace;
 
   public BaseEnv(Function<String, String> envProvider) {
     this.producerConfigFilePath = requireNonNull(envProvider.apply(PRODUCER_CONFIG_FILE_PATH));
     this.dataPlaneConfigFilePath = requireNonNull(envProvider.apply(DATA_PLANE_CONFIG_FILE_PATH));
+
+    final var shards = envProvider.apply(DATA_PLANE_CONFIG_SHARDS);
+    this.dataPlaneConfigShards = shards == null || shards.isBlank() ? 1 : Integer.parseInt(shards);
+
+    this.podName = envProvider.apply(POD_NAME);
+    this.podNamespace = envProvider.apply(POD_NAMESPACE);
   }
 
   public String getProducerConfigFilePath() {
@@ -25,11 +39,53 @@ public abstract class BaseEnv {
     return dataPlaneConfigFilePath;
   }
 
+  public int getDataPlaneConfigShards() {
+    return dataPlaneConfigShards;
+  }
+
+  /**
+   * Get the paths of the data plane config shards.
+   *
+   * <p>The first shard is at the data plane config file path, other shards are at
+   * path-&lt;shard&gt;, which is the naming convention used by the control plane for shards.
+   *
+   * @return data plane config shards paths.
+   */
+  public List<String> getDataPlaneConfigFilePaths() {
+    final var paths = new ArrayList<String>(dataPlaneConfigShards);
+    paths.add(dataPlaneConfigFilePath);
+    for (int i = 1; i < dataPlaneConfigShards; i++) {
+      paths.add(dataPlaneConfigFilePath + "-" + i);
+    }
+    return paths;
+  }
+
+  /**
+   * Get the name of the pod, set using the downward API.
+   *
+   * @return pod name or null.
+   */
+  public String getPodName() {
+    return podName;
+  }
+
+  /**
+   * Get the namespace of the pod, set using the downward API.
+   *
+   * @return pod namespace or null.
+   */
+  public String getPodNamespace() {
+    return podNamespace;
+  }
+
   @Override
   public String toString() {
     return "BaseEnv{" +
       "producerConfigFilePath='" + producerConfigFilePath + '\'' +
       ", dataPlaneConfigFilePath='" + dataPlaneConfigFilePath + '\'' +
+      ", dataPlaneConfigShards=" + dataPlaneConfigShards +
+      ", podName='" + podName + '\'' +
+      ", podNamespace='" + podNamespace + '\'' +
       '}';
   }
 }
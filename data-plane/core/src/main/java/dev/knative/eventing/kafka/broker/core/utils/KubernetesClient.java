@@ -0,0 +1,83 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package dev.knative.eventing.kafka.broker.core.utils;
+
+import io.vertx.core.Future;
+import io.vertx.core.Promise;
+import io.vertx.core.Vertx;
+import io.vertx.core.buffer.Buffer;
+import io.vertx.core.http.HttpMethod;
+import io.vertx.core.net.PemTrustOptions;
+import io.vertx.ext.web.client.HttpRequest;
+import io.vertx.ext.web.client.WebClient;
+import io.vertx.ext.web.client.WebClientOptions;
+import java.nio.charset.StandardCharsets;
+import java.util.Objects;
+
+/**
+ * KubernetesClient sends requests to the Kubernetes API server using the in-cluster configuration
+ * (pod service account token and CA).
+ */
+public class KubernetesClient {
+
+  private static final String SERVICE_ACCOUNT_PATH = "/var/run/secrets/kubernetes.io/serviceaccount";
+  private static final String TOKEN_PATH = SERVICE_ACCOUNT_PATH + "/token";
+  private static final String CA_PATH = SERVICE_ACCOUNT_PATH + "/ca.crt";
+
+  private static final String KUBERNETES_SERVICE_HOST = "KUBERNETES_SERVICE_HOST";
+  private static final String KUBERNETES_SERVICE_PORT = "KUBERNETES_SERVICE_PORT";
+
+  private final Vertx vertx;
+  private final WebClient client;
+
+  /**
+   * All args constructor.
+   *
+   * @param vertx vertx instance.
+   */
+  public KubernetesClient(final Vertx vertx) {
+    Objects.requireNonNull(vertx, "provide vertx");
+
+    this.vertx = vertx;
+    this.client = WebClient.create(vertx, new WebClientOptions()
+      .setSsl(true)
+      .setPemTrustOptions(new PemTrustOptions().addCertPath(CA_PATH))
+      .setDefaultHost(System.getenv(KUBERNETES_SERVICE_HOST))
+      .setDefaultPort(Integer.parseInt(System.getenv(KUBERNETES_SERVICE_PORT)))
+    );
+  }
+
+  /**
+   * Create a request authenticated with the pod service account token.
+   *
+   * @param method HTTP method.
+   * @param uri    request URI.
+   * @return request.
+   */
+  public Future<HttpRequest<Buffer>> request(final HttpMethod method, final String uri) {
+
+    // The token is read for every request since it might be rotated.
+    final Promise<Buffer> token = Promise.promise();
+    vertx.fileSystem().readFile(TOKEN_PATH, token);
+
+    return token.future().map(t -> client
+      .request(method, uri)
+      .bearerTokenAuthentication(t.toString(StandardCharsets.UTF_8).trim())
+    );
+  }
+}
@@ -58,6 +58,40 @@ public class BrokerWrapperTest {
     assertThat(broker.topic()).isEqualTo(topic);
   }
 
+  @Test
+  public void authSecretCallsShouldBeDelegatedToWrappedBroker() {
+    final var broker = new BrokerWrapper(
+      Broker.newBuilder()
+        .setAuthSecret(BrokersConfig.SecretReference.newBuilder()
+          .setNamespace("knative-eventing")
+          .setName("kafka-auth")
+          .setVersion("1")
+          .build())
+        .build()
+    );
+
+    assertThat(broker.authSecretNamespace()).isEqualTo("knative-eventing");
+    assertThat(broker.authSecretName()).isEqualTo("kafka-auth");
+    assertThat(broker.authSecretVersion()).isEqualTo("1");
+  }
+
+  @Test
+  public void egressConfigCallsShouldBeDelegatedToWrappedBroker() {
+    final var broker = new BrokerWrapper(
+      Broker.newBuilder()
+        .setEgressConfig(BrokersConfig.EgressConfig.newBuilder()
+          .setRetry(3)
+          .setBackoffPolicy(BrokersConfig.BackoffPolicy.Linear)
+          .setBackoffDelay(1000)
+          .build())
+        .build()
+    );
+
+    assertThat(broker.retry()).isEqualTo(3);
+    assertThat(broker.backoffPolicy()).isEqualTo(BrokersConfig.BackoffPolicy.Linear);
+    assertThat(broker.backoffDelay()).isEqualTo(1000);
+  }
+
   @ParameterizedTest
   @MethodSource(value = {"equalTriggersProvider"})
   public void testTriggerEquality(
@@ -121,6 +155,71 @@ public class BrokerWrapperTest {
             .setBootstrapServers("kafka-1:9092,kafka-2:9092")
             .build()
         )
+      ),
+      Arguments.of(
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setAuthSecret(BrokersConfig.SecretReference.newBuilder()
+              .setNamespace("knative-eventing")
+              .setName("kafka-auth")
+              .build())
+            .build()
+        ),
+        new BrokerWrapper(
+          Broker.newBuilder().build()
+        )
+      ),
+      Arguments.of(
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setAuthSecret(BrokersConfig.SecretReference.newBuilder()
+              .setNamespace("knative-eventing")
+              .setName("kafka-auth")
+              .setVersion("1")
+              .build())
+            .build()
+        ),
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setAuthSecret(BrokersConfig.SecretReference.newBuilder()
+              .setNamespace("knative-eventing")
+              .setName("kafka-auth")
+              .setVersion("2")
+              .build())
+            .build()
+        )
+      ),
+      Arguments.of(
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setEgressConfig(BrokersConfig.EgressConfig.newBuilder()
+              .setRetry(3)
+              .build())
+            .build()
+        ),
+        new BrokerWrapper(
+          Broker.newBuilder().build()
+        )
+      ),
+      Arguments.of(
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setEgressConfig(BrokersConfig.EgressConfig.newBuilder()
+              .setRetry(3)
+              .setBackoffPolicy(BrokersConfig.BackoffPolicy.Exponential)
+              .setBackoffDelay(1000)
+              .build())
+            .build()
+        ),
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setEgressConfig(BrokersConfig.EgressConfig.newBuilder()
+              .setRetry(3)
+              .setBackoffPolicy(BrokersConfig.BackoffPolicy.Linear)
+              .setBackoffDelay(1000)
+              .build())
+            .build()
+        )
       )
     );
   }
@@ -209,6 +308,24 @@ public class BrokerWrapperTest {
             .setBootstrapServers("kafka-1:9092,kafka-2:9092")
             .build()
         )
+      ),
+      Arguments.of(
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setAuthSecret(BrokersConfig.SecretReference.newBuilder()
+              .setNamespace("knative-eventing")
+              .setName("kafka-auth")
+              .build())
+            .build()
+        ),
+        new BrokerWrapper(
+          Broker.newBuilder()
+            .setAuthSecret(BrokersConfig.SecretReference.newBuilder()
+              .setNamespace("knative-eventing")
+              .setName("kafka-auth")
+              .build())
+            .build()
+        )
       )
     );
   }
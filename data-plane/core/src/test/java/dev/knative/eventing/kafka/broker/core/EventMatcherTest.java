@@ -142,6 +142,46 @@ public class EventMatcherTest {
     assertThat(match).isTrue();
   }
 
+  @Test
+  public void shouldPassOnMatchingPrefix() {
+
+    final var event = CloudEventBuilder.v1()
+      .withId("123")
+      .withType("dev.knative.eventing.create")
+      .withSource(URI.create("/api/source"))
+      .withExtension("extension1", "valueExtension1")
+      .build();
+
+    final var eventMatcher = EventMatcher.prefix(Map.of(
+      "type", "dev.knative.",
+      "extension1", "value"
+    ));
+
+    assertThat(eventMatcher.match(event)).isTrue();
+    assertThat(EventMatcher.prefix(Map.of("type", "knative.")).match(event)).isFalse();
+    assertThat(EventMatcher.prefix(Map.of("subject", "a")).match(event)).isFalse();
+  }
+
+  @Test
+  public void shouldPassOnMatchingSuffix() {
+
+    final var event = CloudEventBuilder.v1()
+      .withId("123")
+      .withType("dev.knative.eventing.create")
+      .withSource(URI.create("/api/source"))
+      .withExtension("extension1", "valueExtension1")
+      .build();
+
+    final var eventMatcher = EventMatcher.suffix(Map.of(
+      "type", ".create",
+      "extension1", "Extension1"
+    ));
+
+    assertThat(eventMatcher.match(event)).isTrue();
+    assertThat(EventMatcher.suffix(Map.of("type", ".delete")).match(event)).isFalse();
+    assertThat(EventMatcher.suffix(Map.of("subject", "a")).match(event)).isFalse();
+  }
+
   public static Stream<Arguments> testCases() {
     return Stream.of(
       Arguments.of(
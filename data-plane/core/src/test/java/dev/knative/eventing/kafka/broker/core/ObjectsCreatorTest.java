@@ -17,14 +17,18 @@
 package dev.knative.eventing.kafka.broker.core;
 
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.broker1;
+import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.broker1Unwrapped;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.broker2;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.brokers;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.trigger1;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.trigger2;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.trigger3;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.trigger4;
+import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.trigger12;
 import static org.assertj.core.api.Assertions.assertThat;
 
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers;
 import io.vertx.core.Future;
 import java.util.Map;
 import java.util.Set;
@@ -51,7 +55,37 @@ public class ObjectsCreatorTest {
       return Future.succeededFuture();
     });
 
-    creator.accept(brokers());
+    assertThat(creator.apply(brokers()).succeeded()).isTrue();
+
+    assertThat(called.get()).isTrue();
+  }
+
+  @Test
+  public void shouldReportReconcileFailure() {
+    final var creator = new ObjectsCreator(
+      objects -> Future.failedFuture(new IllegalStateException("failed to reconcile"))
+    );
+
+    assertThat(creator.apply(brokers()).failed()).isTrue();
+  }
+
+  @Test
+  public void shouldSkipPausedTriggers() {
+    final var called = new AtomicBoolean(false);
+
+    final var broker = Broker.newBuilder(broker1Unwrapped())
+      .setTriggers(1, trigger12().toBuilder().setPaused(true))
+      .build();
+
+    final var creator = new ObjectsCreator(objects -> {
+      called.set(true);
+      assertThat(objects).usingRecursiveComparison().isEqualTo(Map.of(
+        new BrokerWrapper(broker), Set.of(trigger1())
+      ));
+      return Future.succeededFuture();
+    });
+
+    assertThat(creator.apply(Brokers.newBuilder().addBrokers(broker).build()).succeeded()).isTrue();
 
     assertThat(called.get()).isTrue();
   }
@@ -18,8 +18,14 @@ package dev.knative.eventing.kafka.broker.core;
 
 import static org.assertj.core.api.Assertions.assertThat;
 
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger;
 import io.cloudevents.CloudEvent;
+import io.cloudevents.core.builder.CloudEventBuilder;
+import java.net.URI;
 import java.util.Collections;
 import java.util.Map;
 import java.util.stream.Stream;
@@ -67,6 +73,92 @@ public class TriggerWrapperTest {
     assertThat(triggerWrapper.destination()).isEqualTo(destination);
   }
 
+  @Test
+  public void deadLetterSinkCallShouldBeDelegatedToWrappedTrigger() {
+    final var deadLetterSink = "http://dls.example.com";
+    final var triggerWrapper = new TriggerWrapper(
+      Trigger.newBuilder().setDeadLetterSink(deadLetterSink).build()
+    );
+
+    assertThat(triggerWrapper.deadLetterSink()).isEqualTo(deadLetterSink);
+  }
+
+  @Test
+  public void egressConfigCallShouldBeDelegatedToWrappedTrigger() {
+    final var egressConfig = EgressConfig.newBuilder()
+      .setRetry(5)
+      .setBackoffPolicy(BackoffPolicy.Linear)
+      .setBackoffDelay(100)
+      .setTimeout(1000)
+      .build();
+    final var triggerWrapper = new TriggerWrapper(
+      Trigger.newBuilder().setEgressConfig(egressConfig).build()
+    );
+
+    assertThat(triggerWrapper.egressConfig()).isEqualTo(egressConfig);
+  }
+
+  @Test
+  public void egressConfigShouldBeNullWhenNotSet() {
+    final var triggerWrapper = new TriggerWrapper(Trigger.newBuilder().build());
+
+    assertThat(triggerWrapper.egressConfig()).isNull();
+  }
+
+  @Test
+  public void deliveryOrderCallShouldBeDelegatedToWrappedTrigger() {
+    final var triggerWrapper = new TriggerWrapper(
+      Trigger.newBuilder().setDeliveryOrder(DeliveryOrder.Ordered).build()
+    );
+
+    assertThat(triggerWrapper.deliveryOrder()).isEqualTo(DeliveryOrder.Ordered);
+  }
+
+  @Test
+  public void filterShouldMatchFilterExpressions() {
+    final var triggerWrapper = new TriggerWrapper(
+      Trigger.newBuilder()
+        .putAttributes("source", "/api/source")
+        .addFilters(filter(FilterDialect.Prefix, Map.of("type", "dev.knative.")))
+        .addFilters(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.newBuilder()
+          .setDialect(FilterDialect.Any)
+          .addFilters(filter(FilterDialect.Suffix, Map.of("type", ".create")))
+          .addFilters(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.newBuilder()
+            .setDialect(FilterDialect.Not)
+            .addFilters(filter(FilterDialect.Exact, Map.of("subject", "ignored")))
+          )
+        )
+        .build()
+    );
+
+    final var filter = triggerWrapper.filter();
+
+    assertThat(filter.match(event("/api/source", "dev.knative.create", "ignored"))).isTrue();
+    assertThat(filter.match(event("/api/source", "dev.knative.delete", "subject"))).isTrue();
+    assertThat(filter.match(event("/api/source", "dev.knative.delete", "ignored"))).isFalse();
+    assertThat(filter.match(event("/api/source", "knative.create", "subject"))).isFalse();
+    assertThat(filter.match(event("/api/other", "dev.knative.create", "subject"))).isFalse();
+  }
+
+  private static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter filter(
+    final FilterDialect dialect,
+    final Map<String, String> attributes) {
+
+    return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.newBuilder()
+      .setDialect(dialect)
+      .putAllAttributes(attributes)
+      .build();
+  }
+
+  private static CloudEvent event(final String source, final String type, final String subject) {
+    return CloudEventBuilder.v1()
+      .withId("123")
+      .withSource(URI.create(source))
+      .withType(type)
+      .withSubject(subject)
+      .build();
+  }
+
   // test if filter returned by filter() agrees with EventMatcher
   @ParameterizedTest
   @MethodSource(value = "dev.knative.eventing.kafka.broker.core.EventMatcherTest#testCases")
@@ -89,6 +181,49 @@ public class TriggerWrapperTest {
 
   public static Stream<Arguments> differentTriggersProvider() {
     return Stream.of(
+      // trigger's dead letter sink is different
+      Arguments.of(
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .setDeadLetterSink("http://dls1.example.com")
+          .build()
+        ),
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .setDeadLetterSink("http://dls.example.com")
+          .build()
+        )
+      ),
+      // trigger's delivery order is different
+      Arguments.of(
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .setDeliveryOrder(DeliveryOrder.Ordered)
+          .build()
+        ),
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .build()
+        )
+      ),
+      // trigger's egress config is different
+      Arguments.of(
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .setEgressConfig(EgressConfig.newBuilder().setRetry(5).build())
+          .build()
+        ),
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .build()
+        )
+      ),
       // trigger's destination is different
       Arguments.of(
         new TriggerWrapper(Trigger
@@ -159,6 +294,23 @@ public class TriggerWrapperTest {
           .build()
         )
       ),
+      // trigger's filters are different
+      Arguments.of(
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .setId("1234-hello")
+          .addFilters(filter(FilterDialect.Prefix, Map.of("type", "dev.knative.")))
+          .build()
+        ),
+        new TriggerWrapper(Trigger
+          .newBuilder()
+          .setDestination("this-is-my-destination")
+          .setId("1234-hello")
+          .addFilters(filter(FilterDialect.Suffix, Map.of("type", "dev.knative.")))
+          .build()
+        )
+      ),
       // trigger's id is different
       Arguments.of(
         new TriggerWrapper(Trigger
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.file;
+
+import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.broker1Unwrapped;
+import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.broker2Unwrapped;
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.assertThatThrownBy;
+
+import com.google.protobuf.util.JsonFormat;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger;
+import java.io.IOException;
+import java.nio.charset.StandardCharsets;
+import java.util.Base64;
+import org.junit.jupiter.api.Test;
+
+public class CompressedBrokersTest {
+
+  @Test
+  public void shouldEncodeAndDecode() throws IOException {
+    final var brokers = Brokers.newBuilder()
+      .addBrokers(broker1Unwrapped())
+      .addBrokers(broker2Unwrapped())
+      .setVolumeGeneration(42)
+      .build();
+
+    final var encoded = CompressedBrokers.encode(brokers, CompressedBrokers.CODEC_GZIP);
+
+    assertThat(CompressedBrokers.isCompressed(encoded)).isTrue();
+    assertThat(CompressedBrokers.decode(encoded)).isEqualTo(brokers);
+  }
+
+  @Test
+  public void shouldDecodeControlPlaneEncoding() throws IOException {
+    // Encoded by the control plane with the protobuf-gzip format.
+    final var encoded = Base64.getDecoder().decode(
+      "AGtiAR+LCAAAAAAAAP8AXgCh/wpaCghicm9rZXItMRIHdG9waWMtMSI3ChMKBHR5cGUSC2Rldi5rbmF0aXZlEhVodHRwOi8"
+        + "vbG9jYWxob3N0OjgwODAaCXRyaWdnZXItMSoML25zL2Jyb2tlci0xEAMDAOqJ5L1eAAAA"
+    );
+
+    final var expected = Brokers.newBuilder()
+      .addBrokers(Broker.newBuilder()
+        .setId("broker-1")
+        .setTopic("topic-1")
+        .setPath("/ns/broker-1")
+        .addTriggers(Trigger.newBuilder()
+          .setId("trigger-1")
+          .setDestination("http://localhost:8080")
+          .putAttributes("type", "dev.knative")
+        )
+      )
+      .setVolumeGeneration(3)
+      .build();
+
+    assertThat(CompressedBrokers.decode(encoded)).isEqualTo(expected);
+  }
+
+  @Test
+  public void shouldNotRecognizeJson() throws IOException {
+    final var json = JsonFormat.printer()
+      .print(Brokers.newBuilder().addBrokers(broker1Unwrapped()))
+      .getBytes(StandardCharsets.UTF_8);
+
+    assertThat(CompressedBrokers.isCompressed(json)).isFalse();
+    assertThat(CompressedBrokers.isCompressed(new byte[0])).isFalse();
+  }
+
+  @Test
+  public void shouldFailOnUnknownCodec() {
+    final var data = new byte[]{0x00, 'k', 'b', 42, 1, 2, 3};
+
+    assertThat(CompressedBrokers.isCompressed(data)).isTrue();
+    assertThatThrownBy(() -> CompressedBrokers.decode(data))
+      .isInstanceOf(IllegalArgumentException.class);
+  }
+}
@@ -19,17 +19,21 @@ package dev.knative.eventing.kafka.broker.core.file;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.broker1Unwrapped;
 import static dev.knative.eventing.kafka.broker.core.testing.utils.CoreObjects.broker2Unwrapped;
 import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.entry;
 
 import com.google.protobuf.util.JsonFormat;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers;
+import io.vertx.core.Future;
 import java.io.File;
 import java.io.FileWriter;
 import java.io.IOException;
 import java.nio.file.FileSystems;
 import java.nio.file.Files;
+import java.util.ArrayList;
+import java.util.List;
 import java.util.concurrent.CountDownLatch;
 import java.util.concurrent.atomic.AtomicBoolean;
-import java.util.function.Consumer;
+import java.util.function.Function;
 import org.junit.jupiter.api.Test;
 import org.junit.jupiter.api.Timeout;
 import org.slf4j.LoggerFactory;
@@ -52,7 +56,7 @@ public class FileWatcherTest {
     final var isFirst = new AtomicBoolean(true);
     final var waitFirst = new CountDownLatch(1);
     final var waitSecond = new CountDownLatch(1);
-    final Consumer<Brokers> brokersConsumer = broker -> {
+    final Function<Brokers, Future<Void>> brokersConsumer = broker -> {
 
       if (isFirst.getAndSet(false)) {
         assertThat(broker).isEqualTo(broker1);
@@ -61,6 +65,7 @@ public class FileWatcherTest {
         assertThat(broker).isEqualTo(broker2);
         waitSecond.countDown();
       }
+      return Future.succeededFuture();
     };
 
     final var fw = new FileWatcher(
@@ -97,9 +102,10 @@ public class FileWatcherTest {
     write(file, broker1);
 
     final var waitBroker = new CountDownLatch(1);
-    final Consumer<Brokers> brokersConsumer = broker -> {
+    final Function<Brokers, Future<Void>> brokersConsumer = broker -> {
       assertThat(broker).isEqualTo(broker1);
       waitBroker.countDown();
+      return Future.succeededFuture();
     };
 
     final var fw = new FileWatcher(
@@ -115,6 +121,187 @@ public class FileWatcherTest {
     thread.interrupt();
   }
 
+  @Test
+  @Timeout(value = 5)
+  public void shouldReadCompressedBrokers() throws IOException, InterruptedException {
+
+    final var file = Files.createTempFile("fw-", "-fw").toFile();
+
+    final var broker1 = Brokers.newBuilder()
+      .addBrokers(broker1Unwrapped())
+      .setVolumeGeneration(1)
+      .build();
+    Files.write(file.toPath(), CompressedBrokers.encode(broker1, CompressedBrokers.CODEC_GZIP));
+
+    final var waitBroker = new CountDownLatch(1);
+    final Function<Brokers, Future<Void>> brokersConsumer = broker -> {
+      assertThat(broker).isEqualTo(broker1);
+      waitBroker.countDown();
+      return Future.succeededFuture();
+    };
+
+    final var fw = new FileWatcher(
+      FileSystems.getDefault().newWatchService(),
+      brokersConsumer,
+      file
+    );
+
+    final var thread = watch(fw);
+
+    waitBroker.await();
+
+    thread.interrupt();
+  }
+
+  @Test
+  @Timeout(value = 5)
+  public void shouldMergeShards() throws IOException, InterruptedException {
+
+    final var dir = Files.createTempDirectory("fw-");
+    final var shard0 = dir.resolve("data").toFile();
+    final var shard1 = dir.resolve("data-1").toFile();
+    final var shard2 = dir.resolve("data-2").toFile(); // not mounted
+
+    write(shard0, Brokers.newBuilder()
+      .addBrokers(broker1Unwrapped())
+      .setVolumeGeneration(3)
+      .build());
+    write(shard1, Brokers.newBuilder()
+      .addBrokers(broker2Unwrapped())
+      .setVolumeGeneration(7)
+      .build());
+
+    final var expected = Brokers.newBuilder()
+      .addBrokers(broker1Unwrapped())
+      .addBrokers(broker2Unwrapped())
+      .build();
+
+    final var waitBrokers = new CountDownLatch(1);
+    final Function<Brokers, Future<Void>> brokersConsumer = brokers -> {
+      assertThat(brokers).isEqualTo(expected);
+      waitBrokers.countDown();
+      return Future.succeededFuture();
+    };
+
+    final var fw = new FileWatcher(
+      FileSystems.getDefault().newWatchService(),
+      brokersConsumer,
+      List.of(shard0, shard1, shard2)
+    );
+
+    final var thread = watch(fw);
+
+    waitBrokers.await();
+
+    thread.interrupt();
+  }
+
+  @Test
+  @Timeout(value = 5)
+  public void shouldAcknowledgeShardsVolumeGenerations() throws IOException, InterruptedException {
+
+    final var dir = Files.createTempDirectory("fw-");
+    final var shard0 = dir.resolve("data").toFile();
+    final var shard1 = dir.resolve("data-1").toFile();
+    final var shard2 = dir.resolve("data-2").toFile(); // not mounted
+
+    write(shard0, Brokers.newBuilder()
+      .addBrokers(broker1Unwrapped())
+      .setVolumeGeneration(3)
+      .build());
+    write(shard1, Brokers.newBuilder()
+      .addBrokers(broker2Unwrapped())
+      .setVolumeGeneration(7)
+      .build());
+
+    final var brokersReported = new AtomicBoolean(false);
+    final var waitAck = new CountDownLatch(1);
+    final VolumeGenerationAcknowledger acknowledger = volumeGenerations -> {
+      // Volume generations are acknowledged once brokers are reported.
+      assertThat(brokersReported.get()).isTrue();
+      assertThat(volumeGenerations).containsExactly(3L, 7L, 0L);
+      waitAck.countDown();
+    };
+
+    final var fw = new FileWatcher(
+      FileSystems.getDefault().newWatchService(),
+      brokers -> {
+        brokersReported.set(true);
+        return Future.succeededFuture();
+      },
+      List.of(shard0, shard1, shard2),
+      acknowledger
+    );
+
+    final var thread = watch(fw);
+
+    waitAck.await();
+
+    thread.interrupt();
+  }
+
+  @Test
+  @Timeout(value = 5)
+  public void shouldNotAcknowledgeVolumeGenerationWhenReconcileFails()
+    throws IOException, InterruptedException {
+
+    final var file = Files.createTempFile("fw-", "-fw").toFile();
+
+    write(file, Brokers.newBuilder()
+      .addBrokers(broker1Unwrapped())
+      .setVolumeGeneration(1)
+      .build());
+
+    final var acknowledged = new ArrayList<List<Long>>();
+    final var waitAck = new CountDownLatch(1);
+    final VolumeGenerationAcknowledger acknowledger = volumeGenerations -> {
+      acknowledged.add(volumeGenerations);
+      if (volumeGenerations.equals(List.of(2L))) {
+        waitAck.countDown();
+      }
+    };
+
+    final Function<Brokers, Future<Void>> brokersConsumer = brokers -> {
+      if (brokers.getVolumeGeneration() == 1) {
+        return Future.failedFuture(new IllegalStateException("failed to load credentials"));
+      }
+      return Future.succeededFuture();
+    };
+
+    final var fw = new FileWatcher(
+      FileSystems.getDefault().newWatchService(),
+      brokersConsumer,
+      List.of(file),
+      acknowledger
+    );
+
+    final var thread = watch(fw);
+
+    Thread.sleep(1000);
+
+    write(file, Brokers.newBuilder()
+      .addBrokers(broker1Unwrapped())
+      .setVolumeGeneration(2)
+      .build());
+    waitAck.await();
+
+    thread.interrupt();
+
+    assertThat(acknowledged).doesNotContain(List.of(1L));
+  }
+
+  @Test
+  public void shouldCreatePodAnnotationsPatch() {
+    final var patch = PodAnnotationAcknowledger.patch(List.of(3L, 7L, -1L));
+
+    assertThat(patch.getJsonObject("metadata").getJsonObject("annotations").getMap())
+      .containsOnly(
+        entry("volumeGenerationAck", "3"),
+        entry("volumeGenerationAck-1", "7"),
+        entry("volumeGenerationAck-2", "18446744073709551615")
+      );
+  }
+
   private Thread watch(FileWatcher fw) {
     final var thread = new Thread(() -> {
       try {
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.core.security;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.junit.jupiter.api.Assertions.assertThrows;
+
+import java.util.Map;
+import java.util.Properties;
+import org.apache.kafka.clients.CommonClientConfigs;
+import org.apache.kafka.common.config.SaslConfigs;
+import org.apache.kafka.common.config.SslConfigs;
+import org.junit.jupiter.api.Test;
+
+public class KafkaClientsAuthTest {
+
+  @Test
+  public void shouldDefaultToPlaintext() {
+    final var configs = new Properties();
+
+    KafkaClientsAuth.attachCredentials(configs, new KubernetesCredentials(Map.of()));
+
+    assertThat(configs.getProperty(CommonClientConfigs.SECURITY_PROTOCOL_CONFIG))
+      .isEqualTo("PLAINTEXT");
+    assertThat(configs.getProperty(SaslConfigs.SASL_JAAS_CONFIG)).isNull();
+    assertThat(configs.getProperty(SslConfigs.SSL_TRUSTSTORE_LOCATION_CONFIG)).isNull();
+  }
+
+  @Test
+  public void shouldConfigureSaslPlain() {
+    final var configs = new Properties();
+
+    KafkaClientsAuth.attachCredentials(configs, new KubernetesCredentials(Map.of(
+      KubernetesCredentials.PROTOCOL_KEY, "SASL_PLAINTEXT",
+      KubernetesCredentials.SASL_USERNAME_KEY, "user",
+      KubernetesCredentials.SASL_PASSWORD_KEY, "pass\"word"
+    )));
+
+    assertThat(configs.getProperty(CommonClientConfigs.SECURITY_PROTOCOL_CONFIG))
+      .isEqualTo("SASL_PLAINTEXT");
+    assertThat(configs.getProperty(SaslConfigs.SASL_MECHANISM)).isEqualTo("PLAIN");
+    assertThat(configs.getProperty(SaslConfigs.SASL_JAAS_CONFIG)).isEqualTo(
+      "org.apache.kafka.common.security.plain.PlainLoginModule required "
+        + "username=\"user\" password=\"pass\\\"word\";"
+    );
+  }
+
+  @Test
+  public void shouldConfigureSaslScram() {
+    final var configs = new Properties();
+
+    KafkaClientsAuth.attachCredentials(configs, new KubernetesCredentials(Map.of(
+      KubernetesCredentials.PROTOCOL_KEY, "SASL_PLAINTEXT",
+      KubernetesCredentials.SASL_MECHANISM_KEY, "SCRAM-SHA-512",
+      KubernetesCredentials.SASL_USERNAME_KEY, "user",
+      KubernetesCredentials.SASL_PASSWORD_KEY, "password"
+    )));
+
+    assertThat(configs.getProperty(SaslConfigs.SASL_MECHANISM)).isEqualTo("SCRAM-SHA-512");
+    assertThat(configs.getProperty(SaslConfigs.SASL_JAAS_CONFIG))
+      .startsWith("org.apache.kafka.common.security.scram.ScramLoginModule required");
+  }
+
+  @Test
+  public void shouldFailWithoutSaslPassword() {
+    assertThrows(IllegalArgumentException.class, () -> KafkaClientsAuth.attachCredentials(
+      new Properties(),
+      new KubernetesCredentials(Map.of(
+        KubernetesCredentials.PROTOCOL_KEY, "SASL_SSL",
+        KubernetesCredentials.SASL_USERNAME_KEY, "user"
+      ))
+    ));
+  }
+
+  @Test
+  public void shouldFailWithUnsupportedProtocol() {
+    assertThrows(IllegalArgumentException.class, () -> KafkaClientsAuth.attachCredentials(
+      new Properties(),
+      new KubernetesCredentials(Map.of(KubernetesCredentials.PROTOCOL_KEY, "SSH"))
+    ));
+  }
+
+  @Test
+  public void shouldFailWithInvalidCaCertificate() {
+    assertThrows(IllegalArgumentException.class, () -> KafkaClientsAuth.attachCredentials(
+      new Properties(),
+      new KubernetesCredentials(Map.of(
+        KubernetesCredentials.PROTOCOL_KEY, "SSL",
+        KubernetesCredentials.CA_CERTIFICATE_KEY, "not a certificate"
+      ))
+    ));
+  }
+}
@@ -115,57 +115,87 @@ public final class ConsumerRecordHandler<K, V, R> implements
    */
   @Override
   public void handle(final KafkaConsumerRecord<K, V> record) {
+    dispatch(record);
+  }
+
+  /**
+   * Dispatch the given record.
+   *
+   * @param record record to dispatch.
+   * @return a future completed once the {@link ConsumerRecordOffsetStrategy} has been notified of
+   * the outcome of the dispatch.
+   */
+  Future<Void> dispatch(final KafkaConsumerRecord<K, V> record) {
 
     logger.debug("handling record {}", record);
 
     receiver.recordReceived(record);
 
-    if (filter.match(record.value())) {
-      logger.debug("record match filtering {}", record);
-
-      subscriberSender.send(record)
-        .onSuccess(response -> onSuccessfullySentToSubscriber(record))
-        .onFailure(cause -> onFailedToSendToSubscriber(record, cause))
-        .compose(sinkResponseHandler::handle)
-        .onFailure(
-          t -> logger.error("Failed to send the subscriber response to the broker topic", t));
-    } else {
+    if (!filter.match(record.value())) {
       logger.debug("record doesn't match filtering {}", record);
 
       receiver.recordDiscarded(record);
+      return Future.succeededFuture();
     }
+
+    logger.debug("record match filtering {}", record);
+
+    return subscriberSender.send(record).compose(
+      response -> onSuccessfullySentToSubscriber(record, response),
+      cause -> onFailedToSendToSubscriber(record, cause)
+    );
   }
 
-  private void onSuccessfullySentToSubscriber(final KafkaConsumerRecord<K, V> record) {
+  private Future<Void> onSuccessfullySentToSubscriber(
+    final KafkaConsumerRecord<K, V> record,
+    final R response) {
+
     logSuccessfulSendTo(SUBSCRIBER, record);
 
     receiver.successfullySentToSubscriber(record);
+
+    handleSinkResponse(response);
+    return Future.succeededFuture();
   }
 
-  private void onFailedToSendToSubscriber(
+  private Future<Void> onFailedToSendToSubscriber(
     final KafkaConsumerRecord<K, V> record,
     final Throwable cause) {
 
     logFailedSendTo(SUBSCRIBER, record, cause);
 
-    deadLetterQueueSender.send(record)
-      .onSuccess(ignored -> onSuccessfullySentToDLQ(record))
-      .onFailure(ex -> onFailedToSendToDLQ(record, ex))
-      .compose(sinkResponseHandler::handle)
-      .onFailure(
-        t -> logger.error("Failed to send the subscriber response to the broker topic", t));
+    return deadLetterQueueSender.send(record).compose(
+      response -> onSuccessfullySentToDLQ(record, response),
+      ex -> onFailedToSendToDLQ(record, ex)
+    );
   }
 
-  private void onSuccessfullySentToDLQ(final KafkaConsumerRecord<K, V> record) {
+  private Future<Void> onSuccessfullySentToDLQ(
+    final KafkaConsumerRecord<K, V> record,
+    final R response) {
+
     logSuccessfulSendTo(DLQ, record);
 
     receiver.successfullySentToDLQ(record);
+
+    handleSinkResponse(response);
+    return Future.succeededFuture();
   }
 
-  private void onFailedToSendToDLQ(KafkaConsumerRecord<K, V> record, Throwable ex) {
+  private Future<Void> onFailedToSendToDLQ(
+    final KafkaConsumerRecord<K, V> record,
+    final Throwable ex) {
+
     logFailedSendTo(DLQ, record, ex);
 
     receiver.failedToSendToDLQ(record, ex);
+    return Future.succeededFuture();
+  }
+
+  private void handleSinkResponse(final R response) {
+    sinkResponseHandler.handle(response)
+      .onFailure(
+        t -> logger.error("Failed to send the subscriber response to the broker topic", t));
   }
 
   private static <K, V> void logFailedSendTo(
@@ -18,6 +18,8 @@ package dev.knative.eventing.kafka.broker.dispatcher;
 
 import dev.knative.eventing.kafka.broker.core.ObjectsCreator;
 import dev.knative.eventing.kafka.broker.core.file.FileWatcher;
+import dev.knative.eventing.kafka.broker.core.file.VolumeGenerationAcknowledger;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
 import dev.knative.eventing.kafka.broker.core.utils.Configurations;
 import dev.knative.eventing.kafka.broker.dispatcher.http.HttpConsumerVerticleFactory;
 import io.cloudevents.CloudEvent;
@@ -27,6 +29,7 @@ import io.vertx.ext.web.client.WebClientOptions;
 import java.io.File;
 import java.io.IOException;
 import java.nio.file.FileSystems;
+import java.util.stream.Collectors;
 import net.logstash.logback.encoder.LogstashEncoder;
 import org.slf4j.Logger;
 import org.slf4j.LoggerFactory;
@@ -67,7 +70,8 @@ public class Main {
       consumerConfig,
       WebClient.create(vertx, new WebClientOptions(webClientConfig)),
       vertx,
-      producerConfig
+      producerConfig,
+      AuthProvider.kubernetes(vertx)
     );
 
     final var brokersManager = new BrokersManager<>(
@@ -83,7 +87,12 @@ public class Main {
       final var fw = new FileWatcher(
         FileSystems.getDefault().newWatchService(),
         objectCreator,
-        new File(env.getDataPlaneConfigFilePath())
+        env.getDataPlaneConfigFilePaths().stream()
+          .map(File::new)
+          .collect(Collectors.toList()),
+        env.getPodName() == null
+          ? VolumeGenerationAcknowledger.noop()
+          : VolumeGenerationAcknowledger.kubernetes(vertx, env.getPodNamespace(), env.getPodName())
       );
 
       fw.watch(); // block forever
@@ -0,0 +1,122 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.dispatcher;
+
+import static net.logstash.logback.argument.StructuredArguments.keyValue;
+
+import io.vertx.core.Future;
+import io.vertx.core.Handler;
+import io.vertx.kafka.client.common.TopicPartition;
+import io.vertx.kafka.client.consumer.KafkaConsumer;
+import io.vertx.kafka.client.consumer.KafkaConsumerRecord;
+import java.util.HashMap;
+import java.util.HashSet;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Set;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+
+/**
+ * OrderedConsumerRecordHandler dispatches records of the same partition one at a time, in the order
+ * they were appended to the partition.
+ *
+ * <p>Partitions with records waiting for the previous record to be dispatched are paused, so that
+ * the consumer doesn't fetch more records than the ones already received, and they're resumed once
+ * every record has been dispatched.
+ *
+ * <p>This class isn't thread-safe, records must be handled on the context of the consumer.
+ *
+ * @param <K> type of records' key.
+ * @param <V> type of records' value.
+ * @param <R> type of the response of given senders.
+ */
+public final class OrderedConsumerRecordHandler<K, V, R> implements
+  Handler<KafkaConsumerRecord<K, V>> {
+
+  private static final Logger logger = LoggerFactory
+    .getLogger(OrderedConsumerRecordHandler.class);
+
+  private final KafkaConsumer<K, V> consumer;
+  private final ConsumerRecordHandler<K, V, R> handler;
+
+  // last record dispatch of each partition.
+  private final Map<TopicPartition, Future<Void>> lastDispatch;
+  private final Set<TopicPartition> paused;
+
+  /**
+   * All args constructor.
+   *
+   * @param consumer Kafka consumer.
+   * @param handler  handler dispatching records.
+   */
+  public OrderedConsumerRecordHandler(
+    final KafkaConsumer<K, V> consumer,
+    final ConsumerRecordHandler<K, V, R> handler) {
+
+    Objects.requireNonNull(consumer, "provide consumer");
+    Objects.requireNonNull(handler, "provide handler");
+
+    this.consumer = consumer;
+    this.handler = handler;
+    this.lastDispatch = new HashMap<>();
+    this.paused = new HashSet<>();
+  }
+
+  /**
+   * Handle the given record once the previous record of the same partition has been dispatched.
+   *
+   * @param record record to handle.
+   */
+  @Override
+  public void handle(final KafkaConsumerRecord<K, V> record) {
+    final var topicPartition = new TopicPartition(record.topic(), record.partition());
+
+    final var previous = lastDispatch.get(topicPartition);
+    if (previous != null && !previous.isComplete() && paused.add(topicPartition)) {
+      logger.debug("pausing partition {} {}",
+        keyValue("topic", record.topic()),
+        keyValue("partition", record.partition())
+      );
+
+      consumer.pause(topicPartition);
+    }
+
+    final var dispatch = previous == null
+      ? handler.dispatch(record)
+      : previous.compose(v -> handler.dispatch(record), cause -> handler.dispatch(record));
+
+    lastDispatch.put(topicPartition, dispatch);
+
+    dispatch.onComplete(ignored -> {
+      if (lastDispatch.get(topicPartition) != dispatch) {
+        // Other records of the partition are waiting.
+        return;
+      }
+
+      lastDispatch.remove(topicPartition);
+      if (paused.remove(topicPartition)) {
+        logger.debug("resuming partition {} {}",
+          keyValue("topic", record.topic()),
+          keyValue("partition", record.partition())
+        );
+
+        consumer.resume(topicPartition);
+      }
+    });
+  }
+}
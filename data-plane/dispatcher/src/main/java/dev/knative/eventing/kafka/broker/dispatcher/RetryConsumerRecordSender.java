@@ -0,0 +1,122 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.dispatcher;
+
+import static net.logstash.logback.argument.StructuredArguments.keyValue;
+
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy;
+import io.vertx.core.Future;
+import io.vertx.core.Promise;
+import io.vertx.core.Vertx;
+import io.vertx.kafka.client.consumer.KafkaConsumerRecord;
+import java.util.Objects;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+
+/**
+ * RetryConsumerRecordSender retries sending a record with the given sender until it succeeds or
+ * the number of retries is exhausted, waiting between attempts according to the backoff policy.
+ *
+ * @param <K> type of records' key.
+ * @param <V> type of records' value.
+ * @param <R> type of the response of the given sender.
+ */
+public final class RetryConsumerRecordSender<K, V, R> implements ConsumerRecordSender<K, V, R> {
+
+  private static final Logger logger = LoggerFactory.getLogger(RetryConsumerRecordSender.class);
+
+  private final Vertx vertx;
+  private final ConsumerRecordSender<K, V, R> sender;
+  private final int retry;
+  private final BackoffPolicy backoffPolicy;
+  private final long backoffDelay;
+
+  /**
+   * All args constructor.
+   *
+   * @param vertx         vertx instance.
+   * @param sender        sender to retry.
+   * @param retry         number of retries, 0 means no retries.
+   * @param backoffPolicy backoff policy applied between retries.
+   * @param backoffDelay  delay before retrying in milliseconds.
+   */
+  public RetryConsumerRecordSender(
+    final Vertx vertx,
+    final ConsumerRecordSender<K, V, R> sender,
+    final int retry,
+    final BackoffPolicy backoffPolicy,
+    final long backoffDelay) {
+
+    Objects.requireNonNull(vertx, "provide vertx");
+    Objects.requireNonNull(sender, "provide sender");
+    Objects.requireNonNull(backoffPolicy, "provide backoffPolicy");
+    if (retry < 0) {
+      throw new IllegalArgumentException("retry must be non-negative - got " + retry);
+    }
+    if (backoffDelay < 0) {
+      throw new IllegalArgumentException("backoffDelay must be non-negative - got " + backoffDelay);
+    }
+
+    this.vertx = vertx;
+    this.sender = sender;
+    this.retry = retry;
+    this.backoffPolicy = backoffPolicy;
+    this.backoffDelay = backoffDelay;
+  }
+
+  /**
+   * {@inheritDoc}
+   */
+  @Override
+  public Future<R> send(final KafkaConsumerRecord<K, V> record) {
+    return send(record, 0);
+  }
+
+  private Future<R> send(final KafkaConsumerRecord<K, V> record, final int attempt) {
+    return sender.send(record).recover(cause -> {
+      if (attempt >= retry) {
+        return Future.failedFuture(cause);
+      }
+
+      final var delay = delay(attempt);
+
+      logger.debug("failed to send record, retrying {} {} {}",
+        keyValue("attempt", attempt + 1),
+        keyValue("delay", delay),
+        keyValue("cause", cause.getMessage())
+      );
+
+      final Promise<R> promise = Promise.promise();
+      vertx.setTimer(delay, timerId -> send(record, attempt + 1).onComplete(promise));
+      return promise.future();
+    });
+  }
+
+  /**
+   * Get the delay before the given retry.
+   *
+   * @param attempt number of retries already done.
+   * @return delay in milliseconds, timers need a positive delay.
+   */
+  long delay(final int attempt) {
+    if (backoffPolicy == BackoffPolicy.Linear) {
+      return Math.max(1, backoffDelay);
+    }
+    // Casting a double greater than Long.MAX_VALUE returns Long.MAX_VALUE.
+    return Math.max(1, (long) (backoffDelay * Math.pow(2, attempt)));
+  }
+}
@@ -39,9 +39,10 @@ public final class HttpConsumerRecordSender implements
 
   private final WebClient client;
   private final String subscriberURI;
+  private final long timeout;
 
   /**
-   * All args constructor.
+   * Constructor for senders without request timeout.
    *
    * @param client        http client.
    * @param subscriberURI subscriber URI
@@ -50,14 +51,33 @@ public final class HttpConsumerRecordSender implements
     final WebClient client,
     final String subscriberURI) {
 
+    this(client, subscriberURI, 0);
+  }
+
+  /**
+   * All args constructor.
+   *
+   * @param client        http client.
+   * @param subscriberURI subscriber URI
+   * @param timeout       request timeout in milliseconds, 0 means no timeout.
+   */
+  public HttpConsumerRecordSender(
+    final WebClient client,
+    final String subscriberURI,
+    final long timeout) {
+
     Objects.requireNonNull(client, "provide client");
     Objects.requireNonNull(subscriberURI, "provide subscriber URI");
     if (subscriberURI.equals("") || !URI.create(subscriberURI).isAbsolute()) {
       throw new IllegalArgumentException("provide a valid subscriber URI");
     }
+    if (timeout < 0) {
+      throw new IllegalArgumentException("timeout must be non-negative - got " + timeout);
+    }
 
     this.client = client;
     this.subscriberURI = subscriberURI;
+    this.timeout = timeout;
   }
 
   /**
@@ -66,8 +86,13 @@ public final class HttpConsumerRecordSender implements
   @Override
   public Future<HttpResponse<Buffer>> send(final KafkaConsumerRecord<String, CloudEvent> record) {
     try {
+      final var request = client.postAbs(subscriberURI);
+      if (timeout > 0) {
+        request.timeout(timeout);
+      }
+
       return VertxMessageFactory
-        .createWriter(client.postAbs(subscriberURI))
+        .createWriter(request)
         .writeBinary(record.value())
         .compose(response -> {
           if (response.statusCode() >= 300 || response.statusCode() < 200) {
@@ -20,21 +20,31 @@ import static org.apache.kafka.clients.consumer.ConsumerConfig.GROUP_ID_CONFIG;
 
 import dev.knative.eventing.kafka.broker.core.Broker;
 import dev.knative.eventing.kafka.broker.core.Trigger;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
+import dev.knative.eventing.kafka.broker.core.security.Credentials;
+import dev.knative.eventing.kafka.broker.core.security.KafkaClientsAuth;
 import dev.knative.eventing.kafka.broker.dispatcher.ConsumerRecordHandler;
 import dev.knative.eventing.kafka.broker.dispatcher.ConsumerRecordOffsetStrategyFactory;
 import dev.knative.eventing.kafka.broker.dispatcher.ConsumerRecordSender;
 import dev.knative.eventing.kafka.broker.dispatcher.ConsumerVerticle;
 import dev.knative.eventing.kafka.broker.dispatcher.ConsumerVerticleFactory;
+import dev.knative.eventing.kafka.broker.dispatcher.OrderedConsumerRecordHandler;
+import dev.knative.eventing.kafka.broker.dispatcher.RetryConsumerRecordSender;
 import io.cloudevents.CloudEvent;
 import io.cloudevents.kafka.CloudEventDeserializer;
 import io.cloudevents.kafka.CloudEventSerializer;
 import io.vertx.circuitbreaker.CircuitBreakerOptions;
 import io.vertx.core.AbstractVerticle;
 import io.vertx.core.Future;
+import io.vertx.core.Handler;
 import io.vertx.core.Vertx;
 import io.vertx.core.buffer.Buffer;
 import io.vertx.ext.web.client.HttpResponse;
 import io.vertx.ext.web.client.WebClient;
+import io.vertx.kafka.client.consumer.KafkaConsumerRecord;
 import java.util.Objects;
 import java.util.Properties;
 import org.apache.kafka.clients.consumer.ConsumerConfig;
@@ -55,6 +65,7 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
   private final Properties producerConfigs;
   private final ConsumerRecordOffsetStrategyFactory<String, CloudEvent>
     consumerRecordOffsetStrategyFactory;
+  private final AuthProvider authProvider;
 
   /**
    * All args constructor.
@@ -64,6 +75,7 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
    * @param client                              http client.
    * @param vertx                               vertx instance.
    * @param producerConfigs                     base producer configurations.
+   * @param authProvider                        provider of Brokers credentials.
    */
   public HttpConsumerVerticleFactory(
     final ConsumerRecordOffsetStrategyFactory<String, CloudEvent>
@@ -71,7 +83,8 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
     final Properties consumerConfigs,
     final WebClient client,
     final Vertx vertx,
-    final Properties producerConfigs) {
+    final Properties producerConfigs,
+    final AuthProvider authProvider) {
 
     Objects.requireNonNull(consumerRecordOffsetStrategyFactory,
       "provide consumerRecordOffsetStrategyFactory");
@@ -79,12 +92,14 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
     Objects.requireNonNull(client, "provide message");
     Objects.requireNonNull(vertx, "provide vertx");
     Objects.requireNonNull(producerConfigs, "provide producerConfigs");
+    Objects.requireNonNull(authProvider, "provide authProvider");
 
     this.consumerRecordOffsetStrategyFactory = consumerRecordOffsetStrategyFactory;
     this.consumerConfigs = consumerConfigs;
     this.producerConfigs = producerConfigs;
     this.client = client;
     this.vertx = vertx;
+    this.authProvider = authProvider;
   }
 
   /**
@@ -95,21 +110,51 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
     Objects.requireNonNull(broker, "provide broker");
     Objects.requireNonNull(trigger, "provide trigger");
 
+    return getCredentials(broker)
+      .compose(credentials -> createConsumerVerticle(broker, trigger, credentials));
+  }
+
+  private Future<Credentials> getCredentials(final Broker broker) {
+    if (broker.authSecretName() == null || broker.authSecretName().isEmpty()) {
+      return Future.succeededFuture();
+    }
+    return authProvider.getCredentials(broker.authSecretNamespace(), broker.authSecretName());
+  }
+
+  private Future<AbstractVerticle> createConsumerVerticle(
+    final Broker broker,
+    final Trigger<CloudEvent> trigger,
+    final Credentials credentials) {
+
     final io.vertx.kafka.client.consumer.KafkaConsumer<String, CloudEvent> consumer
-      = createConsumer(vertx, broker, trigger);
+      = createConsumer(vertx, broker, trigger, credentials);
 
     final io.vertx.kafka.client.producer.KafkaProducer<String, CloudEvent> producer
-      = createProducer(vertx, broker, trigger);
+      = createProducer(vertx, broker, trigger, credentials);
 
     final CircuitBreakerOptions circuitBreakerOptions
       = createCircuitBreakerOptions(vertx, broker, trigger);
 
-    final var triggerDestinationSender = createSender(trigger.destination(), circuitBreakerOptions);
+    final var egressConfig = trigger.egressConfig();
 
-    final ConsumerRecordSender<String, CloudEvent, HttpResponse<Buffer>> brokerDLQSender =
-      (broker.deadLetterSink() == null || broker.deadLetterSink().isEmpty())
+    final var triggerDestinationSender = withRetries(
+      broker,
+      egressConfig,
+      createSender(
+        trigger.destination(),
+        egressConfig == null ? 0 : egressConfig.getTimeout(),
+        circuitBreakerOptions
+      )
+    );
+
+    final var deadLetterSink = isEmpty(trigger.deadLetterSink())
+      ? broker.deadLetterSink()
+      : trigger.deadLetterSink();
+
+    final ConsumerRecordSender<String, CloudEvent, HttpResponse<Buffer>> dlqSender =
+      isEmpty(deadLetterSink)
         ? NO_DLQ_SENDER
-        : createSender(broker.deadLetterSink(), circuitBreakerOptions);
+        : createSender(deadLetterSink, 0, circuitBreakerOptions);
 
     final var consumerOffsetManager = consumerRecordOffsetStrategyFactory
       .get(consumer, broker, trigger);
@@ -121,12 +166,14 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
       trigger.filter(),
       consumerOffsetManager,
       sinkResponseHandler,
-      brokerDLQSender
+      dlqSender
     );
 
-    return Future.succeededFuture(
-      new ConsumerVerticle<>(consumer, broker.topic(), consumerRecordHandler)
-    );
+    return Future.succeededFuture(new ConsumerVerticle<>(
+      consumer,
+      broker.topic(),
+      withDeliveryOrder(trigger, consumer, consumerRecordHandler)
+    ));
   }
 
   protected CircuitBreakerOptions createCircuitBreakerOptions(
@@ -141,12 +188,16 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
   protected io.vertx.kafka.client.producer.KafkaProducer<String, CloudEvent> createProducer(
     final Vertx vertx,
     final Broker broker,
-    final Trigger<CloudEvent> trigger) {
+    final Trigger<CloudEvent> trigger,
+    final Credentials credentials) {
 
     // TODO check producer configurations to change per instance
     // producerConfigs is a shared object and it acts as a prototype for each consumer instance.
     final var producerConfigs = (Properties) this.producerConfigs.clone();
     producerConfigs.setProperty(ProducerConfig.BOOTSTRAP_SERVERS_CONFIG, broker.bootstrapServers());
+    if (credentials != null) {
+      KafkaClientsAuth.attachCredentials(producerConfigs, credentials);
+    }
 
     final var kafkaProducer = new KafkaProducer<>(
       producerConfigs,
@@ -160,13 +211,17 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
   protected io.vertx.kafka.client.consumer.KafkaConsumer<String, CloudEvent> createConsumer(
     final Vertx vertx,
     final Broker broker,
-    final Trigger<CloudEvent> trigger) {
+    final Trigger<CloudEvent> trigger,
+    final Credentials credentials) {
 
     // TODO check consumer configurations to change per instance
     // consumerConfigs is a shared object and it acts as a prototype for each consumer instance.
     final var consumerConfigs = (Properties) this.consumerConfigs.clone();
     consumerConfigs.setProperty(GROUP_ID_CONFIG, trigger.id());
     consumerConfigs.setProperty(ConsumerConfig.BOOTSTRAP_SERVERS_CONFIG, broker.bootstrapServers());
+    if (credentials != null) {
+      KafkaClientsAuth.attachCredentials(consumerConfigs, credentials);
+    }
 
     // Note: KafkaConsumer instances are not thread-safe.
     // There are methods thread-safe, but in general they're not.
@@ -179,13 +234,66 @@ public class HttpConsumerVerticleFactory implements ConsumerVerticleFactory<Clou
     return io.vertx.kafka.client.consumer.KafkaConsumer.create(vertx, kafkaConsumer);
   }
 
+  // the egress config of the trigger, when set, overrides the egress config of the broker.
+  private ConsumerRecordSender<String, CloudEvent, HttpResponse<Buffer>> withRetries(
+    final Broker broker,
+    final EgressConfig egressConfig,
+    final ConsumerRecordSender<String, CloudEvent, HttpResponse<Buffer>> sender) {
+
+    if (egressConfig != null) {
+      return withRetries(
+        egressConfig.getRetry(),
+        egressConfig.getBackoffPolicy(),
+        egressConfig.getBackoffDelay(),
+        sender
+      );
+    }
+    return withRetries(broker.retry(), broker.backoffPolicy(), broker.backoffDelay(), sender);
+  }
+
+  private ConsumerRecordSender<String, CloudEvent, HttpResponse<Buffer>> withRetries(
+    final int retry,
+    final BackoffPolicy backoffPolicy,
+    final long backoffDelay,
+    final ConsumerRecordSender<String, CloudEvent, HttpResponse<Buffer>> sender) {
+
+    if (retry <= 0) {
+      return sender;
+    }
+
+    return new RetryConsumerRecordSender<>(
+      vertx,
+      sender,
+      retry,
+      backoffPolicy,
+      backoffDelay
+    );
+  }
+
+  private static Handler<KafkaConsumerRecord<String, CloudEvent>> withDeliveryOrder(
+    final Trigger<CloudEvent> trigger,
+    final io.vertx.kafka.client.consumer.KafkaConsumer<String, CloudEvent> consumer,
+    final ConsumerRecordHandler<String, CloudEvent, HttpResponse<Buffer>> handler) {
+
+    if (trigger.deliveryOrder() == DeliveryOrder.Ordered) {
+      return new OrderedConsumerRecordHandler<>(consumer, handler);
+    }
+    return handler;
+  }
+
   private HttpConsumerRecordSender createSender(
     final String target,
+    final long timeout,
     final CircuitBreakerOptions circuitBreakerOptions) {
 
     return new HttpConsumerRecordSender(
       client,
-      target
+      target,
+      timeout
     );
   }
+
+  private static boolean isEmpty(final String s) {
+    return s == null || s.isEmpty();
+  }
 }
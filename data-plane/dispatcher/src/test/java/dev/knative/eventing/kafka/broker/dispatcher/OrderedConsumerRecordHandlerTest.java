@@ -0,0 +1,148 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.dispatcher;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.ArgumentMatchers.any;
+import static org.mockito.Mockito.mock;
+import static org.mockito.Mockito.never;
+import static org.mockito.Mockito.times;
+import static org.mockito.Mockito.verify;
+
+import io.vertx.core.Future;
+import io.vertx.core.Promise;
+import io.vertx.kafka.client.common.TopicPartition;
+import io.vertx.kafka.client.consumer.KafkaConsumer;
+import io.vertx.kafka.client.consumer.KafkaConsumerRecord;
+import io.vertx.kafka.client.consumer.impl.KafkaConsumerRecordImpl;
+import java.util.ArrayList;
+import java.util.List;
+import org.apache.kafka.clients.consumer.ConsumerRecord;
+import org.junit.jupiter.api.Test;
+
+public class OrderedConsumerRecordHandlerTest {
+
+  private static final String TOPIC = "t1";
+
+  @Test
+  @SuppressWarnings("unchecked")
+  public void shouldDispatchRecordsOfTheSamePartitionInOrder() {
+
+    final KafkaConsumer<Object, Object> consumer = mock(KafkaConsumer.class);
+    final var sent = new ArrayList<KafkaConsumerRecord<Object, Object>>();
+    final var responses = new ArrayList<Promise<Object>>();
+
+    final var handler = new OrderedConsumerRecordHandler<>(
+      consumer,
+      handler(sent, responses)
+    );
+
+    final var r1 = record(0, 1);
+    final var r2 = record(0, 2);
+    final var r3 = record(1, 1);
+
+    handler.handle(r1);
+    handler.handle(r2);
+    handler.handle(r3);
+
+    // r2 waits for r1, since they belong to the same partition.
+    assertThat(sent).containsExactly(r1, r3);
+    verify(consumer, times(1)).pause(new TopicPartition(TOPIC, 0));
+    verify(consumer, never()).pause(new TopicPartition(TOPIC, 1));
+
+    responses.get(0).complete();
+
+    assertThat(sent).containsExactly(r1, r3, r2);
+    verify(consumer, never()).resume(any(TopicPartition.class));
+
+    responses.get(2).complete();
+
+    verify(consumer, times(1)).resume(new TopicPartition(TOPIC, 0));
+  }
+
+  @Test
+  @SuppressWarnings("unchecked")
+  public void shouldDispatchNextRecordWhenPreviousRecordFails() {
+
+    final KafkaConsumer<Object, Object> consumer = mock(KafkaConsumer.class);
+    final var sent = new ArrayList<KafkaConsumerRecord<Object, Object>>();
+    final var responses = new ArrayList<Promise<Object>>();
+
+    final var handler = new OrderedConsumerRecordHandler<>(
+      consumer,
+      handler(sent, responses)
+    );
+
+    final var r1 = record(0, 1);
+    final var r2 = record(0, 2);
+
+    handler.handle(r1);
+    handler.handle(r2);
+
+    // the subscriber and the DLQ fail.
+    responses.get(0).fail("subscriber failed");
+
+    assertThat(sent).containsExactly(r1, r2);
+  }
+
+  @Test
+  @SuppressWarnings("unchecked")
+  public void shouldNotPauseWhenRecordsAreDispatchedImmediately() {
+
+    final KafkaConsumer<Object, Object> consumer = mock(KafkaConsumer.class);
+
+    final var handler = new OrderedConsumerRecordHandler<>(
+      consumer,
+      new ConsumerRecordHandler<>(
+        record -> Future.succeededFuture(),
+        value -> true,
+        mock(ConsumerRecordOffsetStrategy.class),
+        response -> Future.succeededFuture()
+      )
+    );
+
+    handler.handle(record(0, 1));
+    handler.handle(record(0, 2));
+
+    verify(consumer, never()).pause(any(TopicPartition.class));
+    verify(consumer, never()).resume(any(TopicPartition.class));
+  }
+
+  @SuppressWarnings("unchecked")
+  private static ConsumerRecordHandler<Object, Object, Object> handler(
+    final List<KafkaConsumerRecord<Object, Object>> sent,
+    final List<Promise<Object>> responses) {
+
+    return new ConsumerRecordHandler<>(
+      record -> {
+        sent.add(record);
+        final Promise<Object> response = Promise.promise();
+        responses.add(response);
+        return response.future();
+      },
+      value -> true,
+      mock(ConsumerRecordOffsetStrategy.class),
+      response -> Future.succeededFuture()
+    );
+  }
+
+  private static KafkaConsumerRecord<Object, Object> record(final int partition, final long offset) {
+    return new KafkaConsumerRecordImpl<>(
+      new ConsumerRecord<>(TOPIC, partition, offset, "", "")
+    );
+  }
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2020 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dev.knative.eventing.kafka.broker.dispatcher;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy;
+import io.vertx.core.Future;
+import io.vertx.core.Vertx;
+import io.vertx.junit5.VertxExtension;
+import io.vertx.junit5.VertxTestContext;
+import io.vertx.kafka.client.consumer.KafkaConsumerRecord;
+import io.vertx.kafka.client.consumer.impl.KafkaConsumerRecordImpl;
+import java.util.concurrent.atomic.AtomicInteger;
+import org.apache.kafka.clients.consumer.ConsumerRecord;
+import org.junit.jupiter.api.Test;
+import org.junit.jupiter.api.extension.ExtendWith;
+
+@ExtendWith(VertxExtension.class)
+public class RetryConsumerRecordSenderTest {
+
+  @Test
+  public void shouldRetryUntilSuccess(final Vertx vertx, final VertxTestContext context) {
+    final var attempts = new AtomicInteger(0);
+
+    final var sender = new RetryConsumerRecordSender<Object, Object, Object>(
+      vertx,
+      record -> attempts.incrementAndGet() < 3
+        ? Future.failedFuture("failed")
+        : Future.succeededFuture("ok"),
+      5,
+      BackoffPolicy.Exponential,
+      10
+    );
+
+    sender.send(record())
+      .onComplete(context.succeeding(response -> context.verify(() -> {
+        assertThat(response).isEqualTo("ok");
+        assertThat(attempts.get()).isEqualTo(3);
+        context.completeNow();
+      })));
+  }
+
+  @Test
+  public void shouldFailWhenRetriesAreExhausted(final Vertx vertx, final VertxTestContext context) {
+    final var attempts = new AtomicInteger(0);
+
+    final var sender = new RetryConsumerRecordSender<Object, Object, Object>(
+      vertx,
+      record -> {
+        attempts.incrementAndGet();
+        return Future.failedFuture("failed");
+      },
+      2,
+      BackoffPolicy.Linear,
+      10
+    );
+
+    sender.send(record())
+      .onComplete(context.failing(cause -> context.verify(() -> {
+        assertThat(cause).hasMessage("failed");
+        assertThat(attempts.get()).isEqualTo(3);
+        context.completeNow();
+      })));
+  }
+
+  @Test
+  public void shouldNotRetryWithoutRetries(final Vertx vertx, final VertxTestContext context) {
+    final var attempts = new AtomicInteger(0);
+
+    final var sender = new RetryConsumerRecordSender<Object, Object, Object>(
+      vertx,
+      record -> {
+        attempts.incrementAndGet();
+        return Future.failedFuture("failed");
+      },
+      0,
+      BackoffPolicy.Exponential,
+      10
+    );
+
+    sender.send(record())
+      .onComplete(context.failing(cause -> context.verify(() -> {
+        assertThat(attempts.get()).isEqualTo(1);
+        context.completeNow();
+      })));
+  }
+
+  @Test
+  public void exponentialDelay(final Vertx vertx) {
+    final var sender = new RetryConsumerRecordSender<Object, Object, Object>(
+      vertx,
+      record -> Future.succeededFuture(),
+      3,
+      BackoffPolicy.Exponential,
+      100
+    );
+
+    assertThat(sender.delay(0)).isEqualTo(100);
+    assertThat(sender.delay(1)).isEqualTo(200);
+    assertThat(sender.delay(2)).isEqualTo(400);
+  }
+
+  @Test
+  public void linearDelay(final Vertx vertx) {
+    final var sender = new RetryConsumerRecordSender<Object, Object, Object>(
+      vertx,
+      record -> Future.succeededFuture(),
+      3,
+      BackoffPolicy.Linear,
+      100
+    );
+
+    assertThat(sender.delay(0)).isEqualTo(100);
+    assertThat(sender.delay(2)).isEqualTo(100);
+  }
+
+  private static KafkaConsumerRecord<Object, Object> record() {
+    return new KafkaConsumerRecordImpl<>(new ConsumerRecord<>("", 0, 0L, "", ""));
+  }
+}
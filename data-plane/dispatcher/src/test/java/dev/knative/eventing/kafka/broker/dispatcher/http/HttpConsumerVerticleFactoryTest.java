@@ -28,6 +28,10 @@ import dev.knative.eventing.kafka.broker.core.Broker;
 import dev.knative.eventing.kafka.broker.core.EventMatcher;
 import dev.knative.eventing.kafka.broker.core.Filter;
 import dev.knative.eventing.kafka.broker.core.Trigger;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
 import dev.knative.eventing.kafka.broker.dispatcher.ConsumerRecordOffsetStrategyFactory;
 import io.cloudevents.CloudEvent;
 import io.cloudevents.kafka.CloudEventDeserializer;
@@ -66,7 +70,8 @@ public class HttpConsumerVerticleFactoryTest {
       consumerProperties,
       WebClient.create(vertx),
       vertx,
-      producerConfigs
+      producerConfigs,
+      AuthProvider.noAuth()
     );
 
     final var consumerFactoryFuture = verticleFactory.get(
@@ -95,6 +100,36 @@ public class HttpConsumerVerticleFactoryTest {
         public String path() {
           return null;
         }
+
+        @Override
+        public String authSecretNamespace() {
+          return "";
+        }
+
+        @Override
+        public String authSecretName() {
+          return "";
+        }
+
+        @Override
+        public String authSecretVersion() {
+          return "";
+        }
+
+        @Override
+        public int retry() {
+          return 0;
+        }
+
+        @Override
+        public BackoffPolicy backoffPolicy() {
+          return BackoffPolicy.Exponential;
+        }
+
+        @Override
+        public long backoffDelay() {
+          return 0;
+        }
       },
       new Trigger<>() {
         @Override
@@ -111,6 +146,21 @@ public class HttpConsumerVerticleFactoryTest {
         public String destination() {
           return "http://localhost:43256";
         }
+
+        @Override
+        public String deadLetterSink() {
+          return "";
+        }
+
+        @Override
+        public EgressConfig egressConfig() {
+          return null;
+        }
+
+        @Override
+        public DeliveryOrder deliveryOrder() {
+          return DeliveryOrder.Unordered;
+        }
       }
     );
 
@@ -138,7 +188,8 @@ public class HttpConsumerVerticleFactoryTest {
       consumerProperties,
       WebClient.create(vertx),
       vertx,
-      producerConfigs
+      producerConfigs,
+      AuthProvider.noAuth()
     );
 
     assertDoesNotThrow(() -> {
@@ -168,6 +219,36 @@ public class HttpConsumerVerticleFactoryTest {
           public String path() {
             return null;
           }
+
+          @Override
+          public String authSecretNamespace() {
+            return "";
+          }
+
+          @Override
+          public String authSecretName() {
+            return "";
+          }
+
+          @Override
+          public String authSecretVersion() {
+            return "";
+          }
+
+          @Override
+          public int retry() {
+            return 0;
+          }
+
+          @Override
+          public BackoffPolicy backoffPolicy() {
+            return BackoffPolicy.Exponential;
+          }
+
+          @Override
+          public long backoffDelay() {
+            return 0;
+          }
         },
         new Trigger<>() {
           @Override
@@ -184,6 +265,21 @@ public class HttpConsumerVerticleFactoryTest {
           public String destination() {
             return "http://localhost:43256";
           }
+
+          @Override
+          public String deadLetterSink() {
+            return "";
+          }
+
+          @Override
+          public EgressConfig egressConfig() {
+            return null;
+          }
+
+          @Override
+          public DeliveryOrder deliveryOrder() {
+            return DeliveryOrder.Unordered;
+          }
         });
     });
   }
@@ -14,6 +14,416 @@ public final class BrokersConfig {
     registerAllExtensions(
         (com.google.protobuf.ExtensionRegistryLite) registry);
   }
+  /**
+   * Protobuf enum {@code BackoffPolicy}
+   */
+  public enum BackoffPolicy
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <pre>
+     * delay is backoffDelay*2^&lt;numberOfRetries&gt;.
+     * </pre>
+     *
+     * <code>Exponential = 0;</code>
+     */
+    Exponential(0),
+    /**
+     * <pre>
+     * delay is backoffDelay between every retry.
+     * </pre>
+     *
+     * <code>Linear = 1;</code>
+     */
+    Linear(1),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <pre>
+     * delay is backoffDelay*2^&lt;numberOfRetries&gt;.
+     * </pre>
+     *
+     * <code>Exponential = 0;</code>
+     */
+    public static final int Exponential_VALUE = 0;
+    /**
+     * <pre>
+     * delay is backoffDelay between every retry.
+     * </pre>
+     *
+     * <code>Linear = 1;</code>
+     */
+    public static final int Linear_VALUE = 1;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static BackoffPolicy valueOf(int value) {
+      return forNumber(value);
+    }
+
+    public static BackoffPolicy forNumber(int value) {
+      switch (value) {
+        case 0: return Exponential;
+        case 1: return Linear;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<BackoffPolicy>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        BackoffPolicy> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<BackoffPolicy>() {
+            public BackoffPolicy findValueByNumber(int number) {
+              return BackoffPolicy.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.getDescriptor().getEnumTypes().get(0);
+    }
+
+    private static final BackoffPolicy[] VALUES = values();
+
+    public static BackoffPolicy valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private BackoffPolicy(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:BackoffPolicy)
+  }
+
+  /**
+   * Protobuf enum {@code DeliveryOrder}
+   */
+  public enum DeliveryOrder
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <pre>
+     * events are sent concurrently, without waiting for the destination to handle previous events.
+     * </pre>
+     *
+     * <code>Unordered = 0;</code>
+     */
+    Unordered(0),
+    /**
+     * <pre>
+     * events of the same partition are sent one at a time, in the order they were appended to the partition.
+     * </pre>
+     *
+     * <code>Ordered = 1;</code>
+     */
+    Ordered(1),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <pre>
+     * events are sent concurrently, without waiting for the destination to handle previous events.
+     * </pre>
+     *
+     * <code>Unordered = 0;</code>
+     */
+    public static final int Unordered_VALUE = 0;
+    /**
+     * <pre>
+     * events of the same partition are sent one at a time, in the order they were appended to the partition.
+     * </pre>
+     *
+     * <code>Ordered = 1;</code>
+     */
+    public static final int Ordered_VALUE = 1;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static DeliveryOrder valueOf(int value) {
+      return forNumber(value);
+    }
+
+    public static DeliveryOrder forNumber(int value) {
+      switch (value) {
+        case 0: return Unordered;
+        case 1: return Ordered;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<DeliveryOrder>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        DeliveryOrder> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<DeliveryOrder>() {
+            public DeliveryOrder findValueByNumber(int number) {
+              return DeliveryOrder.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.getDescriptor().getEnumTypes().get(1);
+    }
+
+    private static final DeliveryOrder[] VALUES = values();
+
+    public static DeliveryOrder valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private DeliveryOrder(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:DeliveryOrder)
+  }
+
+  /**
+   * Protobuf enum {@code FilterDialect}
+   */
+  public enum FilterDialect
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <pre>
+     * attributes values are equal to the event context attributes values.
+     * </pre>
+     *
+     * <code>Exact = 0;</code>
+     */
+    Exact(0),
+    /**
+     * <pre>
+     * attributes values are prefixes of the event context attributes values.
+     * </pre>
+     *
+     * <code>Prefix = 1;</code>
+     */
+    Prefix(1),
+    /**
+     * <pre>
+     * attributes values are suffixes of the event context attributes values.
+     * </pre>
+     *
+     * <code>Suffix = 2;</code>
+     */
+    Suffix(2),
+    /**
+     * <pre>
+     * the event matches all the nested filters.
+     * </pre>
+     *
+     * <code>All = 3;</code>
+     */
+    All(3),
+    /**
+     * <pre>
+     * the event matches at least one of the nested filters.
+     * </pre>
+     *
+     * <code>Any = 4;</code>
+     */
+    Any(4),
+    /**
+     * <pre>
+     * the event doesn't match the nested filter.
+     * </pre>
+     *
+     * <code>Not = 5;</code>
+     */
+    Not(5),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <pre>
+     * attributes values are equal to the event context attributes values.
+     * </pre>
+     *
+     * <code>Exact = 0;</code>
+     */
+    public static final int Exact_VALUE = 0;
+    /**
+     * <pre>
+     * attributes values are prefixes of the event context attributes values.
+     * </pre>
+     *
+     * <code>Prefix = 1;</code>
+     */
+    public static final int Prefix_VALUE = 1;
+    /**
+     * <pre>
+     * attributes values are suffixes of the event context attributes values.
+     * </pre>
+     *
+     * <code>Suffix = 2;</code>
+     */
+    public static final int Suffix_VALUE = 2;
+    /**
+     * <pre>
+     * the event matches all the nested filters.
+     * </pre>
+     *
+     * <code>All = 3;</code>
+     */
+    public static final int All_VALUE = 3;
+    /**
+     * <pre>
+     * the event matches at least one of the nested filters.
+     * </pre>
+     *
+     * <code>Any = 4;</code>
+     */
+    public static final int Any_VALUE = 4;
+    /**
+     * <pre>
+     * the event doesn't match the nested filter.
+     * </pre>
+     *
+     * <code>Not = 5;</code>
+     */
+    public static final int Not_VALUE = 5;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static FilterDialect valueOf(int value) {
+      return forNumber(value);
+    }
+
+    public static FilterDialect forNumber(int value) {
+      switch (value) {
+        case 0: return Exact;
+        case 1: return Prefix;
+        case 2: return Suffix;
+        case 3: return All;
+        case 4: return Any;
+        case 5: return Not;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<FilterDialect>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        FilterDialect> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<FilterDialect>() {
+            public FilterDialect findValueByNumber(int number) {
+              return FilterDialect.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.getDescriptor().getEnumTypes().get(2);
+    }
+
+    private static final FilterDialect[] VALUES = values();
+
+    public static FilterDialect valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private FilterDialect(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:FilterDialect)
+  }
+
   public interface TriggerOrBuilder extends
       // @@protoc_insertion_point(interface_extends:Trigger)
       com.google.protobuf.MessageOrBuilder {
@@ -127,57 +537,181 @@ public final class BrokersConfig {
      */
     com.google.protobuf.ByteString
         getIdBytes();
-  }
-  /**
-   * Protobuf type {@code Trigger}
-   */
-  public  static final class Trigger extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:Trigger)
-      TriggerOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use Trigger.newBuilder() to construct.
-    private Trigger(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private Trigger() {
-      destination_ = "";
-      id_ = "";
-    }
 
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new Trigger();
-    }
+    /**
+     * <pre>
+     * paused triggers aren't consumed, dispatchers leave the consumer group of paused triggers.
+     * </pre>
+     *
+     * <code>bool paused = 4;</code>
+     */
+    boolean getPaused();
 
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private Trigger(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      int mutable_bitField0_ = 0;
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            case 10: {
-              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+    /**
+     * <pre>
+     * dead letter sink URI, when empty the dead letter sink of the broker is used.
+     * </pre>
+     *
+     * <code>string deadLetterSink = 5;</code>
+     */
+    java.lang.String getDeadLetterSink();
+    /**
+     * <pre>
+     * dead letter sink URI, when empty the dead letter sink of the broker is used.
+     * </pre>
+     *
+     * <code>string deadLetterSink = 5;</code>
+     */
+    com.google.protobuf.ByteString
+        getDeadLetterSinkBytes();
+
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to the trigger destination.
+     * When not set, the egress config of the broker is used.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 6;</code>
+     */
+    boolean hasEgressConfig();
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to the trigger destination.
+     * When not set, the egress config of the broker is used.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 6;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getEgressConfig();
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to the trigger destination.
+     * When not set, the egress config of the broker is used.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 6;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder getEgressConfigOrBuilder();
+
+    /**
+     * <pre>
+     * order in which events are sent to the trigger destination.
+     * </pre>
+     *
+     * <code>.DeliveryOrder deliveryOrder = 7;</code>
+     */
+    int getDeliveryOrderValue();
+    /**
+     * <pre>
+     * order in which events are sent to the trigger destination.
+     * </pre>
+     *
+     * <code>.DeliveryOrder deliveryOrder = 7;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder getDeliveryOrder();
+
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> 
+        getFiltersList();
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getFilters(int index);
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    int getFiltersCount();
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+        getFiltersOrBuilderList();
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder getFiltersOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code Trigger}
+   */
+  public  static final class Trigger extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:Trigger)
+      TriggerOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use Trigger.newBuilder() to construct.
+    private Trigger(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private Trigger() {
+      destination_ = "";
+      id_ = "";
+      deadLetterSink_ = "";
+      deliveryOrder_ = 0;
+      filters_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new Trigger();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private Trigger(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
                 attributes_ = com.google.protobuf.MapField.newMapField(
                     AttributesDefaultEntryHolder.defaultEntry);
                 mutable_bitField0_ |= 0x00000001;
@@ -201,6 +735,45 @@ public final class BrokersConfig {
               id_ = s;
               break;
             }
+            case 32: {
+
+              paused_ = input.readBool();
+              break;
+            }
+            case 42: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              deadLetterSink_ = s;
+              break;
+            }
+            case 50: {
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder subBuilder = null;
+              if (egressConfig_ != null) {
+                subBuilder = egressConfig_.toBuilder();
+              }
+              egressConfig_ = input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(egressConfig_);
+                egressConfig_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 56: {
+              int rawValue = input.readEnum();
+
+              deliveryOrder_ = rawValue;
+              break;
+            }
+            case 66: {
+              if (!((mutable_bitField0_ & 0x00000002) != 0)) {
+                filters_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter>();
+                mutable_bitField0_ |= 0x00000002;
+              }
+              filters_.add(
+                  input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.parser(), extensionRegistry));
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -216,6 +789,9 @@ public final class BrokersConfig {
         throw new com.google.protobuf.InvalidProtocolBufferException(
             e).setUnfinishedMessage(this);
       } finally {
+        if (((mutable_bitField0_ & 0x00000002) != 0)) {
+          filters_ = java.util.Collections.unmodifiableList(filters_);
+        }
         this.unknownFields = unknownFields.build();
         makeExtensionsImmutable();
       }
@@ -437,6 +1013,182 @@ public final class BrokersConfig {
       }
     }
 
+    public static final int PAUSED_FIELD_NUMBER = 4;
+    private boolean paused_;
+    /**
+     * <pre>
+     * paused triggers aren't consumed, dispatchers leave the consumer group of paused triggers.
+     * </pre>
+     *
+     * <code>bool paused = 4;</code>
+     */
+    public boolean getPaused() {
+      return paused_;
+    }
+
+    public static final int DEADLETTERSINK_FIELD_NUMBER = 5;
+    private volatile java.lang.Object deadLetterSink_;
+    /**
+     * <pre>
+     * dead letter sink URI, when empty the dead letter sink of the broker is used.
+     * </pre>
+     *
+     * <code>string deadLetterSink = 5;</code>
+     */
+    public java.lang.String getDeadLetterSink() {
+      java.lang.Object ref = deadLetterSink_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        deadLetterSink_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * dead letter sink URI, when empty the dead letter sink of the broker is used.
+     * </pre>
+     *
+     * <code>string deadLetterSink = 5;</code>
+     */
+    public com.google.protobuf.ByteString
+        getDeadLetterSinkBytes() {
+      java.lang.Object ref = deadLetterSink_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        deadLetterSink_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int EGRESSCONFIG_FIELD_NUMBER = 6;
+    private dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig egressConfig_;
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to the trigger destination.
+     * When not set, the egress config of the broker is used.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 6;</code>
+     */
+    public boolean hasEgressConfig() {
+      return egressConfig_ != null;
+    }
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to the trigger destination.
+     * When not set, the egress config of the broker is used.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 6;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getEgressConfig() {
+      return egressConfig_ == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance() : egressConfig_;
+    }
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to the trigger destination.
+     * When not set, the egress config of the broker is used.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 6;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder getEgressConfigOrBuilder() {
+      return getEgressConfig();
+    }
+
+    public static final int DELIVERYORDER_FIELD_NUMBER = 7;
+    private int deliveryOrder_;
+    /**
+     * <pre>
+     * order in which events are sent to the trigger destination.
+     * </pre>
+     *
+     * <code>.DeliveryOrder deliveryOrder = 7;</code>
+     */
+    public int getDeliveryOrderValue() {
+      return deliveryOrder_;
+    }
+    /**
+     * <pre>
+     * order in which events are sent to the trigger destination.
+     * </pre>
+     *
+     * <code>.DeliveryOrder deliveryOrder = 7;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder getDeliveryOrder() {
+      @SuppressWarnings("deprecation")
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder result = dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder.valueOf(deliveryOrder_);
+      return result == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder.UNRECOGNIZED : result;
+    }
+
+    public static final int FILTERS_FIELD_NUMBER = 8;
+    private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> filters_;
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> getFiltersList() {
+      return filters_;
+    }
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+        getFiltersOrBuilderList() {
+      return filters_;
+    }
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    public int getFiltersCount() {
+      return filters_.size();
+    }
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getFilters(int index) {
+      return filters_.get(index);
+    }
+    /**
+     * <pre>
+     * filters events by matching filter expressions on event context attributes.
+     * An event passes the filter if it matches every expression and the attributes filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 8;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder getFiltersOrBuilder(
+        int index) {
+      return filters_.get(index);
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -463,6 +1215,21 @@ public final class BrokersConfig {
       if (!getIdBytes().isEmpty()) {
         com.google.protobuf.GeneratedMessageV3.writeString(output, 3, id_);
       }
+      if (paused_ != false) {
+        output.writeBool(4, paused_);
+      }
+      if (!getDeadLetterSinkBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 5, deadLetterSink_);
+      }
+      if (egressConfig_ != null) {
+        output.writeMessage(6, getEgressConfig());
+      }
+      if (deliveryOrder_ != dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder.Unordered.getNumber()) {
+        output.writeEnum(7, deliveryOrder_);
+      }
+      for (int i = 0; i < filters_.size(); i++) {
+        output.writeMessage(8, filters_.get(i));
+      }
       unknownFields.writeTo(output);
     }
 
@@ -488,7 +1255,26 @@ public final class BrokersConfig {
       if (!getIdBytes().isEmpty()) {
         size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, id_);
       }
-      size += unknownFields.getSerializedSize();
+      if (paused_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(4, paused_);
+      }
+      if (!getDeadLetterSinkBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(5, deadLetterSink_);
+      }
+      if (egressConfig_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(6, getEgressConfig());
+      }
+      if (deliveryOrder_ != dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder.Unordered.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(7, deliveryOrder_);
+      }
+      for (int i = 0; i < filters_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(8, filters_.get(i));
+      }
+      size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
     }
@@ -509,6 +1295,18 @@ public final class BrokersConfig {
           .equals(other.getDestination())) return false;
       if (!getId()
           .equals(other.getId())) return false;
+      if (getPaused()
+          != other.getPaused()) return false;
+      if (!getDeadLetterSink()
+          .equals(other.getDeadLetterSink())) return false;
+      if (hasEgressConfig() != other.hasEgressConfig()) return false;
+      if (hasEgressConfig()) {
+        if (!getEgressConfig()
+            .equals(other.getEgressConfig())) return false;
+      }
+      if (deliveryOrder_ != other.deliveryOrder_) return false;
+      if (!getFiltersList()
+          .equals(other.getFiltersList())) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -528,6 +1326,21 @@ public final class BrokersConfig {
       hash = (53 * hash) + getDestination().hashCode();
       hash = (37 * hash) + ID_FIELD_NUMBER;
       hash = (53 * hash) + getId().hashCode();
+      hash = (37 * hash) + PAUSED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getPaused());
+      hash = (37 * hash) + DEADLETTERSINK_FIELD_NUMBER;
+      hash = (53 * hash) + getDeadLetterSink().hashCode();
+      if (hasEgressConfig()) {
+        hash = (37 * hash) + EGRESSCONFIG_FIELD_NUMBER;
+        hash = (53 * hash) + getEgressConfig().hashCode();
+      }
+      hash = (37 * hash) + DELIVERYORDER_FIELD_NUMBER;
+      hash = (53 * hash) + deliveryOrder_;
+      if (getFiltersCount() > 0) {
+        hash = (37 * hash) + FILTERS_FIELD_NUMBER;
+        hash = (53 * hash) + getFiltersList().hashCode();
+      }
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -678,6 +1491,7 @@ public final class BrokersConfig {
       private void maybeForceBuilderInitialization() {
         if (com.google.protobuf.GeneratedMessageV3
                 .alwaysUseFieldBuilders) {
+          getFiltersFieldBuilder();
         }
       }
       @java.lang.Override
@@ -688,6 +1502,24 @@ public final class BrokersConfig {
 
         id_ = "";
 
+        paused_ = false;
+
+        deadLetterSink_ = "";
+
+        if (egressConfigBuilder_ == null) {
+          egressConfig_ = null;
+        } else {
+          egressConfig_ = null;
+          egressConfigBuilder_ = null;
+        }
+        deliveryOrder_ = 0;
+
+        if (filtersBuilder_ == null) {
+          filters_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000002);
+        } else {
+          filtersBuilder_.clear();
+        }
         return this;
       }
 
@@ -719,6 +1551,23 @@ public final class BrokersConfig {
         result.attributes_.makeImmutable();
         result.destination_ = destination_;
         result.id_ = id_;
+        result.paused_ = paused_;
+        result.deadLetterSink_ = deadLetterSink_;
+        if (egressConfigBuilder_ == null) {
+          result.egressConfig_ = egressConfig_;
+        } else {
+          result.egressConfig_ = egressConfigBuilder_.build();
+        }
+        result.deliveryOrder_ = deliveryOrder_;
+        if (filtersBuilder_ == null) {
+          if (((bitField0_ & 0x00000002) != 0)) {
+            filters_ = java.util.Collections.unmodifiableList(filters_);
+            bitField0_ = (bitField0_ & ~0x00000002);
+          }
+          result.filters_ = filters_;
+        } else {
+          result.filters_ = filtersBuilder_.build();
+        }
         onBuilt();
         return result;
       }
@@ -777,6 +1626,45 @@ public final class BrokersConfig {
           id_ = other.id_;
           onChanged();
         }
+        if (other.getPaused() != false) {
+          setPaused(other.getPaused());
+        }
+        if (!other.getDeadLetterSink().isEmpty()) {
+          deadLetterSink_ = other.deadLetterSink_;
+          onChanged();
+        }
+        if (other.hasEgressConfig()) {
+          mergeEgressConfig(other.getEgressConfig());
+        }
+        if (other.deliveryOrder_ != 0) {
+          setDeliveryOrderValue(other.getDeliveryOrderValue());
+        }
+        if (filtersBuilder_ == null) {
+          if (!other.filters_.isEmpty()) {
+            if (filters_.isEmpty()) {
+              filters_ = other.filters_;
+              bitField0_ = (bitField0_ & ~0x00000002);
+            } else {
+              ensureFiltersIsMutable();
+              filters_.addAll(other.filters_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.filters_.isEmpty()) {
+            if (filtersBuilder_.isEmpty()) {
+              filtersBuilder_.dispose();
+              filtersBuilder_ = null;
+              filters_ = other.filters_;
+              bitField0_ = (bitField0_ & ~0x00000002);
+              filtersBuilder_ = 
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getFiltersFieldBuilder() : null;
+            } else {
+              filtersBuilder_.addAllMessages(other.filters_);
+            }
+          }
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -1163,215 +2051,3987 @@ public final class BrokersConfig {
         onChanged();
         return this;
       }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
 
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
+      private boolean paused_ ;
+      /**
+       * <pre>
+       * paused triggers aren't consumed, dispatchers leave the consumer group of paused triggers.
+       * </pre>
+       *
+       * <code>bool paused = 4;</code>
+       */
+      public boolean getPaused() {
+        return paused_;
       }
-
-
-      // @@protoc_insertion_point(builder_scope:Trigger)
-    }
-
-    // @@protoc_insertion_point(class_scope:Trigger)
-    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger();
-    }
-
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<Trigger>
-        PARSER = new com.google.protobuf.AbstractParser<Trigger>() {
-      @java.lang.Override
-      public Trigger parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new Trigger(input, extensionRegistry);
+      /**
+       * <pre>
+       * paused triggers aren't consumed, dispatchers leave the consumer group of paused triggers.
+       * </pre>
+       *
+       * <code>bool paused = 4;</code>
+       */
+      public Builder setPaused(boolean value) {
+        
+        paused_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * paused triggers aren't consumed, dispatchers leave the consumer group of paused triggers.
+       * </pre>
+       *
+       * <code>bool paused = 4;</code>
+       */
+      public Builder clearPaused() {
+        
+        paused_ = false;
+        onChanged();
+        return this;
       }
-    };
 
-    public static com.google.protobuf.Parser<Trigger> parser() {
-      return PARSER;
-    }
+      private java.lang.Object deadLetterSink_ = "";
+      /**
+       * <pre>
+       * dead letter sink URI, when empty the dead letter sink of the broker is used.
+       * </pre>
+       *
+       * <code>string deadLetterSink = 5;</code>
+       */
+      public java.lang.String getDeadLetterSink() {
+        java.lang.Object ref = deadLetterSink_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          deadLetterSink_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * dead letter sink URI, when empty the dead letter sink of the broker is used.
+       * </pre>
+       *
+       * <code>string deadLetterSink = 5;</code>
+       */
+      public com.google.protobuf.ByteString
+          getDeadLetterSinkBytes() {
+        java.lang.Object ref = deadLetterSink_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          deadLetterSink_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * dead letter sink URI, when empty the dead letter sink of the broker is used.
+       * </pre>
+       *
+       * <code>string deadLetterSink = 5;</code>
+       */
+      public Builder setDeadLetterSink(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        deadLetterSink_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * dead letter sink URI, when empty the dead letter sink of the broker is used.
+       * </pre>
+       *
+       * <code>string deadLetterSink = 5;</code>
+       */
+      public Builder clearDeadLetterSink() {
+        
+        deadLetterSink_ = getDefaultInstance().getDeadLetterSink();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * dead letter sink URI, when empty the dead letter sink of the broker is used.
+       * </pre>
+       *
+       * <code>string deadLetterSink = 5;</code>
+       */
+      public Builder setDeadLetterSinkBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        deadLetterSink_ = value;
+        onChanged();
+        return this;
+      }
 
-    @java.lang.Override
-    public com.google.protobuf.Parser<Trigger> getParserForType() {
-      return PARSER;
-    }
+      private dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig egressConfig_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder> egressConfigBuilder_;
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public boolean hasEgressConfig() {
+        return egressConfigBuilder_ != null || egressConfig_ != null;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getEgressConfig() {
+        if (egressConfigBuilder_ == null) {
+          return egressConfig_ == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance() : egressConfig_;
+        } else {
+          return egressConfigBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public Builder setEgressConfig(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig value) {
+        if (egressConfigBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          egressConfig_ = value;
+          onChanged();
+        } else {
+          egressConfigBuilder_.setMessage(value);
+        }
 
-    @java.lang.Override
-    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public Builder setEgressConfig(
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder builderForValue) {
+        if (egressConfigBuilder_ == null) {
+          egressConfig_ = builderForValue.build();
+          onChanged();
+        } else {
+          egressConfigBuilder_.setMessage(builderForValue.build());
+        }
 
-  }
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public Builder mergeEgressConfig(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig value) {
+        if (egressConfigBuilder_ == null) {
+          if (egressConfig_ != null) {
+            egressConfig_ =
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.newBuilder(egressConfig_).mergeFrom(value).buildPartial();
+          } else {
+            egressConfig_ = value;
+          }
+          onChanged();
+        } else {
+          egressConfigBuilder_.mergeFrom(value);
+        }
 
-  public interface BrokerOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:Broker)
-      com.google.protobuf.MessageOrBuilder {
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public Builder clearEgressConfig() {
+        if (egressConfigBuilder_ == null) {
+          egressConfig_ = null;
+          onChanged();
+        } else {
+          egressConfig_ = null;
+          egressConfigBuilder_ = null;
+        }
 
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder getEgressConfigBuilder() {
+        
+        onChanged();
+        return getEgressConfigFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder getEgressConfigOrBuilder() {
+        if (egressConfigBuilder_ != null) {
+          return egressConfigBuilder_.getMessageOrBuilder();
+        } else {
+          return egressConfig_ == null ?
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance() : egressConfig_;
+        }
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to the trigger destination.
+       * When not set, the egress config of the broker is used.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 6;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder> 
+          getEgressConfigFieldBuilder() {
+        if (egressConfigBuilder_ == null) {
+          egressConfigBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder>(
+                  getEgressConfig(),
+                  getParentForChildren(),
+                  isClean());
+          egressConfig_ = null;
+        }
+        return egressConfigBuilder_;
+      }
+
+      private int deliveryOrder_ = 0;
+      /**
+       * <pre>
+       * order in which events are sent to the trigger destination.
+       * </pre>
+       *
+       * <code>.DeliveryOrder deliveryOrder = 7;</code>
+       */
+      public int getDeliveryOrderValue() {
+        return deliveryOrder_;
+      }
+      /**
+       * <pre>
+       * order in which events are sent to the trigger destination.
+       * </pre>
+       *
+       * <code>.DeliveryOrder deliveryOrder = 7;</code>
+       */
+      public Builder setDeliveryOrderValue(int value) {
+        deliveryOrder_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * order in which events are sent to the trigger destination.
+       * </pre>
+       *
+       * <code>.DeliveryOrder deliveryOrder = 7;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder getDeliveryOrder() {
+        @SuppressWarnings("deprecation")
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder result = dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder.valueOf(deliveryOrder_);
+        return result == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder.UNRECOGNIZED : result;
+      }
+      /**
+       * <pre>
+       * order in which events are sent to the trigger destination.
+       * </pre>
+       *
+       * <code>.DeliveryOrder deliveryOrder = 7;</code>
+       */
+      public Builder setDeliveryOrder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.DeliveryOrder value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        
+        deliveryOrder_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * order in which events are sent to the trigger destination.
+       * </pre>
+       *
+       * <code>.DeliveryOrder deliveryOrder = 7;</code>
+       */
+      public Builder clearDeliveryOrder() {
+        
+        deliveryOrder_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> filters_ =
+        java.util.Collections.emptyList();
+      private void ensureFiltersIsMutable() {
+        if (!((bitField0_ & 0x00000002) != 0)) {
+          filters_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter>(filters_);
+          bitField0_ |= 0x00000002;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> filtersBuilder_;
+
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> getFiltersList() {
+        if (filtersBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(filters_);
+        } else {
+          return filtersBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public int getFiltersCount() {
+        if (filtersBuilder_ == null) {
+          return filters_.size();
+        } else {
+          return filtersBuilder_.getCount();
+        }
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getFilters(int index) {
+        if (filtersBuilder_ == null) {
+          return filters_.get(index);
+        } else {
+          return filtersBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder setFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter value) {
+        if (filtersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureFiltersIsMutable();
+          filters_.set(index, value);
+          onChanged();
+        } else {
+          filtersBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder setFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder builderForValue) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          filtersBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder addFilters(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter value) {
+        if (filtersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureFiltersIsMutable();
+          filters_.add(value);
+          onChanged();
+        } else {
+          filtersBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder addFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter value) {
+        if (filtersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureFiltersIsMutable();
+          filters_.add(index, value);
+          onChanged();
+        } else {
+          filtersBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder addFilters(
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder builderForValue) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.add(builderForValue.build());
+          onChanged();
+        } else {
+          filtersBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder addFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder builderForValue) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          filtersBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder addAllFilters(
+          java.lang.Iterable<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> values) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, filters_);
+          onChanged();
+        } else {
+          filtersBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder clearFilters() {
+        if (filtersBuilder_ == null) {
+          filters_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000002);
+          onChanged();
+        } else {
+          filtersBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public Builder removeFilters(int index) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.remove(index);
+          onChanged();
+        } else {
+          filtersBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder getFiltersBuilder(
+          int index) {
+        return getFiltersFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder getFiltersOrBuilder(
+          int index) {
+        if (filtersBuilder_ == null) {
+          return filters_.get(index);  } else {
+          return filtersBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+           getFiltersOrBuilderList() {
+        if (filtersBuilder_ != null) {
+          return filtersBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(filters_);
+        }
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder addFiltersBuilder() {
+        return getFiltersFieldBuilder().addBuilder(
+            dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder addFiltersBuilder(
+          int index) {
+        return getFiltersFieldBuilder().addBuilder(
+            index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * filters events by matching filter expressions on event context attributes.
+       * An event passes the filter if it matches every expression and the attributes filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 8;</code>
+       */
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder> 
+           getFiltersBuilderList() {
+        return getFiltersFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+          getFiltersFieldBuilder() {
+        if (filtersBuilder_ == null) {
+          filtersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder>(
+                  filters_,
+                  ((bitField0_ & 0x00000002) != 0),
+                  getParentForChildren(),
+                  isClean());
+          filters_ = null;
+        }
+        return filtersBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:Trigger)
+    }
+
+    // @@protoc_insertion_point(class_scope:Trigger)
+    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger();
+    }
+
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<Trigger>
+        PARSER = new com.google.protobuf.AbstractParser<Trigger>() {
+      @java.lang.Override
+      public Trigger parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new Trigger(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<Trigger> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<Trigger> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface BrokerOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:Broker)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string id = 1;</code>
+     */
+    java.lang.String getId();
+    /**
+     * <code>string id = 1;</code>
+     */
+    com.google.protobuf.ByteString
+        getIdBytes();
+
+    /**
+     * <pre>
+     * the Kafka topic to consume.
+     * </pre>
+     *
+     * <code>string topic = 2;</code>
+     */
+    java.lang.String getTopic();
+    /**
+     * <pre>
+     * the Kafka topic to consume.
+     * </pre>
+     *
+     * <code>string topic = 2;</code>
+     */
+    com.google.protobuf.ByteString
+        getTopicBytes();
+
+    /**
+     * <pre>
+     * dead letter sink URI
+     * </pre>
+     *
+     * <code>string deadLetterSink = 3;</code>
+     */
+    java.lang.String getDeadLetterSink();
+    /**
+     * <pre>
+     * dead letter sink URI
+     * </pre>
+     *
+     * <code>string deadLetterSink = 3;</code>
+     */
+    com.google.protobuf.ByteString
+        getDeadLetterSinkBytes();
+
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> 
+        getTriggersList();
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getTriggers(int index);
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    int getTriggersCount();
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
+        getTriggersOrBuilderList();
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder getTriggersOrBuilder(
+        int index);
+
+    /**
+     * <pre>
+     * path to listen for incoming events.
+     * </pre>
+     *
+     * <code>string path = 5;</code>
+     */
+    java.lang.String getPath();
+    /**
+     * <pre>
+     * path to listen for incoming events.
+     * </pre>
+     *
+     * <code>string path = 5;</code>
+     */
+    com.google.protobuf.ByteString
+        getPathBytes();
+
+    /**
+     * <pre>
+     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+     * </pre>
+     *
+     * <code>string bootstrapServers = 6;</code>
+     */
+    java.lang.String getBootstrapServers();
+    /**
+     * <pre>
+     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+     * </pre>
+     *
+     * <code>string bootstrapServers = 6;</code>
+     */
+    com.google.protobuf.ByteString
+        getBootstrapServersBytes();
+
+    /**
+     * <pre>
+     * reference to the secret containing the credentials to connect to the Kafka cluster.
+     * The data plane loads the secret, credentials are never written in the contract.
+     * </pre>
+     *
+     * <code>.SecretReference authSecret = 7;</code>
+     */
+    boolean hasAuthSecret();
+    /**
+     * <pre>
+     * reference to the secret containing the credentials to connect to the Kafka cluster.
+     * The data plane loads the secret, credentials are never written in the contract.
+     * </pre>
+     *
+     * <code>.SecretReference authSecret = 7;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference getAuthSecret();
+    /**
+     * <pre>
+     * reference to the secret containing the credentials to connect to the Kafka cluster.
+     * The data plane loads the secret, credentials are never written in the contract.
+     * </pre>
+     *
+     * <code>.SecretReference authSecret = 7;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReferenceOrBuilder getAuthSecretOrBuilder();
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to triggers of the broker.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 8;</code>
+     */
+    boolean hasEgressConfig();
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to triggers of the broker.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 8;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getEgressConfig();
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to triggers of the broker.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 8;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder getEgressConfigOrBuilder();
+  }
+  /**
+   * Protobuf type {@code Broker}
+   */
+  public  static final class Broker extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:Broker)
+      BrokerOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use Broker.newBuilder() to construct.
+    private Broker(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private Broker() {
+      id_ = "";
+      topic_ = "";
+      deadLetterSink_ = "";
+      triggers_ = java.util.Collections.emptyList();
+      path_ = "";
+      bootstrapServers_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new Broker();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private Broker(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              id_ = s;
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              topic_ = s;
+              break;
+            }
+            case 26: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              deadLetterSink_ = s;
+              break;
+            }
+            case 34: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                triggers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              triggers_.add(
+                  input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.parser(), extensionRegistry));
+              break;
+            }
+            case 42: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              path_ = s;
+              break;
+            }
+            case 50: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              bootstrapServers_ = s;
+              break;
+            }
+            case 58: {
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder subBuilder = null;
+              if (authSecret_ != null) {
+                subBuilder = authSecret_.toBuilder();
+              }
+              authSecret_ = input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(authSecret_);
+                authSecret_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 66: {
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder subBuilder = null;
+              if (egressConfig_ != null) {
+                subBuilder = egressConfig_.toBuilder();
+              }
+              egressConfig_ = input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(egressConfig_);
+                egressConfig_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          triggers_ = java.util.Collections.unmodifiableList(triggers_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder.class);
+    }
+
+    public static final int ID_FIELD_NUMBER = 1;
+    private volatile java.lang.Object id_;
+    /**
+     * <code>string id = 1;</code>
+     */
+    public java.lang.String getId() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        id_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string id = 1;</code>
+     */
+    public com.google.protobuf.ByteString
+        getIdBytes() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        id_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int TOPIC_FIELD_NUMBER = 2;
+    private volatile java.lang.Object topic_;
+    /**
+     * <pre>
+     * the Kafka topic to consume.
+     * </pre>
+     *
+     * <code>string topic = 2;</code>
+     */
+    public java.lang.String getTopic() {
+      java.lang.Object ref = topic_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        topic_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * the Kafka topic to consume.
+     * </pre>
+     *
+     * <code>string topic = 2;</code>
+     */
+    public com.google.protobuf.ByteString
+        getTopicBytes() {
+      java.lang.Object ref = topic_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        topic_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int DEADLETTERSINK_FIELD_NUMBER = 3;
+    private volatile java.lang.Object deadLetterSink_;
+    /**
+     * <pre>
+     * dead letter sink URI
+     * </pre>
+     *
+     * <code>string deadLetterSink = 3;</code>
+     */
+    public java.lang.String getDeadLetterSink() {
+      java.lang.Object ref = deadLetterSink_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        deadLetterSink_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * dead letter sink URI
+     * </pre>
+     *
+     * <code>string deadLetterSink = 3;</code>
+     */
+    public com.google.protobuf.ByteString
+        getDeadLetterSinkBytes() {
+      java.lang.Object ref = deadLetterSink_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        deadLetterSink_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int TRIGGERS_FIELD_NUMBER = 4;
+    private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> triggers_;
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> getTriggersList() {
+      return triggers_;
+    }
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
+        getTriggersOrBuilderList() {
+      return triggers_;
+    }
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    public int getTriggersCount() {
+      return triggers_.size();
+    }
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getTriggers(int index) {
+      return triggers_.get(index);
+    }
+    /**
+     * <pre>
+     * triggers associated with the broker
+     * </pre>
+     *
+     * <code>repeated .Trigger triggers = 4;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder getTriggersOrBuilder(
+        int index) {
+      return triggers_.get(index);
+    }
+
+    public static final int PATH_FIELD_NUMBER = 5;
+    private volatile java.lang.Object path_;
+    /**
+     * <pre>
+     * path to listen for incoming events.
+     * </pre>
+     *
+     * <code>string path = 5;</code>
+     */
+    public java.lang.String getPath() {
+      java.lang.Object ref = path_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        path_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * path to listen for incoming events.
+     * </pre>
+     *
+     * <code>string path = 5;</code>
+     */
+    public com.google.protobuf.ByteString
+        getPathBytes() {
+      java.lang.Object ref = path_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        path_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int BOOTSTRAPSERVERS_FIELD_NUMBER = 6;
+    private volatile java.lang.Object bootstrapServers_;
+    /**
+     * <pre>
+     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+     * </pre>
+     *
+     * <code>string bootstrapServers = 6;</code>
+     */
+    public java.lang.String getBootstrapServers() {
+      java.lang.Object ref = bootstrapServers_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        bootstrapServers_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+     * </pre>
+     *
+     * <code>string bootstrapServers = 6;</code>
+     */
+    public com.google.protobuf.ByteString
+        getBootstrapServersBytes() {
+      java.lang.Object ref = bootstrapServers_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        bootstrapServers_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int AUTHSECRET_FIELD_NUMBER = 7;
+    private dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference authSecret_;
+    /**
+     * <pre>
+     * reference to the secret containing the credentials to connect to the Kafka cluster.
+     * The data plane loads the secret, credentials are never written in the contract.
+     * </pre>
+     *
+     * <code>.SecretReference authSecret = 7;</code>
+     */
+    public boolean hasAuthSecret() {
+      return authSecret_ != null;
+    }
+    /**
+     * <pre>
+     * reference to the secret containing the credentials to connect to the Kafka cluster.
+     * The data plane loads the secret, credentials are never written in the contract.
+     * </pre>
+     *
+     * <code>.SecretReference authSecret = 7;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference getAuthSecret() {
+      return authSecret_ == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.getDefaultInstance() : authSecret_;
+    }
+    /**
+     * <pre>
+     * reference to the secret containing the credentials to connect to the Kafka cluster.
+     * The data plane loads the secret, credentials are never written in the contract.
+     * </pre>
+     *
+     * <code>.SecretReference authSecret = 7;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReferenceOrBuilder getAuthSecretOrBuilder() {
+      return getAuthSecret();
+    }
+    public static final int EGRESSCONFIG_FIELD_NUMBER = 8;
+    private dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig egressConfig_;
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to triggers of the broker.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 8;</code>
+     */
+    public boolean hasEgressConfig() {
+      return egressConfig_ != null;
+    }
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to triggers of the broker.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 8;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getEgressConfig() {
+      return egressConfig_ == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance() : egressConfig_;
+    }
+    /**
+     * <pre>
+     * delivery guarantees applied to events sent to triggers of the broker.
+     * </pre>
+     *
+     * <code>.EgressConfig egressConfig = 8;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder getEgressConfigOrBuilder() {
+      return getEgressConfig();
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!getIdBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, id_);
+      }
+      if (!getTopicBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, topic_);
+      }
+      if (!getDeadLetterSinkBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, deadLetterSink_);
+      }
+      for (int i = 0; i < triggers_.size(); i++) {
+        output.writeMessage(4, triggers_.get(i));
+      }
+      if (!getPathBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 5, path_);
+      }
+      if (!getBootstrapServersBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 6, bootstrapServers_);
+      }
+      if (authSecret_ != null) {
+        output.writeMessage(7, getAuthSecret());
+      }
+      if (egressConfig_ != null) {
+        output.writeMessage(8, getEgressConfig());
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!getIdBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, id_);
+      }
+      if (!getTopicBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, topic_);
+      }
+      if (!getDeadLetterSinkBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, deadLetterSink_);
+      }
+      for (int i = 0; i < triggers_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(4, triggers_.get(i));
+      }
+      if (!getPathBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(5, path_);
+      }
+      if (!getBootstrapServersBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(6, bootstrapServers_);
+      }
+      if (authSecret_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(7, getAuthSecret());
+      }
+      if (egressConfig_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(8, getEgressConfig());
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker)) {
+        return super.equals(obj);
+      }
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker other = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker) obj;
+
+      if (!getId()
+          .equals(other.getId())) return false;
+      if (!getTopic()
+          .equals(other.getTopic())) return false;
+      if (!getDeadLetterSink()
+          .equals(other.getDeadLetterSink())) return false;
+      if (!getTriggersList()
+          .equals(other.getTriggersList())) return false;
+      if (!getPath()
+          .equals(other.getPath())) return false;
+      if (!getBootstrapServers()
+          .equals(other.getBootstrapServers())) return false;
+      if (hasAuthSecret() != other.hasAuthSecret()) return false;
+      if (hasAuthSecret()) {
+        if (!getAuthSecret()
+            .equals(other.getAuthSecret())) return false;
+      }
+      if (hasEgressConfig() != other.hasEgressConfig()) return false;
+      if (hasEgressConfig()) {
+        if (!getEgressConfig()
+            .equals(other.getEgressConfig())) return false;
+      }
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
+      hash = (37 * hash) + TOPIC_FIELD_NUMBER;
+      hash = (53 * hash) + getTopic().hashCode();
+      hash = (37 * hash) + DEADLETTERSINK_FIELD_NUMBER;
+      hash = (53 * hash) + getDeadLetterSink().hashCode();
+      if (getTriggersCount() > 0) {
+        hash = (37 * hash) + TRIGGERS_FIELD_NUMBER;
+        hash = (53 * hash) + getTriggersList().hashCode();
+      }
+      hash = (37 * hash) + PATH_FIELD_NUMBER;
+      hash = (53 * hash) + getPath().hashCode();
+      hash = (37 * hash) + BOOTSTRAPSERVERS_FIELD_NUMBER;
+      hash = (53 * hash) + getBootstrapServers().hashCode();
+      if (hasAuthSecret()) {
+        hash = (37 * hash) + AUTHSECRET_FIELD_NUMBER;
+        hash = (53 * hash) + getAuthSecret().hashCode();
+      }
+      if (hasEgressConfig()) {
+        hash = (37 * hash) + EGRESSCONFIG_FIELD_NUMBER;
+        hash = (53 * hash) + getEgressConfig().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code Broker}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:Broker)
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder.class);
+      }
+
+      // Construct using dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+          getTriggersFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        id_ = "";
+
+        topic_ = "";
+
+        deadLetterSink_ = "";
+
+        if (triggersBuilder_ == null) {
+          triggers_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          triggersBuilder_.clear();
+        }
+        path_ = "";
+
+        bootstrapServers_ = "";
+
+        if (authSecretBuilder_ == null) {
+          authSecret_ = null;
+        } else {
+          authSecret_ = null;
+          authSecretBuilder_ = null;
+        }
+        if (egressConfigBuilder_ == null) {
+          egressConfig_ = null;
+        } else {
+          egressConfig_ = null;
+          egressConfigBuilder_ = null;
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_descriptor;
+      }
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getDefaultInstanceForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker build() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker buildPartial() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker result = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker(this);
+        int from_bitField0_ = bitField0_;
+        result.id_ = id_;
+        result.topic_ = topic_;
+        result.deadLetterSink_ = deadLetterSink_;
+        if (triggersBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            triggers_ = java.util.Collections.unmodifiableList(triggers_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.triggers_ = triggers_;
+        } else {
+          result.triggers_ = triggersBuilder_.build();
+        }
+        result.path_ = path_;
+        result.bootstrapServers_ = bootstrapServers_;
+        if (authSecretBuilder_ == null) {
+          result.authSecret_ = authSecret_;
+        } else {
+          result.authSecret_ = authSecretBuilder_.build();
+        }
+        if (egressConfigBuilder_ == null) {
+          result.egressConfig_ = egressConfig_;
+        } else {
+          result.egressConfig_ = egressConfigBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker) {
+          return mergeFrom((dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker other) {
+        if (other == dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance()) return this;
+        if (!other.getId().isEmpty()) {
+          id_ = other.id_;
+          onChanged();
+        }
+        if (!other.getTopic().isEmpty()) {
+          topic_ = other.topic_;
+          onChanged();
+        }
+        if (!other.getDeadLetterSink().isEmpty()) {
+          deadLetterSink_ = other.deadLetterSink_;
+          onChanged();
+        }
+        if (triggersBuilder_ == null) {
+          if (!other.triggers_.isEmpty()) {
+            if (triggers_.isEmpty()) {
+              triggers_ = other.triggers_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureTriggersIsMutable();
+              triggers_.addAll(other.triggers_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.triggers_.isEmpty()) {
+            if (triggersBuilder_.isEmpty()) {
+              triggersBuilder_.dispose();
+              triggersBuilder_ = null;
+              triggers_ = other.triggers_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              triggersBuilder_ = 
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getTriggersFieldBuilder() : null;
+            } else {
+              triggersBuilder_.addAllMessages(other.triggers_);
+            }
+          }
+        }
+        if (!other.getPath().isEmpty()) {
+          path_ = other.path_;
+          onChanged();
+        }
+        if (!other.getBootstrapServers().isEmpty()) {
+          bootstrapServers_ = other.bootstrapServers_;
+          onChanged();
+        }
+        if (other.hasAuthSecret()) {
+          mergeAuthSecret(other.getAuthSecret());
+        }
+        if (other.hasEgressConfig()) {
+          mergeEgressConfig(other.getEgressConfig());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object id_ = "";
+      /**
+       * <code>string id = 1;</code>
+       */
+      public java.lang.String getId() {
+        java.lang.Object ref = id_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          id_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       */
+      public com.google.protobuf.ByteString
+          getIdBytes() {
+        java.lang.Object ref = id_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          id_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       */
+      public Builder setId(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       */
+      public Builder clearId() {
+        
+        id_ = getDefaultInstance().getId();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       */
+      public Builder setIdBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        id_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object topic_ = "";
+      /**
+       * <pre>
+       * the Kafka topic to consume.
+       * </pre>
+       *
+       * <code>string topic = 2;</code>
+       */
+      public java.lang.String getTopic() {
+        java.lang.Object ref = topic_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          topic_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * the Kafka topic to consume.
+       * </pre>
+       *
+       * <code>string topic = 2;</code>
+       */
+      public com.google.protobuf.ByteString
+          getTopicBytes() {
+        java.lang.Object ref = topic_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          topic_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * the Kafka topic to consume.
+       * </pre>
+       *
+       * <code>string topic = 2;</code>
+       */
+      public Builder setTopic(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        topic_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * the Kafka topic to consume.
+       * </pre>
+       *
+       * <code>string topic = 2;</code>
+       */
+      public Builder clearTopic() {
+        
+        topic_ = getDefaultInstance().getTopic();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * the Kafka topic to consume.
+       * </pre>
+       *
+       * <code>string topic = 2;</code>
+       */
+      public Builder setTopicBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        topic_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object deadLetterSink_ = "";
+      /**
+       * <pre>
+       * dead letter sink URI
+       * </pre>
+       *
+       * <code>string deadLetterSink = 3;</code>
+       */
+      public java.lang.String getDeadLetterSink() {
+        java.lang.Object ref = deadLetterSink_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          deadLetterSink_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * dead letter sink URI
+       * </pre>
+       *
+       * <code>string deadLetterSink = 3;</code>
+       */
+      public com.google.protobuf.ByteString
+          getDeadLetterSinkBytes() {
+        java.lang.Object ref = deadLetterSink_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          deadLetterSink_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * dead letter sink URI
+       * </pre>
+       *
+       * <code>string deadLetterSink = 3;</code>
+       */
+      public Builder setDeadLetterSink(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        deadLetterSink_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * dead letter sink URI
+       * </pre>
+       *
+       * <code>string deadLetterSink = 3;</code>
+       */
+      public Builder clearDeadLetterSink() {
+        
+        deadLetterSink_ = getDefaultInstance().getDeadLetterSink();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * dead letter sink URI
+       * </pre>
+       *
+       * <code>string deadLetterSink = 3;</code>
+       */
+      public Builder setDeadLetterSinkBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        deadLetterSink_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> triggers_ =
+        java.util.Collections.emptyList();
+      private void ensureTriggersIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          triggers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger>(triggers_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> triggersBuilder_;
+
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> getTriggersList() {
+        if (triggersBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(triggers_);
+        } else {
+          return triggersBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public int getTriggersCount() {
+        if (triggersBuilder_ == null) {
+          return triggers_.size();
+        } else {
+          return triggersBuilder_.getCount();
+        }
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getTriggers(int index) {
+        if (triggersBuilder_ == null) {
+          return triggers_.get(index);
+        } else {
+          return triggersBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder setTriggers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger value) {
+        if (triggersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureTriggersIsMutable();
+          triggers_.set(index, value);
+          onChanged();
+        } else {
+          triggersBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder setTriggers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder builderForValue) {
+        if (triggersBuilder_ == null) {
+          ensureTriggersIsMutable();
+          triggers_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          triggersBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder addTriggers(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger value) {
+        if (triggersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureTriggersIsMutable();
+          triggers_.add(value);
+          onChanged();
+        } else {
+          triggersBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder addTriggers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger value) {
+        if (triggersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureTriggersIsMutable();
+          triggers_.add(index, value);
+          onChanged();
+        } else {
+          triggersBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder addTriggers(
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder builderForValue) {
+        if (triggersBuilder_ == null) {
+          ensureTriggersIsMutable();
+          triggers_.add(builderForValue.build());
+          onChanged();
+        } else {
+          triggersBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder addTriggers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder builderForValue) {
+        if (triggersBuilder_ == null) {
+          ensureTriggersIsMutable();
+          triggers_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          triggersBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder addAllTriggers(
+          java.lang.Iterable<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> values) {
+        if (triggersBuilder_ == null) {
+          ensureTriggersIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, triggers_);
+          onChanged();
+        } else {
+          triggersBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder clearTriggers() {
+        if (triggersBuilder_ == null) {
+          triggers_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          triggersBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public Builder removeTriggers(int index) {
+        if (triggersBuilder_ == null) {
+          ensureTriggersIsMutable();
+          triggers_.remove(index);
+          onChanged();
+        } else {
+          triggersBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder getTriggersBuilder(
+          int index) {
+        return getTriggersFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder getTriggersOrBuilder(
+          int index) {
+        if (triggersBuilder_ == null) {
+          return triggers_.get(index);  } else {
+          return triggersBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
+           getTriggersOrBuilderList() {
+        if (triggersBuilder_ != null) {
+          return triggersBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(triggers_);
+        }
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder addTriggersBuilder() {
+        return getTriggersFieldBuilder().addBuilder(
+            dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder addTriggersBuilder(
+          int index) {
+        return getTriggersFieldBuilder().addBuilder(
+            index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * triggers associated with the broker
+       * </pre>
+       *
+       * <code>repeated .Trigger triggers = 4;</code>
+       */
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder> 
+           getTriggersBuilderList() {
+        return getTriggersFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
+          getTriggersFieldBuilder() {
+        if (triggersBuilder_ == null) {
+          triggersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder>(
+                  triggers_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          triggers_ = null;
+        }
+        return triggersBuilder_;
+      }
+
+      private java.lang.Object path_ = "";
+      /**
+       * <pre>
+       * path to listen for incoming events.
+       * </pre>
+       *
+       * <code>string path = 5;</code>
+       */
+      public java.lang.String getPath() {
+        java.lang.Object ref = path_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          path_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * path to listen for incoming events.
+       * </pre>
+       *
+       * <code>string path = 5;</code>
+       */
+      public com.google.protobuf.ByteString
+          getPathBytes() {
+        java.lang.Object ref = path_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          path_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * path to listen for incoming events.
+       * </pre>
+       *
+       * <code>string path = 5;</code>
+       */
+      public Builder setPath(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        path_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * path to listen for incoming events.
+       * </pre>
+       *
+       * <code>string path = 5;</code>
+       */
+      public Builder clearPath() {
+        
+        path_ = getDefaultInstance().getPath();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * path to listen for incoming events.
+       * </pre>
+       *
+       * <code>string path = 5;</code>
+       */
+      public Builder setPathBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        path_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object bootstrapServers_ = "";
+      /**
+       * <pre>
+       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * </pre>
+       *
+       * <code>string bootstrapServers = 6;</code>
+       */
+      public java.lang.String getBootstrapServers() {
+        java.lang.Object ref = bootstrapServers_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          bootstrapServers_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * </pre>
+       *
+       * <code>string bootstrapServers = 6;</code>
+       */
+      public com.google.protobuf.ByteString
+          getBootstrapServersBytes() {
+        java.lang.Object ref = bootstrapServers_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          bootstrapServers_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * </pre>
+       *
+       * <code>string bootstrapServers = 6;</code>
+       */
+      public Builder setBootstrapServers(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        bootstrapServers_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * </pre>
+       *
+       * <code>string bootstrapServers = 6;</code>
+       */
+      public Builder clearBootstrapServers() {
+        
+        bootstrapServers_ = getDefaultInstance().getBootstrapServers();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * </pre>
+       *
+       * <code>string bootstrapServers = 6;</code>
+       */
+      public Builder setBootstrapServersBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        bootstrapServers_ = value;
+        onChanged();
+        return this;
+      }
+
+      private dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference authSecret_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReferenceOrBuilder> authSecretBuilder_;
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public boolean hasAuthSecret() {
+        return authSecretBuilder_ != null || authSecret_ != null;
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference getAuthSecret() {
+        if (authSecretBuilder_ == null) {
+          return authSecret_ == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.getDefaultInstance() : authSecret_;
+        } else {
+          return authSecretBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public Builder setAuthSecret(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference value) {
+        if (authSecretBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          authSecret_ = value;
+          onChanged();
+        } else {
+          authSecretBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public Builder setAuthSecret(
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder builderForValue) {
+        if (authSecretBuilder_ == null) {
+          authSecret_ = builderForValue.build();
+          onChanged();
+        } else {
+          authSecretBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public Builder mergeAuthSecret(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference value) {
+        if (authSecretBuilder_ == null) {
+          if (authSecret_ != null) {
+            authSecret_ =
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.newBuilder(authSecret_).mergeFrom(value).buildPartial();
+          } else {
+            authSecret_ = value;
+          }
+          onChanged();
+        } else {
+          authSecretBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public Builder clearAuthSecret() {
+        if (authSecretBuilder_ == null) {
+          authSecret_ = null;
+          onChanged();
+        } else {
+          authSecret_ = null;
+          authSecretBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder getAuthSecretBuilder() {
+        
+        onChanged();
+        return getAuthSecretFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReferenceOrBuilder getAuthSecretOrBuilder() {
+        if (authSecretBuilder_ != null) {
+          return authSecretBuilder_.getMessageOrBuilder();
+        } else {
+          return authSecret_ == null ?
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.getDefaultInstance() : authSecret_;
+        }
+      }
+      /**
+       * <pre>
+       * reference to the secret containing the credentials to connect to the Kafka cluster.
+       * The data plane loads the secret, credentials are never written in the contract.
+       * </pre>
+       *
+       * <code>.SecretReference authSecret = 7;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReferenceOrBuilder> 
+          getAuthSecretFieldBuilder() {
+        if (authSecretBuilder_ == null) {
+          authSecretBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReferenceOrBuilder>(
+                  getAuthSecret(),
+                  getParentForChildren(),
+                  isClean());
+          authSecret_ = null;
+        }
+        return authSecretBuilder_;
+      }
+      private dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig egressConfig_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder> egressConfigBuilder_;
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public boolean hasEgressConfig() {
+        return egressConfigBuilder_ != null || egressConfig_ != null;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getEgressConfig() {
+        if (egressConfigBuilder_ == null) {
+          return egressConfig_ == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance() : egressConfig_;
+        } else {
+          return egressConfigBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public Builder setEgressConfig(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig value) {
+        if (egressConfigBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          egressConfig_ = value;
+          onChanged();
+        } else {
+          egressConfigBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public Builder setEgressConfig(
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder builderForValue) {
+        if (egressConfigBuilder_ == null) {
+          egressConfig_ = builderForValue.build();
+          onChanged();
+        } else {
+          egressConfigBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public Builder mergeEgressConfig(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig value) {
+        if (egressConfigBuilder_ == null) {
+          if (egressConfig_ != null) {
+            egressConfig_ =
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.newBuilder(egressConfig_).mergeFrom(value).buildPartial();
+          } else {
+            egressConfig_ = value;
+          }
+          onChanged();
+        } else {
+          egressConfigBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public Builder clearEgressConfig() {
+        if (egressConfigBuilder_ == null) {
+          egressConfig_ = null;
+          onChanged();
+        } else {
+          egressConfig_ = null;
+          egressConfigBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder getEgressConfigBuilder() {
+        
+        onChanged();
+        return getEgressConfigFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder getEgressConfigOrBuilder() {
+        if (egressConfigBuilder_ != null) {
+          return egressConfigBuilder_.getMessageOrBuilder();
+        } else {
+          return egressConfig_ == null ?
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance() : egressConfig_;
+        }
+      }
+      /**
+       * <pre>
+       * delivery guarantees applied to events sent to triggers of the broker.
+       * </pre>
+       *
+       * <code>.EgressConfig egressConfig = 8;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder> 
+          getEgressConfigFieldBuilder() {
+        if (egressConfigBuilder_ == null) {
+          egressConfigBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder>(
+                  getEgressConfig(),
+                  getParentForChildren(),
+                  isClean());
+          egressConfig_ = null;
+        }
+        return egressConfigBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:Broker)
+    }
+
+    // @@protoc_insertion_point(class_scope:Broker)
+    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker();
+    }
+
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<Broker>
+        PARSER = new com.google.protobuf.AbstractParser<Broker>() {
+      @java.lang.Override
+      public Broker parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new Broker(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<Broker> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<Broker> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SecretReferenceOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:SecretReference)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * secret namespace.
+     * </pre>
+     *
+     * <code>string namespace = 1;</code>
+     */
+    java.lang.String getNamespace();
+    /**
+     * <pre>
+     * secret namespace.
+     * </pre>
+     *
+     * <code>string namespace = 1;</code>
+     */
+    com.google.protobuf.ByteString
+        getNamespaceBytes();
+
+    /**
+     * <pre>
+     * secret name.
+     * </pre>
+     *
+     * <code>string name = 2;</code>
+     */
+    java.lang.String getName();
+    /**
+     * <pre>
+     * secret name.
+     * </pre>
+     *
+     * <code>string name = 2;</code>
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+    /**
+     * <pre>
+     * version of the secret data, it changes when credentials are rotated.
+     * </pre>
+     *
+     * <code>string version = 3;</code>
+     */
+    java.lang.String getVersion();
+    /**
+     * <pre>
+     * version of the secret data, it changes when credentials are rotated.
+     * </pre>
+     *
+     * <code>string version = 3;</code>
+     */
+    com.google.protobuf.ByteString
+        getVersionBytes();
+  }
+  /**
+   * Protobuf type {@code SecretReference}
+   */
+  public  static final class SecretReference extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:SecretReference)
+      SecretReferenceOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SecretReference.newBuilder() to construct.
+    private SecretReference(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SecretReference() {
+      namespace_ = "";
+      name_ = "";
+      version_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SecretReference();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SecretReference(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              namespace_ = s;
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              name_ = s;
+              break;
+            }
+            case 26: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              version_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_SecretReference_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_SecretReference_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder.class);
+    }
+
+    public static final int NAMESPACE_FIELD_NUMBER = 1;
+    private volatile java.lang.Object namespace_;
+    /**
+     * <pre>
+     * secret namespace.
+     * </pre>
+     *
+     * <code>string namespace = 1;</code>
+     */
+    public java.lang.String getNamespace() {
+      java.lang.Object ref = namespace_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        namespace_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * secret namespace.
+     * </pre>
+     *
+     * <code>string namespace = 1;</code>
+     */
+    public com.google.protobuf.ByteString
+        getNamespaceBytes() {
+      java.lang.Object ref = namespace_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        namespace_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int NAME_FIELD_NUMBER = 2;
+    private volatile java.lang.Object name_;
+    /**
+     * <pre>
+     * secret name.
+     * </pre>
+     *
+     * <code>string name = 2;</code>
+     */
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * secret name.
+     * </pre>
+     *
+     * <code>string name = 2;</code>
+     */
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int VERSION_FIELD_NUMBER = 3;
+    private volatile java.lang.Object version_;
+    /**
+     * <pre>
+     * version of the secret data, it changes when credentials are rotated.
+     * </pre>
+     *
+     * <code>string version = 3;</code>
+     */
+    public java.lang.String getVersion() {
+      java.lang.Object ref = version_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        version_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * version of the secret data, it changes when credentials are rotated.
+     * </pre>
+     *
+     * <code>string version = 3;</code>
+     */
+    public com.google.protobuf.ByteString
+        getVersionBytes() {
+      java.lang.Object ref = version_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        version_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!getNamespaceBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, namespace_);
+      }
+      if (!getNameBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, name_);
+      }
+      if (!getVersionBytes().isEmpty()) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, version_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!getNamespaceBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, namespace_);
+      }
+      if (!getNameBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, name_);
+      }
+      if (!getVersionBytes().isEmpty()) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, version_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference)) {
+        return super.equals(obj);
+      }
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference other = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference) obj;
+
+      if (!getNamespace()
+          .equals(other.getNamespace())) return false;
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (!getVersion()
+          .equals(other.getVersion())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAMESPACE_FIELD_NUMBER;
+      hash = (53 * hash) + getNamespace().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (37 * hash) + VERSION_FIELD_NUMBER;
+      hash = (53 * hash) + getVersion().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
     /**
-     * <code>string id = 1;</code>
-     */
-    java.lang.String getId();
-    /**
-     * <code>string id = 1;</code>
+     * Protobuf type {@code SecretReference}
      */
-    com.google.protobuf.ByteString
-        getIdBytes();
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:SecretReference)
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReferenceOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_SecretReference_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_SecretReference_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.Builder.class);
+      }
+
+      // Construct using dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        namespace_ = "";
+
+        name_ = "";
+
+        version_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_SecretReference_descriptor;
+      }
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference getDefaultInstanceForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference build() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference buildPartial() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference result = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference(this);
+        result.namespace_ = namespace_;
+        result.name_ = name_;
+        result.version_ = version_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference) {
+          return mergeFrom((dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference other) {
+        if (other == dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference.getDefaultInstance()) return this;
+        if (!other.getNamespace().isEmpty()) {
+          namespace_ = other.namespace_;
+          onChanged();
+        }
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        if (!other.getVersion().isEmpty()) {
+          version_ = other.version_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object namespace_ = "";
+      /**
+       * <pre>
+       * secret namespace.
+       * </pre>
+       *
+       * <code>string namespace = 1;</code>
+       */
+      public java.lang.String getNamespace() {
+        java.lang.Object ref = namespace_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          namespace_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * secret namespace.
+       * </pre>
+       *
+       * <code>string namespace = 1;</code>
+       */
+      public com.google.protobuf.ByteString
+          getNamespaceBytes() {
+        java.lang.Object ref = namespace_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          namespace_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * secret namespace.
+       * </pre>
+       *
+       * <code>string namespace = 1;</code>
+       */
+      public Builder setNamespace(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        namespace_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * secret namespace.
+       * </pre>
+       *
+       * <code>string namespace = 1;</code>
+       */
+      public Builder clearNamespace() {
+        
+        namespace_ = getDefaultInstance().getNamespace();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * secret namespace.
+       * </pre>
+       *
+       * <code>string namespace = 1;</code>
+       */
+      public Builder setNamespaceBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        namespace_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object name_ = "";
+      /**
+       * <pre>
+       * secret name.
+       * </pre>
+       *
+       * <code>string name = 2;</code>
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * secret name.
+       * </pre>
+       *
+       * <code>string name = 2;</code>
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * secret name.
+       * </pre>
+       *
+       * <code>string name = 2;</code>
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * secret name.
+       * </pre>
+       *
+       * <code>string name = 2;</code>
+       */
+      public Builder clearName() {
+        
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * secret name.
+       * </pre>
+       *
+       * <code>string name = 2;</code>
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        name_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object version_ = "";
+      /**
+       * <pre>
+       * version of the secret data, it changes when credentials are rotated.
+       * </pre>
+       *
+       * <code>string version = 3;</code>
+       */
+      public java.lang.String getVersion() {
+        java.lang.Object ref = version_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          version_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * version of the secret data, it changes when credentials are rotated.
+       * </pre>
+       *
+       * <code>string version = 3;</code>
+       */
+      public com.google.protobuf.ByteString
+          getVersionBytes() {
+        java.lang.Object ref = version_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          version_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * version of the secret data, it changes when credentials are rotated.
+       * </pre>
+       *
+       * <code>string version = 3;</code>
+       */
+      public Builder setVersion(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        version_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * version of the secret data, it changes when credentials are rotated.
+       * </pre>
+       *
+       * <code>string version = 3;</code>
+       */
+      public Builder clearVersion() {
+        
+        version_ = getDefaultInstance().getVersion();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * version of the secret data, it changes when credentials are rotated.
+       * </pre>
+       *
+       * <code>string version = 3;</code>
+       */
+      public Builder setVersionBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        version_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:SecretReference)
+    }
+
+    // @@protoc_insertion_point(class_scope:SecretReference)
+    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference();
+    }
+
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SecretReference>
+        PARSER = new com.google.protobuf.AbstractParser<SecretReference>() {
+      @java.lang.Override
+      public SecretReference parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SecretReference(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SecretReference> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SecretReference> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
 
-    /**
-     * <pre>
-     * the Kafka topic to consume.
-     * </pre>
-     *
-     * <code>string topic = 2;</code>
-     */
-    java.lang.String getTopic();
-    /**
-     * <pre>
-     * the Kafka topic to consume.
-     * </pre>
-     *
-     * <code>string topic = 2;</code>
-     */
-    com.google.protobuf.ByteString
-        getTopicBytes();
+  }
 
-    /**
-     * <pre>
-     * dead letter sink URI
-     * </pre>
-     *
-     * <code>string deadLetterSink = 3;</code>
-     */
-    java.lang.String getDeadLetterSink();
-    /**
-     * <pre>
-     * dead letter sink URI
-     * </pre>
-     *
-     * <code>string deadLetterSink = 3;</code>
-     */
-    com.google.protobuf.ByteString
-        getDeadLetterSinkBytes();
+  public interface BrokersOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:Brokers)
+      com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> 
-        getTriggersList();
+    java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> 
+        getBrokersList();
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getTriggers(int index);
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getBrokers(int index);
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    int getTriggersCount();
+    int getBrokersCount();
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
-        getTriggersOrBuilderList();
+    java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
+        getBrokersOrBuilderList();
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder getTriggersOrBuilder(
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder getBrokersOrBuilder(
         int index);
 
     /**
      * <pre>
-     * path to listen for incoming events.
-     * </pre>
-     *
-     * <code>string path = 5;</code>
-     */
-    java.lang.String getPath();
-    /**
-     * <pre>
-     * path to listen for incoming events.
-     * </pre>
-     *
-     * <code>string path = 5;</code>
-     */
-    com.google.protobuf.ByteString
-        getPathBytes();
-
-    /**
-     * <pre>
-     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
-     * </pre>
-     *
-     * <code>string bootstrapServers = 6;</code>
-     */
-    java.lang.String getBootstrapServers();
-    /**
-     * <pre>
-     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+     * Count each config map update.
+     * Make sure each data plane pod has the same volume generation number.
      * </pre>
      *
-     * <code>string bootstrapServers = 6;</code>
+     * <code>uint64 volumeGeneration = 2;</code>
      */
-    com.google.protobuf.ByteString
-        getBootstrapServersBytes();
+    long getVolumeGeneration();
   }
   /**
-   * Protobuf type {@code Broker}
+   * Protobuf type {@code Brokers}
    */
-  public  static final class Broker extends
+  public  static final class Brokers extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:Broker)
-      BrokerOrBuilder {
+      // @@protoc_insertion_point(message_implements:Brokers)
+      BrokersOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use Broker.newBuilder() to construct.
-    private Broker(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use Brokers.newBuilder() to construct.
+    private Brokers(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private Broker() {
-      id_ = "";
-      topic_ = "";
-      deadLetterSink_ = "";
-      triggers_ = java.util.Collections.emptyList();
-      path_ = "";
-      bootstrapServers_ = "";
+    private Brokers() {
+      brokers_ = java.util.Collections.emptyList();
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new Broker();
+      return new Brokers();
     }
 
     @java.lang.Override
@@ -1379,7 +6039,7 @@ public final class BrokersConfig {
     getUnknownFields() {
       return this.unknownFields;
     }
-    private Broker(
+    private Brokers(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
@@ -1399,42 +6059,17 @@ public final class BrokersConfig {
               done = true;
               break;
             case 10: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              id_ = s;
-              break;
-            }
-            case 18: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              topic_ = s;
-              break;
-            }
-            case 26: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              deadLetterSink_ = s;
-              break;
-            }
-            case 34: {
               if (!((mutable_bitField0_ & 0x00000001) != 0)) {
-                triggers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger>();
+                brokers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker>();
                 mutable_bitField0_ |= 0x00000001;
               }
-              triggers_.add(
-                  input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.parser(), extensionRegistry));
-              break;
-            }
-            case 42: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              path_ = s;
+              brokers_.add(
+                  input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.parser(), extensionRegistry));
               break;
             }
-            case 50: {
-              java.lang.String s = input.readStringRequireUtf8();
+            case 16: {
 
-              bootstrapServers_ = s;
+              volumeGeneration_ = input.readUInt64();
               break;
             }
             default: {
@@ -1445,288 +6080,80 @@ public final class BrokersConfig {
               break;
             }
           }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        if (((mutable_bitField0_ & 0x00000001) != 0)) {
-          triggers_ = java.util.Collections.unmodifiableList(triggers_);
-        }
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder.class);
-    }
-
-    public static final int ID_FIELD_NUMBER = 1;
-    private volatile java.lang.Object id_;
-    /**
-     * <code>string id = 1;</code>
-     */
-    public java.lang.String getId() {
-      java.lang.Object ref = id_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        id_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string id = 1;</code>
-     */
-    public com.google.protobuf.ByteString
-        getIdBytes() {
-      java.lang.Object ref = id_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        id_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    public static final int TOPIC_FIELD_NUMBER = 2;
-    private volatile java.lang.Object topic_;
-    /**
-     * <pre>
-     * the Kafka topic to consume.
-     * </pre>
-     *
-     * <code>string topic = 2;</code>
-     */
-    public java.lang.String getTopic() {
-      java.lang.Object ref = topic_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        topic_ = s;
-        return s;
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          brokers_ = java.util.Collections.unmodifiableList(brokers_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
       }
     }
-    /**
-     * <pre>
-     * the Kafka topic to consume.
-     * </pre>
-     *
-     * <code>string topic = 2;</code>
-     */
-    public com.google.protobuf.ByteString
-        getTopicBytes() {
-      java.lang.Object ref = topic_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        topic_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_descriptor;
     }
 
-    public static final int DEADLETTERSINK_FIELD_NUMBER = 3;
-    private volatile java.lang.Object deadLetterSink_;
-    /**
-     * <pre>
-     * dead letter sink URI
-     * </pre>
-     *
-     * <code>string deadLetterSink = 3;</code>
-     */
-    public java.lang.String getDeadLetterSink() {
-      java.lang.Object ref = deadLetterSink_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        deadLetterSink_ = s;
-        return s;
-      }
-    }
-    /**
-     * <pre>
-     * dead letter sink URI
-     * </pre>
-     *
-     * <code>string deadLetterSink = 3;</code>
-     */
-    public com.google.protobuf.ByteString
-        getDeadLetterSinkBytes() {
-      java.lang.Object ref = deadLetterSink_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        deadLetterSink_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.Builder.class);
     }
 
-    public static final int TRIGGERS_FIELD_NUMBER = 4;
-    private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> triggers_;
+    public static final int BROKERS_FIELD_NUMBER = 1;
+    private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> brokers_;
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> getTriggersList() {
-      return triggers_;
+    public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> getBrokersList() {
+      return brokers_;
     }
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
-        getTriggersOrBuilderList() {
-      return triggers_;
+    public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
+        getBrokersOrBuilderList() {
+      return brokers_;
     }
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    public int getTriggersCount() {
-      return triggers_.size();
+    public int getBrokersCount() {
+      return brokers_.size();
     }
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getTriggers(int index) {
-      return triggers_.get(index);
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getBrokers(int index) {
+      return brokers_.get(index);
     }
     /**
-     * <pre>
-     * triggers associated with the broker
-     * </pre>
-     *
-     * <code>repeated .Trigger triggers = 4;</code>
+     * <code>repeated .Broker brokers = 1;</code>
      */
-    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder getTriggersOrBuilder(
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder getBrokersOrBuilder(
         int index) {
-      return triggers_.get(index);
-    }
-
-    public static final int PATH_FIELD_NUMBER = 5;
-    private volatile java.lang.Object path_;
-    /**
-     * <pre>
-     * path to listen for incoming events.
-     * </pre>
-     *
-     * <code>string path = 5;</code>
-     */
-    public java.lang.String getPath() {
-      java.lang.Object ref = path_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        path_ = s;
-        return s;
-      }
-    }
-    /**
-     * <pre>
-     * path to listen for incoming events.
-     * </pre>
-     *
-     * <code>string path = 5;</code>
-     */
-    public com.google.protobuf.ByteString
-        getPathBytes() {
-      java.lang.Object ref = path_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        path_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+      return brokers_.get(index);
     }
 
-    public static final int BOOTSTRAPSERVERS_FIELD_NUMBER = 6;
-    private volatile java.lang.Object bootstrapServers_;
-    /**
-     * <pre>
-     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
-     * </pre>
-     *
-     * <code>string bootstrapServers = 6;</code>
-     */
-    public java.lang.String getBootstrapServers() {
-      java.lang.Object ref = bootstrapServers_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        bootstrapServers_ = s;
-        return s;
-      }
-    }
+    public static final int VOLUMEGENERATION_FIELD_NUMBER = 2;
+    private long volumeGeneration_;
     /**
      * <pre>
-     * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+     * Count each config map update.
+     * Make sure each data plane pod has the same volume generation number.
      * </pre>
      *
-     * <code>string bootstrapServers = 6;</code>
+     * <code>uint64 volumeGeneration = 2;</code>
      */
-    public com.google.protobuf.ByteString
-        getBootstrapServersBytes() {
-      java.lang.Object ref = bootstrapServers_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        bootstrapServers_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+    public long getVolumeGeneration() {
+      return volumeGeneration_;
     }
 
     private byte memoizedIsInitialized = -1;
@@ -1743,23 +6170,11 @@ public final class BrokersConfig {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!getIdBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, id_);
-      }
-      if (!getTopicBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, topic_);
-      }
-      if (!getDeadLetterSinkBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, deadLetterSink_);
-      }
-      for (int i = 0; i < triggers_.size(); i++) {
-        output.writeMessage(4, triggers_.get(i));
-      }
-      if (!getPathBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 5, path_);
+      for (int i = 0; i < brokers_.size(); i++) {
+        output.writeMessage(1, brokers_.get(i));
       }
-      if (!getBootstrapServersBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 6, bootstrapServers_);
+      if (volumeGeneration_ != 0L) {
+        output.writeUInt64(2, volumeGeneration_);
       }
       unknownFields.writeTo(output);
     }
@@ -1770,24 +6185,13 @@ public final class BrokersConfig {
       if (size != -1) return size;
 
       size = 0;
-      if (!getIdBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, id_);
-      }
-      if (!getTopicBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, topic_);
-      }
-      if (!getDeadLetterSinkBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, deadLetterSink_);
-      }
-      for (int i = 0; i < triggers_.size(); i++) {
+      for (int i = 0; i < brokers_.size(); i++) {
         size += com.google.protobuf.CodedOutputStream
-          .computeMessageSize(4, triggers_.get(i));
-      }
-      if (!getPathBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(5, path_);
+          .computeMessageSize(1, brokers_.get(i));
       }
-      if (!getBootstrapServersBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(6, bootstrapServers_);
+      if (volumeGeneration_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(2, volumeGeneration_);
       }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
@@ -1799,23 +6203,15 @@ public final class BrokersConfig {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker)) {
+      if (!(obj instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers)) {
         return super.equals(obj);
       }
-      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker other = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker) obj;
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers other = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers) obj;
 
-      if (!getId()
-          .equals(other.getId())) return false;
-      if (!getTopic()
-          .equals(other.getTopic())) return false;
-      if (!getDeadLetterSink()
-          .equals(other.getDeadLetterSink())) return false;
-      if (!getTriggersList()
-          .equals(other.getTriggersList())) return false;
-      if (!getPath()
-          .equals(other.getPath())) return false;
-      if (!getBootstrapServers()
-          .equals(other.getBootstrapServers())) return false;
+      if (!getBrokersList()
+          .equals(other.getBrokersList())) return false;
+      if (getVolumeGeneration()
+          != other.getVolumeGeneration()) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -1827,88 +6223,81 @@ public final class BrokersConfig {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + ID_FIELD_NUMBER;
-      hash = (53 * hash) + getId().hashCode();
-      hash = (37 * hash) + TOPIC_FIELD_NUMBER;
-      hash = (53 * hash) + getTopic().hashCode();
-      hash = (37 * hash) + DEADLETTERSINK_FIELD_NUMBER;
-      hash = (53 * hash) + getDeadLetterSink().hashCode();
-      if (getTriggersCount() > 0) {
-        hash = (37 * hash) + TRIGGERS_FIELD_NUMBER;
-        hash = (53 * hash) + getTriggersList().hashCode();
+      if (getBrokersCount() > 0) {
+        hash = (37 * hash) + BROKERS_FIELD_NUMBER;
+        hash = (53 * hash) + getBrokersList().hashCode();
       }
-      hash = (37 * hash) + PATH_FIELD_NUMBER;
-      hash = (53 * hash) + getPath().hashCode();
-      hash = (37 * hash) + BOOTSTRAPSERVERS_FIELD_NUMBER;
-      hash = (53 * hash) + getBootstrapServers().hashCode();
+      hash = (37 * hash) + VOLUMEGENERATION_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getVolumeGeneration());
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(byte[] data)
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(java.io.InputStream input)
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseDelimitedFrom(java.io.InputStream input)
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseDelimitedFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -1921,7 +6310,7 @@ public final class BrokersConfig {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker prototype) {
+    public static Builder newBuilder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -1937,26 +6326,26 @@ public final class BrokersConfig {
       return builder;
     }
     /**
-     * Protobuf type {@code Broker}
+     * Protobuf type {@code Brokers}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:Broker)
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder {
+        // @@protoc_insertion_point(builder_implements:Brokers)
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokersOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_descriptor;
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_fieldAccessorTable
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder.class);
+                dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.Builder.class);
       }
 
-      // Construct using dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.newBuilder()
+      // Construct using dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.newBuilder()
       private Builder() {
         maybeForceBuilderInitialization();
       }
@@ -1969,27 +6358,19 @@ public final class BrokersConfig {
       private void maybeForceBuilderInitialization() {
         if (com.google.protobuf.GeneratedMessageV3
                 .alwaysUseFieldBuilders) {
-          getTriggersFieldBuilder();
+          getBrokersFieldBuilder();
         }
       }
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        id_ = "";
-
-        topic_ = "";
-
-        deadLetterSink_ = "";
-
-        if (triggersBuilder_ == null) {
-          triggers_ = java.util.Collections.emptyList();
+        if (brokersBuilder_ == null) {
+          brokers_ = java.util.Collections.emptyList();
           bitField0_ = (bitField0_ & ~0x00000001);
         } else {
-          triggersBuilder_.clear();
+          brokersBuilder_.clear();
         }
-        path_ = "";
-
-        bootstrapServers_ = "";
+        volumeGeneration_ = 0L;
 
         return this;
       }
@@ -1997,17 +6378,17 @@ public final class BrokersConfig {
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Broker_descriptor;
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_descriptor;
       }
 
       @java.lang.Override
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getDefaultInstanceForType() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance();
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers getDefaultInstanceForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.getDefaultInstance();
       }
 
       @java.lang.Override
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker build() {
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker result = buildPartial();
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers build() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -2015,23 +6396,19 @@ public final class BrokersConfig {
       }
 
       @java.lang.Override
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker buildPartial() {
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker result = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker(this);
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers buildPartial() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers result = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers(this);
         int from_bitField0_ = bitField0_;
-        result.id_ = id_;
-        result.topic_ = topic_;
-        result.deadLetterSink_ = deadLetterSink_;
-        if (triggersBuilder_ == null) {
+        if (brokersBuilder_ == null) {
           if (((bitField0_ & 0x00000001) != 0)) {
-            triggers_ = java.util.Collections.unmodifiableList(triggers_);
+            brokers_ = java.util.Collections.unmodifiableList(brokers_);
             bitField0_ = (bitField0_ & ~0x00000001);
           }
-          result.triggers_ = triggers_;
+          result.brokers_ = brokers_;
         } else {
-          result.triggers_ = triggersBuilder_.build();
+          result.brokers_ = brokersBuilder_.build();
         }
-        result.path_ = path_;
-        result.bootstrapServers_ = bootstrapServers_;
+        result.volumeGeneration_ = volumeGeneration_;
         onBuilt();
         return result;
       }
@@ -2070,825 +6447,1148 @@ public final class BrokersConfig {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker) {
-          return mergeFrom((dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker)other);
+        if (other instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers) {
+          return mergeFrom((dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker other) {
-        if (other == dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance()) return this;
-        if (!other.getId().isEmpty()) {
-          id_ = other.id_;
-          onChanged();
-        }
-        if (!other.getTopic().isEmpty()) {
-          topic_ = other.topic_;
-          onChanged();
-        }
-        if (!other.getDeadLetterSink().isEmpty()) {
-          deadLetterSink_ = other.deadLetterSink_;
-          onChanged();
-        }
-        if (triggersBuilder_ == null) {
-          if (!other.triggers_.isEmpty()) {
-            if (triggers_.isEmpty()) {
-              triggers_ = other.triggers_;
+      public Builder mergeFrom(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers other) {
+        if (other == dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.getDefaultInstance()) return this;
+        if (brokersBuilder_ == null) {
+          if (!other.brokers_.isEmpty()) {
+            if (brokers_.isEmpty()) {
+              brokers_ = other.brokers_;
               bitField0_ = (bitField0_ & ~0x00000001);
             } else {
-              ensureTriggersIsMutable();
-              triggers_.addAll(other.triggers_);
+              ensureBrokersIsMutable();
+              brokers_.addAll(other.brokers_);
             }
             onChanged();
           }
         } else {
-          if (!other.triggers_.isEmpty()) {
-            if (triggersBuilder_.isEmpty()) {
-              triggersBuilder_.dispose();
-              triggersBuilder_ = null;
-              triggers_ = other.triggers_;
+          if (!other.brokers_.isEmpty()) {
+            if (brokersBuilder_.isEmpty()) {
+              brokersBuilder_.dispose();
+              brokersBuilder_ = null;
+              brokers_ = other.brokers_;
               bitField0_ = (bitField0_ & ~0x00000001);
-              triggersBuilder_ = 
+              brokersBuilder_ = 
                 com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
-                   getTriggersFieldBuilder() : null;
+                   getBrokersFieldBuilder() : null;
             } else {
-              triggersBuilder_.addAllMessages(other.triggers_);
+              brokersBuilder_.addAllMessages(other.brokers_);
             }
           }
-        }
-        if (!other.getPath().isEmpty()) {
-          path_ = other.path_;
+        }
+        if (other.getVolumeGeneration() != 0L) {
+          setVolumeGeneration(other.getVolumeGeneration());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> brokers_ =
+        java.util.Collections.emptyList();
+      private void ensureBrokersIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          brokers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker>(brokers_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> brokersBuilder_;
+
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> getBrokersList() {
+        if (brokersBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(brokers_);
+        } else {
+          return brokersBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public int getBrokersCount() {
+        if (brokersBuilder_ == null) {
+          return brokers_.size();
+        } else {
+          return brokersBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getBrokers(int index) {
+        if (brokersBuilder_ == null) {
+          return brokers_.get(index);
+        } else {
+          return brokersBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public Builder setBrokers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker value) {
+        if (brokersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureBrokersIsMutable();
+          brokers_.set(index, value);
           onChanged();
+        } else {
+          brokersBuilder_.setMessage(index, value);
         }
-        if (!other.getBootstrapServers().isEmpty()) {
-          bootstrapServers_ = other.bootstrapServers_;
+        return this;
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public Builder setBrokers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder builderForValue) {
+        if (brokersBuilder_ == null) {
+          ensureBrokersIsMutable();
+          brokers_.set(index, builderForValue.build());
           onChanged();
+        } else {
+          brokersBuilder_.setMessage(index, builderForValue.build());
         }
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
         return this;
       }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public Builder addBrokers(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker value) {
+        if (brokersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
           }
+          ensureBrokersIsMutable();
+          brokers_.add(value);
+          onChanged();
+        } else {
+          brokersBuilder_.addMessage(value);
         }
         return this;
       }
-      private int bitField0_;
-
-      private java.lang.Object id_ = "";
       /**
-       * <code>string id = 1;</code>
+       * <code>repeated .Broker brokers = 1;</code>
        */
-      public java.lang.String getId() {
-        java.lang.Object ref = id_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          id_ = s;
-          return s;
+      public Builder addBrokers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker value) {
+        if (brokersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureBrokersIsMutable();
+          brokers_.add(index, value);
+          onChanged();
         } else {
-          return (java.lang.String) ref;
+          brokersBuilder_.addMessage(index, value);
         }
+        return this;
       }
       /**
-       * <code>string id = 1;</code>
+       * <code>repeated .Broker brokers = 1;</code>
        */
-      public com.google.protobuf.ByteString
-          getIdBytes() {
-        java.lang.Object ref = id_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          id_ = b;
-          return b;
+      public Builder addBrokers(
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder builderForValue) {
+        if (brokersBuilder_ == null) {
+          ensureBrokersIsMutable();
+          brokers_.add(builderForValue.build());
+          onChanged();
         } else {
-          return (com.google.protobuf.ByteString) ref;
+          brokersBuilder_.addMessage(builderForValue.build());
         }
+        return this;
       }
       /**
-       * <code>string id = 1;</code>
+       * <code>repeated .Broker brokers = 1;</code>
        */
-      public Builder setId(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        id_ = value;
-        onChanged();
+      public Builder addBrokers(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder builderForValue) {
+        if (brokersBuilder_ == null) {
+          ensureBrokersIsMutable();
+          brokers_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          brokersBuilder_.addMessage(index, builderForValue.build());
+        }
         return this;
       }
       /**
-       * <code>string id = 1;</code>
+       * <code>repeated .Broker brokers = 1;</code>
        */
-      public Builder clearId() {
-        
-        id_ = getDefaultInstance().getId();
-        onChanged();
+      public Builder addAllBrokers(
+          java.lang.Iterable<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> values) {
+        if (brokersBuilder_ == null) {
+          ensureBrokersIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, brokers_);
+          onChanged();
+        } else {
+          brokersBuilder_.addAllMessages(values);
+        }
         return this;
       }
       /**
-       * <code>string id = 1;</code>
+       * <code>repeated .Broker brokers = 1;</code>
        */
-      public Builder setIdBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        id_ = value;
-        onChanged();
+      public Builder clearBrokers() {
+        if (brokersBuilder_ == null) {
+          brokers_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          brokersBuilder_.clear();
+        }
         return this;
       }
-
-      private java.lang.Object topic_ = "";
       /**
-       * <pre>
-       * the Kafka topic to consume.
-       * </pre>
-       *
-       * <code>string topic = 2;</code>
+       * <code>repeated .Broker brokers = 1;</code>
        */
-      public java.lang.String getTopic() {
-        java.lang.Object ref = topic_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          topic_ = s;
-          return s;
+      public Builder removeBrokers(int index) {
+        if (brokersBuilder_ == null) {
+          ensureBrokersIsMutable();
+          brokers_.remove(index);
+          onChanged();
         } else {
-          return (java.lang.String) ref;
+          brokersBuilder_.remove(index);
         }
+        return this;
       }
       /**
-       * <pre>
-       * the Kafka topic to consume.
-       * </pre>
-       *
-       * <code>string topic = 2;</code>
+       * <code>repeated .Broker brokers = 1;</code>
        */
-      public com.google.protobuf.ByteString
-          getTopicBytes() {
-        java.lang.Object ref = topic_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          topic_ = b;
-          return b;
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder getBrokersBuilder(
+          int index) {
+        return getBrokersFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder getBrokersOrBuilder(
+          int index) {
+        if (brokersBuilder_ == null) {
+          return brokers_.get(index);  } else {
+          return brokersBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
+           getBrokersOrBuilderList() {
+        if (brokersBuilder_ != null) {
+          return brokersBuilder_.getMessageOrBuilderList();
         } else {
-          return (com.google.protobuf.ByteString) ref;
+          return java.util.Collections.unmodifiableList(brokers_);
+        }
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder addBrokersBuilder() {
+        return getBrokersFieldBuilder().addBuilder(
+            dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder addBrokersBuilder(
+          int index) {
+        return getBrokersFieldBuilder().addBuilder(
+            index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .Broker brokers = 1;</code>
+       */
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder> 
+           getBrokersBuilderList() {
+        return getBrokersFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
+          getBrokersFieldBuilder() {
+        if (brokersBuilder_ == null) {
+          brokersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder>(
+                  brokers_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          brokers_ = null;
         }
+        return brokersBuilder_;
       }
+
+      private long volumeGeneration_ ;
       /**
        * <pre>
-       * the Kafka topic to consume.
+       * Count each config map update.
+       * Make sure each data plane pod has the same volume generation number.
        * </pre>
        *
-       * <code>string topic = 2;</code>
+       * <code>uint64 volumeGeneration = 2;</code>
        */
-      public Builder setTopic(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        topic_ = value;
-        onChanged();
-        return this;
+      public long getVolumeGeneration() {
+        return volumeGeneration_;
       }
       /**
        * <pre>
-       * the Kafka topic to consume.
+       * Count each config map update.
+       * Make sure each data plane pod has the same volume generation number.
        * </pre>
        *
-       * <code>string topic = 2;</code>
+       * <code>uint64 volumeGeneration = 2;</code>
        */
-      public Builder clearTopic() {
+      public Builder setVolumeGeneration(long value) {
         
-        topic_ = getDefaultInstance().getTopic();
+        volumeGeneration_ = value;
         onChanged();
         return this;
       }
       /**
        * <pre>
-       * the Kafka topic to consume.
+       * Count each config map update.
+       * Make sure each data plane pod has the same volume generation number.
        * </pre>
        *
-       * <code>string topic = 2;</code>
+       * <code>uint64 volumeGeneration = 2;</code>
        */
-      public Builder setTopicBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
+      public Builder clearVolumeGeneration() {
         
-        topic_ = value;
+        volumeGeneration_ = 0L;
         onChanged();
         return this;
       }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:Brokers)
+    }
+
+    // @@protoc_insertion_point(class_scope:Brokers)
+    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers();
+    }
+
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<Brokers>
+        PARSER = new com.google.protobuf.AbstractParser<Brokers>() {
+      @java.lang.Override
+      public Brokers parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new Brokers(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<Brokers> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<Brokers> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface EgressConfigOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:EgressConfig)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * number of retries before moving the event to the dead letter sink, 0 means no retries.
+     * </pre>
+     *
+     * <code>uint32 retry = 1;</code>
+     */
+    int getRetry();
+
+    /**
+     * <pre>
+     * retry backoff policy.
+     * </pre>
+     *
+     * <code>.BackoffPolicy backoffPolicy = 2;</code>
+     */
+    int getBackoffPolicyValue();
+    /**
+     * <pre>
+     * retry backoff policy.
+     * </pre>
+     *
+     * <code>.BackoffPolicy backoffPolicy = 2;</code>
+     */
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy getBackoffPolicy();
+
+    /**
+     * <pre>
+     * delay before retrying in milliseconds.
+     * </pre>
+     *
+     * <code>uint64 backoffDelay = 3;</code>
+     */
+    long getBackoffDelay();
+
+    /**
+     * <pre>
+     * timeout of each request sent to the destination in milliseconds, 0 means no timeout.
+     * </pre>
+     *
+     * <code>uint64 timeout = 4;</code>
+     */
+    long getTimeout();
+  }
+  /**
+   * Protobuf type {@code EgressConfig}
+   */
+  public  static final class EgressConfig extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:EgressConfig)
+      EgressConfigOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use EgressConfig.newBuilder() to construct.
+    private EgressConfig(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private EgressConfig() {
+      backoffPolicy_ = 0;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new EgressConfig();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private EgressConfig(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              retry_ = input.readUInt32();
+              break;
+            }
+            case 16: {
+              int rawValue = input.readEnum();
+
+              backoffPolicy_ = rawValue;
+              break;
+            }
+            case 24: {
+
+              backoffDelay_ = input.readUInt64();
+              break;
+            }
+            case 32: {
+
+              timeout_ = input.readUInt64();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_EgressConfig_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_EgressConfig_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder.class);
+    }
+
+    public static final int RETRY_FIELD_NUMBER = 1;
+    private int retry_;
+    /**
+     * <pre>
+     * number of retries before moving the event to the dead letter sink, 0 means no retries.
+     * </pre>
+     *
+     * <code>uint32 retry = 1;</code>
+     */
+    public int getRetry() {
+      return retry_;
+    }
+
+    public static final int BACKOFFPOLICY_FIELD_NUMBER = 2;
+    private int backoffPolicy_;
+    /**
+     * <pre>
+     * retry backoff policy.
+     * </pre>
+     *
+     * <code>.BackoffPolicy backoffPolicy = 2;</code>
+     */
+    public int getBackoffPolicyValue() {
+      return backoffPolicy_;
+    }
+    /**
+     * <pre>
+     * retry backoff policy.
+     * </pre>
+     *
+     * <code>.BackoffPolicy backoffPolicy = 2;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy getBackoffPolicy() {
+      @SuppressWarnings("deprecation")
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy result = dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy.valueOf(backoffPolicy_);
+      return result == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy.UNRECOGNIZED : result;
+    }
+
+    public static final int BACKOFFDELAY_FIELD_NUMBER = 3;
+    private long backoffDelay_;
+    /**
+     * <pre>
+     * delay before retrying in milliseconds.
+     * </pre>
+     *
+     * <code>uint64 backoffDelay = 3;</code>
+     */
+    public long getBackoffDelay() {
+      return backoffDelay_;
+    }
+
+    public static final int TIMEOUT_FIELD_NUMBER = 4;
+    private long timeout_;
+    /**
+     * <pre>
+     * timeout of each request sent to the destination in milliseconds, 0 means no timeout.
+     * </pre>
+     *
+     * <code>uint64 timeout = 4;</code>
+     */
+    public long getTimeout() {
+      return timeout_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (retry_ != 0) {
+        output.writeUInt32(1, retry_);
+      }
+      if (backoffPolicy_ != dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy.Exponential.getNumber()) {
+        output.writeEnum(2, backoffPolicy_);
+      }
+      if (backoffDelay_ != 0L) {
+        output.writeUInt64(3, backoffDelay_);
+      }
+      if (timeout_ != 0L) {
+        output.writeUInt64(4, timeout_);
+      }
+      unknownFields.writeTo(output);
+    }
 
-      private java.lang.Object deadLetterSink_ = "";
-      /**
-       * <pre>
-       * dead letter sink URI
-       * </pre>
-       *
-       * <code>string deadLetterSink = 3;</code>
-       */
-      public java.lang.String getDeadLetterSink() {
-        java.lang.Object ref = deadLetterSink_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          deadLetterSink_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (retry_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(1, retry_);
       }
-      /**
-       * <pre>
-       * dead letter sink URI
-       * </pre>
-       *
-       * <code>string deadLetterSink = 3;</code>
-       */
-      public com.google.protobuf.ByteString
-          getDeadLetterSinkBytes() {
-        java.lang.Object ref = deadLetterSink_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          deadLetterSink_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
+      if (backoffPolicy_ != dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy.Exponential.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(2, backoffPolicy_);
       }
-      /**
-       * <pre>
-       * dead letter sink URI
-       * </pre>
-       *
-       * <code>string deadLetterSink = 3;</code>
-       */
-      public Builder setDeadLetterSink(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        deadLetterSink_ = value;
-        onChanged();
-        return this;
+      if (backoffDelay_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(3, backoffDelay_);
       }
-      /**
-       * <pre>
-       * dead letter sink URI
-       * </pre>
-       *
-       * <code>string deadLetterSink = 3;</code>
-       */
-      public Builder clearDeadLetterSink() {
-        
-        deadLetterSink_ = getDefaultInstance().getDeadLetterSink();
-        onChanged();
-        return this;
+      if (timeout_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(4, timeout_);
       }
-      /**
-       * <pre>
-       * dead letter sink URI
-       * </pre>
-       *
-       * <code>string deadLetterSink = 3;</code>
-       */
-      public Builder setDeadLetterSinkBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        deadLetterSink_ = value;
-        onChanged();
-        return this;
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig)) {
+        return super.equals(obj);
       }
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig other = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig) obj;
 
-      private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> triggers_ =
-        java.util.Collections.emptyList();
-      private void ensureTriggersIsMutable() {
-        if (!((bitField0_ & 0x00000001) != 0)) {
-          triggers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger>(triggers_);
-          bitField0_ |= 0x00000001;
-         }
+      if (getRetry()
+          != other.getRetry()) return false;
+      if (backoffPolicy_ != other.backoffPolicy_) return false;
+      if (getBackoffDelay()
+          != other.getBackoffDelay()) return false;
+      if (getTimeout()
+          != other.getTimeout()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + RETRY_FIELD_NUMBER;
+      hash = (53 * hash) + getRetry();
+      hash = (37 * hash) + BACKOFFPOLICY_FIELD_NUMBER;
+      hash = (53 * hash) + backoffPolicy_;
+      hash = (37 * hash) + BACKOFFDELAY_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getBackoffDelay());
+      hash = (37 * hash) + TIMEOUT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getTimeout());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code EgressConfig}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:EgressConfig)
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfigOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_EgressConfig_descriptor;
       }
 
-      private com.google.protobuf.RepeatedFieldBuilderV3<
-          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> triggersBuilder_;
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_EgressConfig_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.Builder.class);
+      }
 
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> getTriggersList() {
-        if (triggersBuilder_ == null) {
-          return java.util.Collections.unmodifiableList(triggers_);
-        } else {
-          return triggersBuilder_.getMessageList();
-        }
+      // Construct using dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public int getTriggersCount() {
-        if (triggersBuilder_ == null) {
-          return triggers_.size();
-        } else {
-          return triggersBuilder_.getCount();
-        }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger getTriggers(int index) {
-        if (triggersBuilder_ == null) {
-          return triggers_.get(index);
-        } else {
-          return triggersBuilder_.getMessage(index);
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
         }
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder setTriggers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger value) {
-        if (triggersBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          ensureTriggersIsMutable();
-          triggers_.set(index, value);
-          onChanged();
-        } else {
-          triggersBuilder_.setMessage(index, value);
-        }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        retry_ = 0;
+
+        backoffPolicy_ = 0;
+
+        backoffDelay_ = 0L;
+
+        timeout_ = 0L;
+
         return this;
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder setTriggers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder builderForValue) {
-        if (triggersBuilder_ == null) {
-          ensureTriggersIsMutable();
-          triggers_.set(index, builderForValue.build());
-          onChanged();
-        } else {
-          triggersBuilder_.setMessage(index, builderForValue.build());
-        }
-        return this;
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_EgressConfig_descriptor;
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder addTriggers(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger value) {
-        if (triggersBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          ensureTriggersIsMutable();
-          triggers_.add(value);
-          onChanged();
-        } else {
-          triggersBuilder_.addMessage(value);
-        }
-        return this;
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getDefaultInstanceForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance();
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder addTriggers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger value) {
-        if (triggersBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          ensureTriggersIsMutable();
-          triggers_.add(index, value);
-          onChanged();
-        } else {
-          triggersBuilder_.addMessage(index, value);
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig build() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
         }
-        return this;
+        return result;
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder addTriggers(
-          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder builderForValue) {
-        if (triggersBuilder_ == null) {
-          ensureTriggersIsMutable();
-          triggers_.add(builderForValue.build());
-          onChanged();
-        } else {
-          triggersBuilder_.addMessage(builderForValue.build());
-        }
-        return this;
+
+      @java.lang.Override
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig buildPartial() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig result = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig(this);
+        result.retry_ = retry_;
+        result.backoffPolicy_ = backoffPolicy_;
+        result.backoffDelay_ = backoffDelay_;
+        result.timeout_ = timeout_;
+        onBuilt();
+        return result;
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder addTriggers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder builderForValue) {
-        if (triggersBuilder_ == null) {
-          ensureTriggersIsMutable();
-          triggers_.add(index, builderForValue.build());
-          onChanged();
-        } else {
-          triggersBuilder_.addMessage(index, builderForValue.build());
-        }
-        return this;
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder addAllTriggers(
-          java.lang.Iterable<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger> values) {
-        if (triggersBuilder_ == null) {
-          ensureTriggersIsMutable();
-          com.google.protobuf.AbstractMessageLite.Builder.addAll(
-              values, triggers_);
-          onChanged();
-        } else {
-          triggersBuilder_.addAllMessages(values);
-        }
-        return this;
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder clearTriggers() {
-        if (triggersBuilder_ == null) {
-          triggers_ = java.util.Collections.emptyList();
-          bitField0_ = (bitField0_ & ~0x00000001);
-          onChanged();
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig) {
+          return mergeFrom((dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig)other);
         } else {
-          triggersBuilder_.clear();
+          super.mergeFrom(other);
+          return this;
         }
-        return this;
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public Builder removeTriggers(int index) {
-        if (triggersBuilder_ == null) {
-          ensureTriggersIsMutable();
-          triggers_.remove(index);
-          onChanged();
-        } else {
-          triggersBuilder_.remove(index);
+
+      public Builder mergeFrom(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig other) {
+        if (other == dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig.getDefaultInstance()) return this;
+        if (other.getRetry() != 0) {
+          setRetry(other.getRetry());
+        }
+        if (other.backoffPolicy_ != 0) {
+          setBackoffPolicyValue(other.getBackoffPolicyValue());
         }
+        if (other.getBackoffDelay() != 0L) {
+          setBackoffDelay(other.getBackoffDelay());
+        }
+        if (other.getTimeout() != 0L) {
+          setTimeout(other.getTimeout());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
         return this;
       }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder getTriggersBuilder(
-          int index) {
-        return getTriggersFieldBuilder().getBuilder(index);
-      }
-      /**
-       * <pre>
-       * triggers associated with the broker
-       * </pre>
-       *
-       * <code>repeated .Trigger triggers = 4;</code>
-       */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder getTriggersOrBuilder(
-          int index) {
-        if (triggersBuilder_ == null) {
-          return triggers_.get(index);  } else {
-          return triggersBuilder_.getMessageOrBuilder(index);
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
         }
+        return this;
       }
+
+      private int retry_ ;
       /**
        * <pre>
-       * triggers associated with the broker
+       * number of retries before moving the event to the dead letter sink, 0 means no retries.
        * </pre>
        *
-       * <code>repeated .Trigger triggers = 4;</code>
+       * <code>uint32 retry = 1;</code>
        */
-      public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
-           getTriggersOrBuilderList() {
-        if (triggersBuilder_ != null) {
-          return triggersBuilder_.getMessageOrBuilderList();
-        } else {
-          return java.util.Collections.unmodifiableList(triggers_);
-        }
+      public int getRetry() {
+        return retry_;
       }
       /**
        * <pre>
-       * triggers associated with the broker
+       * number of retries before moving the event to the dead letter sink, 0 means no retries.
        * </pre>
        *
-       * <code>repeated .Trigger triggers = 4;</code>
+       * <code>uint32 retry = 1;</code>
        */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder addTriggersBuilder() {
-        return getTriggersFieldBuilder().addBuilder(
-            dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.getDefaultInstance());
+      public Builder setRetry(int value) {
+        
+        retry_ = value;
+        onChanged();
+        return this;
       }
       /**
        * <pre>
-       * triggers associated with the broker
+       * number of retries before moving the event to the dead letter sink, 0 means no retries.
        * </pre>
        *
-       * <code>repeated .Trigger triggers = 4;</code>
+       * <code>uint32 retry = 1;</code>
        */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder addTriggersBuilder(
-          int index) {
-        return getTriggersFieldBuilder().addBuilder(
-            index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.getDefaultInstance());
+      public Builder clearRetry() {
+        
+        retry_ = 0;
+        onChanged();
+        return this;
       }
+
+      private int backoffPolicy_ = 0;
       /**
        * <pre>
-       * triggers associated with the broker
+       * retry backoff policy.
        * </pre>
        *
-       * <code>repeated .Trigger triggers = 4;</code>
+       * <code>.BackoffPolicy backoffPolicy = 2;</code>
        */
-      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder> 
-           getTriggersBuilderList() {
-        return getTriggersFieldBuilder().getBuilderList();
-      }
-      private com.google.protobuf.RepeatedFieldBuilderV3<
-          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder> 
-          getTriggersFieldBuilder() {
-        if (triggersBuilder_ == null) {
-          triggersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
-              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.TriggerOrBuilder>(
-                  triggers_,
-                  ((bitField0_ & 0x00000001) != 0),
-                  getParentForChildren(),
-                  isClean());
-          triggers_ = null;
-        }
-        return triggersBuilder_;
+      public int getBackoffPolicyValue() {
+        return backoffPolicy_;
       }
-
-      private java.lang.Object path_ = "";
       /**
        * <pre>
-       * path to listen for incoming events.
+       * retry backoff policy.
        * </pre>
        *
-       * <code>string path = 5;</code>
+       * <code>.BackoffPolicy backoffPolicy = 2;</code>
        */
-      public java.lang.String getPath() {
-        java.lang.Object ref = path_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          path_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
+      public Builder setBackoffPolicyValue(int value) {
+        backoffPolicy_ = value;
+        onChanged();
+        return this;
       }
       /**
        * <pre>
-       * path to listen for incoming events.
+       * retry backoff policy.
        * </pre>
        *
-       * <code>string path = 5;</code>
+       * <code>.BackoffPolicy backoffPolicy = 2;</code>
        */
-      public com.google.protobuf.ByteString
-          getPathBytes() {
-        java.lang.Object ref = path_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          path_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy getBackoffPolicy() {
+        @SuppressWarnings("deprecation")
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy result = dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy.valueOf(backoffPolicy_);
+        return result == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy.UNRECOGNIZED : result;
       }
       /**
        * <pre>
-       * path to listen for incoming events.
+       * retry backoff policy.
        * </pre>
        *
-       * <code>string path = 5;</code>
+       * <code>.BackoffPolicy backoffPolicy = 2;</code>
        */
-      public Builder setPath(
-          java.lang.String value) {
+      public Builder setBackoffPolicy(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BackoffPolicy value) {
         if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        path_ = value;
+          throw new NullPointerException();
+        }
+        
+        backoffPolicy_ = value.getNumber();
         onChanged();
         return this;
       }
       /**
        * <pre>
-       * path to listen for incoming events.
+       * retry backoff policy.
        * </pre>
        *
-       * <code>string path = 5;</code>
+       * <code>.BackoffPolicy backoffPolicy = 2;</code>
        */
-      public Builder clearPath() {
+      public Builder clearBackoffPolicy() {
         
-        path_ = getDefaultInstance().getPath();
+        backoffPolicy_ = 0;
         onChanged();
         return this;
       }
+
+      private long backoffDelay_ ;
       /**
        * <pre>
-       * path to listen for incoming events.
+       * delay before retrying in milliseconds.
        * </pre>
        *
-       * <code>string path = 5;</code>
+       * <code>uint64 backoffDelay = 3;</code>
        */
-      public Builder setPathBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        path_ = value;
-        onChanged();
-        return this;
+      public long getBackoffDelay() {
+        return backoffDelay_;
       }
-
-      private java.lang.Object bootstrapServers_ = "";
       /**
        * <pre>
-       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * delay before retrying in milliseconds.
        * </pre>
        *
-       * <code>string bootstrapServers = 6;</code>
+       * <code>uint64 backoffDelay = 3;</code>
        */
-      public java.lang.String getBootstrapServers() {
-        java.lang.Object ref = bootstrapServers_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          bootstrapServers_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
+      public Builder setBackoffDelay(long value) {
+        
+        backoffDelay_ = value;
+        onChanged();
+        return this;
       }
       /**
        * <pre>
-       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * delay before retrying in milliseconds.
        * </pre>
        *
-       * <code>string bootstrapServers = 6;</code>
+       * <code>uint64 backoffDelay = 3;</code>
        */
-      public com.google.protobuf.ByteString
-          getBootstrapServersBytes() {
-        java.lang.Object ref = bootstrapServers_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          bootstrapServers_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
+      public Builder clearBackoffDelay() {
+        
+        backoffDelay_ = 0L;
+        onChanged();
+        return this;
       }
+
+      private long timeout_ ;
       /**
        * <pre>
-       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * timeout of each request sent to the destination in milliseconds, 0 means no timeout.
        * </pre>
        *
-       * <code>string bootstrapServers = 6;</code>
+       * <code>uint64 timeout = 4;</code>
        */
-      public Builder setBootstrapServers(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        bootstrapServers_ = value;
-        onChanged();
-        return this;
+      public long getTimeout() {
+        return timeout_;
       }
       /**
        * <pre>
-       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * timeout of each request sent to the destination in milliseconds, 0 means no timeout.
        * </pre>
        *
-       * <code>string bootstrapServers = 6;</code>
+       * <code>uint64 timeout = 4;</code>
        */
-      public Builder clearBootstrapServers() {
+      public Builder setTimeout(long value) {
         
-        bootstrapServers_ = getDefaultInstance().getBootstrapServers();
+        timeout_ = value;
         onChanged();
         return this;
       }
       /**
        * <pre>
-       * A comma separated list of host/port pairs to use for establishing the initial connection to the Kafka cluster.
+       * timeout of each request sent to the destination in milliseconds, 0 means no timeout.
        * </pre>
        *
-       * <code>string bootstrapServers = 6;</code>
+       * <code>uint64 timeout = 4;</code>
        */
-      public Builder setBootstrapServersBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
+      public Builder clearTimeout() {
         
-        bootstrapServers_ = value;
+        timeout_ = 0L;
         onChanged();
         return this;
       }
@@ -2905,105 +7605,192 @@ public final class BrokersConfig {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:Broker)
+      // @@protoc_insertion_point(builder_scope:EgressConfig)
     }
 
-    // @@protoc_insertion_point(class_scope:Broker)
-    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:EgressConfig)
+    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker();
+      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig();
     }
 
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getDefaultInstance() {
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<Broker>
-        PARSER = new com.google.protobuf.AbstractParser<Broker>() {
+    private static final com.google.protobuf.Parser<EgressConfig>
+        PARSER = new com.google.protobuf.AbstractParser<EgressConfig>() {
       @java.lang.Override
-      public Broker parsePartialFrom(
+      public EgressConfig parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
-        return new Broker(input, extensionRegistry);
+        return new EgressConfig(input, extensionRegistry);
       }
     };
 
-    public static com.google.protobuf.Parser<Broker> parser() {
+    public static com.google.protobuf.Parser<EgressConfig> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<Broker> getParserForType() {
+    public com.google.protobuf.Parser<EgressConfig> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getDefaultInstanceForType() {
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.EgressConfig getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface BrokersOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:Brokers)
+  public interface FilterOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:Filter)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
+     */
+    int getAttributesCount();
+    /**
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
+     */
+    boolean containsAttributes(
+        java.lang.String key);
+    /**
+     * Use {@link #getAttributesMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.String>
+    getAttributes();
+    /**
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.String>
+    getAttributesMap();
+    /**
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
+     */
+
+    java.lang.String getAttributesOrDefault(
+        java.lang.String key,
+        java.lang.String defaultValue);
+    /**
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
+     */
+
+    java.lang.String getAttributesOrThrow(
+        java.lang.String key);
+
+    /**
+     * <pre>
+     * dialect of the filter.
+     * </pre>
+     *
+     * <code>.FilterDialect dialect = 2;</code>
+     */
+    int getDialectValue();
+    /**
+     * <pre>
+     * dialect of the filter.
+     * </pre>
+     *
+     * <code>.FilterDialect dialect = 2;</code>
      */
-    java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> 
-        getBrokersList();
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect getDialect();
+
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
      */
-    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getBrokers(int index);
+    java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> 
+        getFiltersList();
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
      */
-    int getBrokersCount();
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getFilters(int index);
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
      */
-    java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
-        getBrokersOrBuilderList();
+    int getFiltersCount();
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
      */
-    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder getBrokersOrBuilder(
-        int index);
-
+    java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+        getFiltersOrBuilderList();
     /**
      * <pre>
-     * Count each config map update.
-     * Make sure each data plane pod has the same volume generation number.
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
      * </pre>
      *
-     * <code>uint64 volumeGeneration = 2;</code>
+     * <code>repeated .Filter filters = 3;</code>
      */
-    long getVolumeGeneration();
+    dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder getFiltersOrBuilder(
+        int index);
   }
   /**
-   * Protobuf type {@code Brokers}
+   * Protobuf type {@code Filter}
    */
-  public  static final class Brokers extends
+  public  static final class Filter extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:Brokers)
-      BrokersOrBuilder {
+      // @@protoc_insertion_point(message_implements:Filter)
+      FilterOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use Brokers.newBuilder() to construct.
-    private Brokers(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use Filter.newBuilder() to construct.
+    private Filter(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private Brokers() {
-      brokers_ = java.util.Collections.emptyList();
+    private Filter() {
+      dialect_ = 0;
+      filters_ = java.util.Collections.emptyList();
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new Brokers();
+      return new Filter();
     }
 
     @java.lang.Override
@@ -3011,7 +7798,7 @@ public final class BrokersConfig {
     getUnknownFields() {
       return this.unknownFields;
     }
-    private Brokers(
+    private Filter(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
@@ -3032,16 +7819,30 @@ public final class BrokersConfig {
               break;
             case 10: {
               if (!((mutable_bitField0_ & 0x00000001) != 0)) {
-                brokers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker>();
+                attributes_ = com.google.protobuf.MapField.newMapField(
+                    AttributesDefaultEntryHolder.defaultEntry);
                 mutable_bitField0_ |= 0x00000001;
               }
-              brokers_.add(
-                  input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.parser(), extensionRegistry));
+              com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+              attributes__ = input.readMessage(
+                  AttributesDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+              attributes_.getMutableMap().put(
+                  attributes__.getKey(), attributes__.getValue());
               break;
             }
             case 16: {
+              int rawValue = input.readEnum();
 
-              volumeGeneration_ = input.readUInt64();
+              dialect_ = rawValue;
+              break;
+            }
+            case 26: {
+              if (!((mutable_bitField0_ & 0x00000002) != 0)) {
+                filters_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter>();
+                mutable_bitField0_ |= 0x00000002;
+              }
+              filters_.add(
+                  input.readMessage(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.parser(), extensionRegistry));
               break;
             }
             default: {
@@ -3059,8 +7860,8 @@ public final class BrokersConfig {
         throw new com.google.protobuf.InvalidProtocolBufferException(
             e).setUnfinishedMessage(this);
       } finally {
-        if (((mutable_bitField0_ & 0x00000001) != 0)) {
-          brokers_ = java.util.Collections.unmodifiableList(brokers_);
+        if (((mutable_bitField0_ & 0x00000002) != 0)) {
+          filters_ = java.util.Collections.unmodifiableList(filters_);
         }
         this.unknownFields = unknownFields.build();
         makeExtensionsImmutable();
@@ -3068,64 +7869,204 @@ public final class BrokersConfig {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_descriptor;
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Filter_descriptor;
     }
 
+    @SuppressWarnings({"rawtypes"})
+    @java.lang.Override
+    protected com.google.protobuf.MapField internalGetMapField(
+        int number) {
+      switch (number) {
+        case 1:
+          return internalGetAttributes();
+        default:
+          throw new RuntimeException(
+              "Invalid map field number: " + number);
+      }
+    }
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_fieldAccessorTable
+      return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Filter_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.Builder.class);
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder.class);
     }
 
-    public static final int BROKERS_FIELD_NUMBER = 1;
-    private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> brokers_;
+    public static final int ATTRIBUTES_FIELD_NUMBER = 1;
+    private static final class AttributesDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.String, java.lang.String> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.String, java.lang.String>newDefaultInstance(
+                  dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Filter_AttributesEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "",
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "");
+    }
+    private com.google.protobuf.MapField<
+        java.lang.String, java.lang.String> attributes_;
+    private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+    internalGetAttributes() {
+      if (attributes_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            AttributesDefaultEntryHolder.defaultEntry);
+      }
+      return attributes_;
+    }
+
+    public int getAttributesCount() {
+      return internalGetAttributes().getMap().size();
+    }
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
      */
-    public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> getBrokersList() {
-      return brokers_;
+
+    public boolean containsAttributes(
+        java.lang.String key) {
+      if (key == null) { throw new java.lang.NullPointerException(); }
+      return internalGetAttributes().getMap().containsKey(key);
     }
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * Use {@link #getAttributesMap()} instead.
      */
-    public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
-        getBrokersOrBuilderList() {
-      return brokers_;
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.String, java.lang.String> getAttributes() {
+      return getAttributesMap();
     }
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
      */
-    public int getBrokersCount() {
-      return brokers_.size();
+
+    public java.util.Map<java.lang.String, java.lang.String> getAttributesMap() {
+      return internalGetAttributes().getMap();
     }
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
      */
-    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getBrokers(int index) {
-      return brokers_.get(index);
+
+    public java.lang.String getAttributesOrDefault(
+        java.lang.String key,
+        java.lang.String defaultValue) {
+      if (key == null) { throw new java.lang.NullPointerException(); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetAttributes().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
     }
     /**
-     * <code>repeated .Broker brokers = 1;</code>
+     * <pre>
+     * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; attributes = 1;</code>
      */
-    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder getBrokersOrBuilder(
-        int index) {
-      return brokers_.get(index);
+
+    public java.lang.String getAttributesOrThrow(
+        java.lang.String key) {
+      if (key == null) { throw new java.lang.NullPointerException(); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetAttributes().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
+      }
+      return map.get(key);
     }
 
-    public static final int VOLUMEGENERATION_FIELD_NUMBER = 2;
-    private long volumeGeneration_;
+    public static final int DIALECT_FIELD_NUMBER = 2;
+    private int dialect_;
     /**
      * <pre>
-     * Count each config map update.
-     * Make sure each data plane pod has the same volume generation number.
+     * dialect of the filter.
      * </pre>
      *
-     * <code>uint64 volumeGeneration = 2;</code>
+     * <code>.FilterDialect dialect = 2;</code>
      */
-    public long getVolumeGeneration() {
-      return volumeGeneration_;
+    public int getDialectValue() {
+      return dialect_;
+    }
+    /**
+     * <pre>
+     * dialect of the filter.
+     * </pre>
+     *
+     * <code>.FilterDialect dialect = 2;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect getDialect() {
+      @SuppressWarnings("deprecation")
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect result = dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect.valueOf(dialect_);
+      return result == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect.UNRECOGNIZED : result;
+    }
+
+    public static final int FILTERS_FIELD_NUMBER = 3;
+    private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> filters_;
+    /**
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
+     */
+    public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> getFiltersList() {
+      return filters_;
+    }
+    /**
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
+     */
+    public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+        getFiltersOrBuilderList() {
+      return filters_;
+    }
+    /**
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
+     */
+    public int getFiltersCount() {
+      return filters_.size();
+    }
+    /**
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getFilters(int index) {
+      return filters_.get(index);
+    }
+    /**
+     * <pre>
+     * nested filters, used by the All, Any and Not dialects.
+     * The Not dialect has exactly one nested filter.
+     * </pre>
+     *
+     * <code>repeated .Filter filters = 3;</code>
+     */
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder getFiltersOrBuilder(
+        int index) {
+      return filters_.get(index);
     }
 
     private byte memoizedIsInitialized = -1;
@@ -3142,11 +8083,17 @@ public final class BrokersConfig {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      for (int i = 0; i < brokers_.size(); i++) {
-        output.writeMessage(1, brokers_.get(i));
+      com.google.protobuf.GeneratedMessageV3
+        .serializeStringMapTo(
+          output,
+          internalGetAttributes(),
+          AttributesDefaultEntryHolder.defaultEntry,
+          1);
+      if (dialect_ != dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect.Exact.getNumber()) {
+        output.writeEnum(2, dialect_);
       }
-      if (volumeGeneration_ != 0L) {
-        output.writeUInt64(2, volumeGeneration_);
+      for (int i = 0; i < filters_.size(); i++) {
+        output.writeMessage(3, filters_.get(i));
       }
       unknownFields.writeTo(output);
     }
@@ -3157,13 +8104,23 @@ public final class BrokersConfig {
       if (size != -1) return size;
 
       size = 0;
-      for (int i = 0; i < brokers_.size(); i++) {
+      for (java.util.Map.Entry<java.lang.String, java.lang.String> entry
+           : internalGetAttributes().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+        attributes__ = AttributesDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
         size += com.google.protobuf.CodedOutputStream
-          .computeMessageSize(1, brokers_.get(i));
+            .computeMessageSize(1, attributes__);
       }
-      if (volumeGeneration_ != 0L) {
+      if (dialect_ != dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect.Exact.getNumber()) {
         size += com.google.protobuf.CodedOutputStream
-          .computeUInt64Size(2, volumeGeneration_);
+          .computeEnumSize(2, dialect_);
+      }
+      for (int i = 0; i < filters_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(3, filters_.get(i));
       }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
@@ -3175,15 +8132,16 @@ public final class BrokersConfig {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers)) {
+      if (!(obj instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter)) {
         return super.equals(obj);
       }
-      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers other = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers) obj;
+      dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter other = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter) obj;
 
-      if (!getBrokersList()
-          .equals(other.getBrokersList())) return false;
-      if (getVolumeGeneration()
-          != other.getVolumeGeneration()) return false;
+      if (!internalGetAttributes().equals(
+          other.internalGetAttributes())) return false;
+      if (dialect_ != other.dialect_) return false;
+      if (!getFiltersList()
+          .equals(other.getFiltersList())) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -3195,81 +8153,84 @@ public final class BrokersConfig {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      if (getBrokersCount() > 0) {
-        hash = (37 * hash) + BROKERS_FIELD_NUMBER;
-        hash = (53 * hash) + getBrokersList().hashCode();
+      if (!internalGetAttributes().getMap().isEmpty()) {
+        hash = (37 * hash) + ATTRIBUTES_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetAttributes().hashCode();
+      }
+      hash = (37 * hash) + DIALECT_FIELD_NUMBER;
+      hash = (53 * hash) + dialect_;
+      if (getFiltersCount() > 0) {
+        hash = (37 * hash) + FILTERS_FIELD_NUMBER;
+        hash = (53 * hash) + getFiltersList().hashCode();
       }
-      hash = (37 * hash) + VOLUMEGENERATION_FIELD_NUMBER;
-      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-          getVolumeGeneration());
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(byte[] data)
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(java.io.InputStream input)
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseDelimitedFrom(java.io.InputStream input)
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseDelimitedFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parseFrom(
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -3282,7 +8243,7 @@ public final class BrokersConfig {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers prototype) {
+    public static Builder newBuilder(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -3298,26 +8259,48 @@ public final class BrokersConfig {
       return builder;
     }
     /**
-     * Protobuf type {@code Brokers}
+     * Protobuf type {@code Filter}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:Brokers)
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokersOrBuilder {
+        // @@protoc_insertion_point(builder_implements:Filter)
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_descriptor;
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Filter_descriptor;
       }
 
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapField internalGetMapField(
+          int number) {
+        switch (number) {
+          case 1:
+            return internalGetAttributes();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapField internalGetMutableMapField(
+          int number) {
+        switch (number) {
+          case 1:
+            return internalGetMutableAttributes();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_fieldAccessorTable
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Filter_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.Builder.class);
+                dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.class, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder.class);
       }
 
-      // Construct using dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.newBuilder()
+      // Construct using dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.newBuilder()
       private Builder() {
         maybeForceBuilderInitialization();
       }
@@ -3330,37 +8313,38 @@ public final class BrokersConfig {
       private void maybeForceBuilderInitialization() {
         if (com.google.protobuf.GeneratedMessageV3
                 .alwaysUseFieldBuilders) {
-          getBrokersFieldBuilder();
+          getFiltersFieldBuilder();
         }
       }
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        if (brokersBuilder_ == null) {
-          brokers_ = java.util.Collections.emptyList();
-          bitField0_ = (bitField0_ & ~0x00000001);
+        internalGetMutableAttributes().clear();
+        dialect_ = 0;
+
+        if (filtersBuilder_ == null) {
+          filters_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000002);
         } else {
-          brokersBuilder_.clear();
+          filtersBuilder_.clear();
         }
-        volumeGeneration_ = 0L;
-
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Brokers_descriptor;
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.internal_static_Filter_descriptor;
       }
 
       @java.lang.Override
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers getDefaultInstanceForType() {
-        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.getDefaultInstance();
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getDefaultInstanceForType() {
+        return dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.getDefaultInstance();
       }
 
       @java.lang.Override
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers build() {
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers result = buildPartial();
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter build() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -3368,19 +8352,21 @@ public final class BrokersConfig {
       }
 
       @java.lang.Override
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers buildPartial() {
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers result = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers(this);
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter buildPartial() {
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter result = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter(this);
         int from_bitField0_ = bitField0_;
-        if (brokersBuilder_ == null) {
-          if (((bitField0_ & 0x00000001) != 0)) {
-            brokers_ = java.util.Collections.unmodifiableList(brokers_);
-            bitField0_ = (bitField0_ & ~0x00000001);
+        result.attributes_ = internalGetAttributes();
+        result.attributes_.makeImmutable();
+        result.dialect_ = dialect_;
+        if (filtersBuilder_ == null) {
+          if (((bitField0_ & 0x00000002) != 0)) {
+            filters_ = java.util.Collections.unmodifiableList(filters_);
+            bitField0_ = (bitField0_ & ~0x00000002);
           }
-          result.brokers_ = brokers_;
+          result.filters_ = filters_;
         } else {
-          result.brokers_ = brokersBuilder_.build();
+          result.filters_ = filtersBuilder_.build();
         }
-        result.volumeGeneration_ = volumeGeneration_;
         onBuilt();
         return result;
       }
@@ -3419,45 +8405,47 @@ public final class BrokersConfig {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers) {
-          return mergeFrom((dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers)other);
+        if (other instanceof dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter) {
+          return mergeFrom((dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers other) {
-        if (other == dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers.getDefaultInstance()) return this;
-        if (brokersBuilder_ == null) {
-          if (!other.brokers_.isEmpty()) {
-            if (brokers_.isEmpty()) {
-              brokers_ = other.brokers_;
-              bitField0_ = (bitField0_ & ~0x00000001);
+      public Builder mergeFrom(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter other) {
+        if (other == dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.getDefaultInstance()) return this;
+        internalGetMutableAttributes().mergeFrom(
+            other.internalGetAttributes());
+        if (other.dialect_ != 0) {
+          setDialectValue(other.getDialectValue());
+        }
+        if (filtersBuilder_ == null) {
+          if (!other.filters_.isEmpty()) {
+            if (filters_.isEmpty()) {
+              filters_ = other.filters_;
+              bitField0_ = (bitField0_ & ~0x00000002);
             } else {
-              ensureBrokersIsMutable();
-              brokers_.addAll(other.brokers_);
+              ensureFiltersIsMutable();
+              filters_.addAll(other.filters_);
             }
             onChanged();
           }
         } else {
-          if (!other.brokers_.isEmpty()) {
-            if (brokersBuilder_.isEmpty()) {
-              brokersBuilder_.dispose();
-              brokersBuilder_ = null;
-              brokers_ = other.brokers_;
-              bitField0_ = (bitField0_ & ~0x00000001);
-              brokersBuilder_ = 
+          if (!other.filters_.isEmpty()) {
+            if (filtersBuilder_.isEmpty()) {
+              filtersBuilder_.dispose();
+              filtersBuilder_ = null;
+              filters_ = other.filters_;
+              bitField0_ = (bitField0_ & ~0x00000002);
+              filtersBuilder_ = 
                 com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
-                   getBrokersFieldBuilder() : null;
+                   getFiltersFieldBuilder() : null;
             } else {
-              brokersBuilder_.addAllMessages(other.brokers_);
+              filtersBuilder_.addAllMessages(other.filters_);
             }
           }
         }
-        if (other.getVolumeGeneration() != 0L) {
-          setVolumeGeneration(other.getVolumeGeneration());
-        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -3473,11 +8461,11 @@ public final class BrokersConfig {
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws java.io.IOException {
-        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers parsedMessage = null;
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter parsedMessage = null;
         try {
           parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
         } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers) e.getUnfinishedMessage();
+          parsedMessage = (dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter) e.getUnfinishedMessage();
           throw e.unwrapIOException();
         } finally {
           if (parsedMessage != null) {
@@ -3486,287 +8474,552 @@ public final class BrokersConfig {
         }
         return this;
       }
-      private int bitField0_;
+      private int bitField0_;
+
+      private com.google.protobuf.MapField<
+          java.lang.String, java.lang.String> attributes_;
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+      internalGetAttributes() {
+        if (attributes_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              AttributesDefaultEntryHolder.defaultEntry);
+        }
+        return attributes_;
+      }
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+      internalGetMutableAttributes() {
+        onChanged();;
+        if (attributes_ == null) {
+          attributes_ = com.google.protobuf.MapField.newMapField(
+              AttributesDefaultEntryHolder.defaultEntry);
+        }
+        if (!attributes_.isMutable()) {
+          attributes_ = attributes_.copy();
+        }
+        return attributes_;
+      }
+
+      public int getAttributesCount() {
+        return internalGetAttributes().getMap().size();
+      }
+      /**
+       * <pre>
+       * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; attributes = 1;</code>
+       */
+
+      public boolean containsAttributes(
+          java.lang.String key) {
+        if (key == null) { throw new java.lang.NullPointerException(); }
+        return internalGetAttributes().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getAttributesMap()} instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String> getAttributes() {
+        return getAttributesMap();
+      }
+      /**
+       * <pre>
+       * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; attributes = 1;</code>
+       */
+
+      public java.util.Map<java.lang.String, java.lang.String> getAttributesMap() {
+        return internalGetAttributes().getMap();
+      }
+      /**
+       * <pre>
+       * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; attributes = 1;</code>
+       */
+
+      public java.lang.String getAttributesOrDefault(
+          java.lang.String key,
+          java.lang.String defaultValue) {
+        if (key == null) { throw new java.lang.NullPointerException(); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetAttributes().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <pre>
+       * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; attributes = 1;</code>
+       */
+
+      public java.lang.String getAttributesOrThrow(
+          java.lang.String key) {
+        if (key == null) { throw new java.lang.NullPointerException(); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetAttributes().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+
+      public Builder clearAttributes() {
+        internalGetMutableAttributes().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <pre>
+       * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; attributes = 1;</code>
+       */
+
+      public Builder removeAttributes(
+          java.lang.String key) {
+        if (key == null) { throw new java.lang.NullPointerException(); }
+        internalGetMutableAttributes().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String>
+      getMutableAttributes() {
+        return internalGetMutableAttributes().getMutableMap();
+      }
+      /**
+       * <pre>
+       * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; attributes = 1;</code>
+       */
+      public Builder putAttributes(
+          java.lang.String key,
+          java.lang.String value) {
+        if (key == null) { throw new java.lang.NullPointerException(); }
+        if (value == null) { throw new java.lang.NullPointerException(); }
+        internalGetMutableAttributes().getMutableMap()
+            .put(key, value);
+        return this;
+      }
+      /**
+       * <pre>
+       * attributes of the event context to match, used by the Exact, Prefix and Suffix dialects.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; attributes = 1;</code>
+       */
+
+      public Builder putAllAttributes(
+          java.util.Map<java.lang.String, java.lang.String> values) {
+        internalGetMutableAttributes().getMutableMap()
+            .putAll(values);
+        return this;
+      }
+
+      private int dialect_ = 0;
+      /**
+       * <pre>
+       * dialect of the filter.
+       * </pre>
+       *
+       * <code>.FilterDialect dialect = 2;</code>
+       */
+      public int getDialectValue() {
+        return dialect_;
+      }
+      /**
+       * <pre>
+       * dialect of the filter.
+       * </pre>
+       *
+       * <code>.FilterDialect dialect = 2;</code>
+       */
+      public Builder setDialectValue(int value) {
+        dialect_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * dialect of the filter.
+       * </pre>
+       *
+       * <code>.FilterDialect dialect = 2;</code>
+       */
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect getDialect() {
+        @SuppressWarnings("deprecation")
+        dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect result = dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect.valueOf(dialect_);
+        return result == null ? dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect.UNRECOGNIZED : result;
+      }
+      /**
+       * <pre>
+       * dialect of the filter.
+       * </pre>
+       *
+       * <code>.FilterDialect dialect = 2;</code>
+       */
+      public Builder setDialect(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterDialect value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        
+        dialect_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * dialect of the filter.
+       * </pre>
+       *
+       * <code>.FilterDialect dialect = 2;</code>
+       */
+      public Builder clearDialect() {
+        
+        dialect_ = 0;
+        onChanged();
+        return this;
+      }
 
-      private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> brokers_ =
+      private java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> filters_ =
         java.util.Collections.emptyList();
-      private void ensureBrokersIsMutable() {
-        if (!((bitField0_ & 0x00000001) != 0)) {
-          brokers_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker>(brokers_);
-          bitField0_ |= 0x00000001;
+      private void ensureFiltersIsMutable() {
+        if (!((bitField0_ & 0x00000002) != 0)) {
+          filters_ = new java.util.ArrayList<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter>(filters_);
+          bitField0_ |= 0x00000002;
          }
       }
 
       private com.google.protobuf.RepeatedFieldBuilderV3<
-          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> brokersBuilder_;
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> filtersBuilder_;
 
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> getBrokersList() {
-        if (brokersBuilder_ == null) {
-          return java.util.Collections.unmodifiableList(brokers_);
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> getFiltersList() {
+        if (filtersBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(filters_);
         } else {
-          return brokersBuilder_.getMessageList();
+          return filtersBuilder_.getMessageList();
         }
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public int getBrokersCount() {
-        if (brokersBuilder_ == null) {
-          return brokers_.size();
+      public int getFiltersCount() {
+        if (filtersBuilder_ == null) {
+          return filters_.size();
         } else {
-          return brokersBuilder_.getCount();
+          return filtersBuilder_.getCount();
         }
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker getBrokers(int index) {
-        if (brokersBuilder_ == null) {
-          return brokers_.get(index);
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getFilters(int index) {
+        if (filtersBuilder_ == null) {
+          return filters_.get(index);
         } else {
-          return brokersBuilder_.getMessage(index);
+          return filtersBuilder_.getMessage(index);
         }
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder setBrokers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker value) {
-        if (brokersBuilder_ == null) {
+      public Builder setFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter value) {
+        if (filtersBuilder_ == null) {
           if (value == null) {
             throw new NullPointerException();
           }
-          ensureBrokersIsMutable();
-          brokers_.set(index, value);
+          ensureFiltersIsMutable();
+          filters_.set(index, value);
           onChanged();
         } else {
-          brokersBuilder_.setMessage(index, value);
+          filtersBuilder_.setMessage(index, value);
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder setBrokers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder builderForValue) {
-        if (brokersBuilder_ == null) {
-          ensureBrokersIsMutable();
-          brokers_.set(index, builderForValue.build());
+      public Builder setFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder builderForValue) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.set(index, builderForValue.build());
           onChanged();
         } else {
-          brokersBuilder_.setMessage(index, builderForValue.build());
+          filtersBuilder_.setMessage(index, builderForValue.build());
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder addBrokers(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker value) {
-        if (brokersBuilder_ == null) {
+      public Builder addFilters(dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter value) {
+        if (filtersBuilder_ == null) {
           if (value == null) {
             throw new NullPointerException();
           }
-          ensureBrokersIsMutable();
-          brokers_.add(value);
+          ensureFiltersIsMutable();
+          filters_.add(value);
           onChanged();
         } else {
-          brokersBuilder_.addMessage(value);
+          filtersBuilder_.addMessage(value);
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder addBrokers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker value) {
-        if (brokersBuilder_ == null) {
+      public Builder addFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter value) {
+        if (filtersBuilder_ == null) {
           if (value == null) {
             throw new NullPointerException();
           }
-          ensureBrokersIsMutable();
-          brokers_.add(index, value);
+          ensureFiltersIsMutable();
+          filters_.add(index, value);
           onChanged();
         } else {
-          brokersBuilder_.addMessage(index, value);
+          filtersBuilder_.addMessage(index, value);
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder addBrokers(
-          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder builderForValue) {
-        if (brokersBuilder_ == null) {
-          ensureBrokersIsMutable();
-          brokers_.add(builderForValue.build());
+      public Builder addFilters(
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder builderForValue) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.add(builderForValue.build());
           onChanged();
         } else {
-          brokersBuilder_.addMessage(builderForValue.build());
+          filtersBuilder_.addMessage(builderForValue.build());
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder addBrokers(
-          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder builderForValue) {
-        if (brokersBuilder_ == null) {
-          ensureBrokersIsMutable();
-          brokers_.add(index, builderForValue.build());
+      public Builder addFilters(
+          int index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder builderForValue) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.add(index, builderForValue.build());
           onChanged();
         } else {
-          brokersBuilder_.addMessage(index, builderForValue.build());
+          filtersBuilder_.addMessage(index, builderForValue.build());
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder addAllBrokers(
-          java.lang.Iterable<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker> values) {
-        if (brokersBuilder_ == null) {
-          ensureBrokersIsMutable();
+      public Builder addAllFilters(
+          java.lang.Iterable<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter> values) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
           com.google.protobuf.AbstractMessageLite.Builder.addAll(
-              values, brokers_);
+              values, filters_);
           onChanged();
         } else {
-          brokersBuilder_.addAllMessages(values);
+          filtersBuilder_.addAllMessages(values);
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder clearBrokers() {
-        if (brokersBuilder_ == null) {
-          brokers_ = java.util.Collections.emptyList();
-          bitField0_ = (bitField0_ & ~0x00000001);
+      public Builder clearFilters() {
+        if (filtersBuilder_ == null) {
+          filters_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000002);
           onChanged();
         } else {
-          brokersBuilder_.clear();
+          filtersBuilder_.clear();
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder removeBrokers(int index) {
-        if (brokersBuilder_ == null) {
-          ensureBrokersIsMutable();
-          brokers_.remove(index);
+      public Builder removeFilters(int index) {
+        if (filtersBuilder_ == null) {
+          ensureFiltersIsMutable();
+          filters_.remove(index);
           onChanged();
         } else {
-          brokersBuilder_.remove(index);
+          filtersBuilder_.remove(index);
         }
         return this;
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder getBrokersBuilder(
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder getFiltersBuilder(
           int index) {
-        return getBrokersFieldBuilder().getBuilder(index);
+        return getFiltersFieldBuilder().getBuilder(index);
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder getBrokersOrBuilder(
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder getFiltersOrBuilder(
           int index) {
-        if (brokersBuilder_ == null) {
-          return brokers_.get(index);  } else {
-          return brokersBuilder_.getMessageOrBuilder(index);
+        if (filtersBuilder_ == null) {
+          return filters_.get(index);  } else {
+          return filtersBuilder_.getMessageOrBuilder(index);
         }
       }
       /**
-       * <code>repeated .Broker brokers = 1;</code>
+       * <pre>
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
+       * </pre>
+       *
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
-           getBrokersOrBuilderList() {
-        if (brokersBuilder_ != null) {
-          return brokersBuilder_.getMessageOrBuilderList();
+      public java.util.List<? extends dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+           getFiltersOrBuilderList() {
+        if (filtersBuilder_ != null) {
+          return filtersBuilder_.getMessageOrBuilderList();
         } else {
-          return java.util.Collections.unmodifiableList(brokers_);
-        }
-      }
-      /**
-       * <code>repeated .Broker brokers = 1;</code>
-       */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder addBrokersBuilder() {
-        return getBrokersFieldBuilder().addBuilder(
-            dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance());
-      }
-      /**
-       * <code>repeated .Broker brokers = 1;</code>
-       */
-      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder addBrokersBuilder(
-          int index) {
-        return getBrokersFieldBuilder().addBuilder(
-            index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.getDefaultInstance());
-      }
-      /**
-       * <code>repeated .Broker brokers = 1;</code>
-       */
-      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder> 
-           getBrokersBuilderList() {
-        return getBrokersFieldBuilder().getBuilderList();
-      }
-      private com.google.protobuf.RepeatedFieldBuilderV3<
-          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder> 
-          getBrokersFieldBuilder() {
-        if (brokersBuilder_ == null) {
-          brokersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
-              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.BrokerOrBuilder>(
-                  brokers_,
-                  ((bitField0_ & 0x00000001) != 0),
-                  getParentForChildren(),
-                  isClean());
-          brokers_ = null;
+          return java.util.Collections.unmodifiableList(filters_);
         }
-        return brokersBuilder_;
       }
-
-      private long volumeGeneration_ ;
       /**
        * <pre>
-       * Count each config map update.
-       * Make sure each data plane pod has the same volume generation number.
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
        * </pre>
        *
-       * <code>uint64 volumeGeneration = 2;</code>
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public long getVolumeGeneration() {
-        return volumeGeneration_;
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder addFiltersBuilder() {
+        return getFiltersFieldBuilder().addBuilder(
+            dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.getDefaultInstance());
       }
       /**
        * <pre>
-       * Count each config map update.
-       * Make sure each data plane pod has the same volume generation number.
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
        * </pre>
        *
-       * <code>uint64 volumeGeneration = 2;</code>
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder setVolumeGeneration(long value) {
-        
-        volumeGeneration_ = value;
-        onChanged();
-        return this;
+      public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder addFiltersBuilder(
+          int index) {
+        return getFiltersFieldBuilder().addBuilder(
+            index, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.getDefaultInstance());
       }
       /**
        * <pre>
-       * Count each config map update.
-       * Make sure each data plane pod has the same volume generation number.
+       * nested filters, used by the All, Any and Not dialects.
+       * The Not dialect has exactly one nested filter.
        * </pre>
        *
-       * <code>uint64 volumeGeneration = 2;</code>
+       * <code>repeated .Filter filters = 3;</code>
        */
-      public Builder clearVolumeGeneration() {
-        
-        volumeGeneration_ = 0L;
-        onChanged();
-        return this;
+      public java.util.List<dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder> 
+           getFiltersBuilderList() {
+        return getFiltersFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder> 
+          getFiltersFieldBuilder() {
+        if (filtersBuilder_ == null) {
+          filtersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter.Builder, dev.knative.eventing.kafka.broker.core.config.BrokersConfig.FilterOrBuilder>(
+                  filters_,
+                  ((bitField0_ & 0x00000002) != 0),
+                  getParentForChildren(),
+                  isClean());
+          filters_ = null;
+        }
+        return filtersBuilder_;
       }
       @java.lang.Override
       public final Builder setUnknownFields(
@@ -3781,41 +9034,41 @@ public final class BrokersConfig {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:Brokers)
+      // @@protoc_insertion_point(builder_scope:Filter)
     }
 
-    // @@protoc_insertion_point(class_scope:Brokers)
-    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:Filter)
+    private static final dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers();
+      DEFAULT_INSTANCE = new dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter();
     }
 
-    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers getDefaultInstance() {
+    public static dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<Brokers>
-        PARSER = new com.google.protobuf.AbstractParser<Brokers>() {
+    private static final com.google.protobuf.Parser<Filter>
+        PARSER = new com.google.protobuf.AbstractParser<Filter>() {
       @java.lang.Override
-      public Brokers parsePartialFrom(
+      public Filter parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
-        return new Brokers(input, extensionRegistry);
+        return new Filter(input, extensionRegistry);
       }
     };
 
-    public static com.google.protobuf.Parser<Brokers> parser() {
+    public static com.google.protobuf.Parser<Filter> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<Brokers> getParserForType() {
+    public com.google.protobuf.Parser<Filter> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Brokers getDefaultInstanceForType() {
+    public dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Filter getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
@@ -3836,11 +9089,31 @@ public final class BrokersConfig {
   private static final 
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_Broker_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_SecretReference_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_SecretReference_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_Brokers_descriptor;
   private static final 
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_Brokers_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_EgressConfig_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_EgressConfig_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_Filter_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_Filter_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_Filter_AttributesEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_Filter_AttributesEntry_fieldAccessorTable;
 
   public static com.google.protobuf.Descriptors.FileDescriptor
       getDescriptor() {
@@ -3850,18 +9123,36 @@ public final class BrokersConfig {
       descriptor;
   static {
     java.lang.String[] descriptorData = {
-      "\n\030proto/def/triggers.proto\"\213\001\n\007Trigger\022," +
+      "\n\030proto/def/triggers.proto\"\231\002\n\007Trigger\022," +
       "\n\nattributes\030\001 \003(\0132\030.Trigger.AttributesE" +
-      "ntry\022\023\n\013destination\030\002 \001(\t\022\n\n\002id\030\003 \001(\t\0321\n" +
-      "\017AttributesEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002" +
-      " \001(\t:\0028\001\"\177\n\006Broker\022\n\n\002id\030\001 \001(\t\022\r\n\005topic\030" +
-      "\002 \001(\t\022\026\n\016deadLetterSink\030\003 \001(\t\022\032\n\010trigger" +
-      "s\030\004 \003(\0132\010.Trigger\022\014\n\004path\030\005 \001(\t\022\030\n\020boots" +
-      "trapServers\030\006 \001(\t\"=\n\007Brokers\022\030\n\007brokers\030" +
-      "\001 \003(\0132\007.Broker\022\030\n\020volumeGeneration\030\002 \001(\004" +
-      "B]\n-dev.knative.eventing.kafka.broker.co" +
-      "re.configB\rBrokersConfigZ\035control-plane/" +
-      "pkg/core/configb\006proto3"
+      "ntry\022\023\n\013destination\030\002 \001(\t\022\n\n\002id\030\003 \001(\t\022\016\n" +
+      "\006paused\030\004 \001(\010\022\026\n\016deadLetterSink\030\005 \001(\t\022#\n" +
+      "\014egressConfig\030\006 \001(\0132\r.EgressConfig\022%\n\rde" +
+      "liveryOrder\030\007 \001(\0162\016.DeliveryOrder\022\030\n\007fil" +
+      "ters\030\010 \003(\0132\007.Filter\0321\n\017AttributesEntry\022\013" +
+      "\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"\312\001\n\006Broke" +
+      "r\022\n\n\002id\030\001 \001(\t\022\r\n\005topic\030\002 \001(\t\022\026\n\016deadLett" +
+      "erSink\030\003 \001(\t\022\032\n\010triggers\030\004 \003(\0132\010.Trigger" +
+      "\022\014\n\004path\030\005 \001(\t\022\030\n\020bootstrapServers\030\006 \001(\t" +
+      "\022$\n\nauthSecret\030\007 \001(\0132\020.SecretReference\022#" +
+      "\n\014egressConfig\030\010 \001(\0132\r.EgressConfig\"C\n\017S" +
+      "ecretReference\022\021\n\tnamespace\030\001 \001(\t\022\014\n\004nam" +
+      "e\030\002 \001(\t\022\017\n\007version\030\003 \001(\t\"=\n\007Brokers\022\030\n\007b" +
+      "rokers\030\001 \003(\0132\007.Broker\022\030\n\020volumeGeneratio" +
+      "n\030\002 \001(\004\"k\n\014EgressConfig\022\r\n\005retry\030\001 \001(\r\022%" +
+      "\n\rbackoffPolicy\030\002 \001(\0162\016.BackoffPolicy\022\024\n" +
+      "\014backoffDelay\030\003 \001(\004\022\017\n\007timeout\030\004 \001(\004\"\243\001\n" +
+      "\006Filter\022+\n\nattributes\030\001 \003(\0132\027.Filter.Att" +
+      "ributesEntry\022\037\n\007dialect\030\002 \001(\0162\016.FilterDi" +
+      "alect\022\030\n\007filters\030\003 \003(\0132\007.Filter\0321\n\017Attri" +
+      "butesEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\002" +
+      "8\001*,\n\rBackoffPolicy\022\017\n\013Exponential\020\000\022\n\n\006" +
+      "Linear\020\001*+\n\rDeliveryOrder\022\r\n\tUnordered\020\000" +
+      "\022\013\n\007Ordered\020\001*M\n\rFilterDialect\022\t\n\005Exact\020" +
+      "\000\022\n\n\006Prefix\020\001\022\n\n\006Suffix\020\002\022\007\n\003All\020\003\022\007\n\003An" +
+      "y\020\004\022\007\n\003Not\020\005B]\n-dev.knative.eventing.kaf" +
+      "ka.broker.core.configB\rBrokersConfigZ\035co" +
+      "ntrol-plane/pkg/core/configb\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -3872,7 +9163,7 @@ public final class BrokersConfig {
     internal_static_Trigger_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_Trigger_descriptor,
-        new java.lang.String[] { "Attributes", "Destination", "Id", });
+        new java.lang.String[] { "Attributes", "Destination", "Id", "Paused", "DeadLetterSink", "EgressConfig", "DeliveryOrder", "Filters", });
     internal_static_Trigger_AttributesEntry_descriptor =
       internal_static_Trigger_descriptor.getNestedTypes().get(0);
     internal_static_Trigger_AttributesEntry_fieldAccessorTable = new
@@ -3884,13 +9175,37 @@ public final class BrokersConfig {
     internal_static_Broker_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_Broker_descriptor,
-        new java.lang.String[] { "Id", "Topic", "DeadLetterSink", "Triggers", "Path", "BootstrapServers", });
-    internal_static_Brokers_descriptor =
+        new java.lang.String[] { "Id", "Topic", "DeadLetterSink", "Triggers", "Path", "BootstrapServers", "AuthSecret", "EgressConfig", });
+    internal_static_SecretReference_descriptor =
       getDescriptor().getMessageTypes().get(2);
+    internal_static_SecretReference_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_SecretReference_descriptor,
+        new java.lang.String[] { "Namespace", "Name", "Version", });
+    internal_static_Brokers_descriptor =
+      getDescriptor().getMessageTypes().get(3);
     internal_static_Brokers_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_Brokers_descriptor,
         new java.lang.String[] { "Brokers", "VolumeGeneration", });
+    internal_static_EgressConfig_descriptor =
+      getDescriptor().getMessageTypes().get(4);
+    internal_static_EgressConfig_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_EgressConfig_descriptor,
+        new java.lang.String[] { "Retry", "BackoffPolicy", "BackoffDelay", "Timeout", });
+    internal_static_Filter_descriptor =
+      getDescriptor().getMessageTypes().get(5);
+    internal_static_Filter_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_Filter_descriptor,
+        new java.lang.String[] { "Attributes", "Dialect", "Filters", });
+    internal_static_Filter_AttributesEntry_descriptor =
+      internal_static_Filter_descriptor.getNestedTypes().get(0);
+    internal_static_Filter_AttributesEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_Filter_AttributesEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
   }
 
   // @@protoc_insertion_point(outer_class_scope)
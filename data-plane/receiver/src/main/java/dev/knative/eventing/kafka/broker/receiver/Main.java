@@ -20,6 +20,8 @@ import static net.logstash.logback.argument.StructuredArguments.keyValue;
 
 import dev.knative.eventing.kafka.broker.core.ObjectsCreator;
 import dev.knative.eventing.kafka.broker.core.file.FileWatcher;
+import dev.knative.eventing.kafka.broker.core.file.VolumeGenerationAcknowledger;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
 import dev.knative.eventing.kafka.broker.core.utils.Configurations;
 import io.cloudevents.kafka.CloudEventSerializer;
 import io.vertx.core.Vertx;
@@ -28,6 +30,7 @@ import io.vertx.kafka.client.producer.KafkaProducer;
 import java.io.File;
 import java.io.IOException;
 import java.nio.file.FileSystems;
+import java.util.stream.Collectors;
 import net.logstash.logback.encoder.LogstashEncoder;
 import org.apache.kafka.clients.producer.ProducerConfig;
 import org.apache.kafka.common.serialization.StringSerializer;
@@ -64,7 +67,8 @@ public class Main {
     final var handler = new RequestHandler<>(
       producerConfigs,
       new CloudEventRequestToRecordMapper(),
-      properties -> KafkaProducer.create(vertx, properties)
+      properties -> KafkaProducer.create(vertx, properties),
+      AuthProvider.kubernetes(vertx)
     );
 
     final var httpServerOptions = new HttpServerOptions(
@@ -83,7 +87,12 @@ public class Main {
       final var fw = new FileWatcher(
         FileSystems.getDefault().newWatchService(),
         new ObjectsCreator(handler),
-        new File(env.getDataPlaneConfigFilePath())
+        env.getDataPlaneConfigFilePaths().stream()
+          .map(File::new)
+          .collect(Collectors.toList()),
+        env.getPodName() == null
+          ? VolumeGenerationAcknowledger.noop()
+          : VolumeGenerationAcknowledger.kubernetes(vertx, env.getPodNamespace(), env.getPodName())
       );
 
       fw.watch(); // block forever
@@ -25,7 +25,11 @@ import static net.logstash.logback.argument.StructuredArguments.keyValue;
 import dev.knative.eventing.kafka.broker.core.Broker;
 import dev.knative.eventing.kafka.broker.core.ObjectsReconciler;
 import dev.knative.eventing.kafka.broker.core.Trigger;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
+import dev.knative.eventing.kafka.broker.core.security.Credentials;
+import dev.knative.eventing.kafka.broker.core.security.KafkaClientsAuth;
 import io.cloudevents.CloudEvent;
+import io.vertx.core.CompositeFuture;
 import io.vertx.core.Future;
 import io.vertx.core.Handler;
 import io.vertx.core.Promise;
@@ -34,12 +38,15 @@ import io.vertx.kafka.client.producer.KafkaProducer;
 import io.vertx.kafka.client.producer.KafkaProducerRecord;
 import io.vertx.kafka.client.producer.RecordMetadata;
 import java.util.AbstractMap.SimpleImmutableEntry;
+import java.util.ArrayList;
 import java.util.HashMap;
+import java.util.List;
 import java.util.Map;
 import java.util.Map.Entry;
 import java.util.Objects;
 import java.util.Properties;
 import java.util.Set;
+import java.util.concurrent.ConcurrentHashMap;
 import java.util.concurrent.atomic.AtomicReference;
 import java.util.function.Function;
 import org.apache.kafka.clients.producer.ProducerConfig;
@@ -61,29 +68,35 @@ public class RequestHandler<K, V> implements Handler<HttpServerRequest>,
   private static final Logger logger = LoggerFactory.getLogger(RequestHandler.class);
 
   private final RequestToRecordMapper<K, V> requestToRecordMapper;
-  // path -> <bootstrapServers, producer>
+  // path -> <connection (bootstrapServers and auth secret), producer>
   private final AtomicReference<Map<String, Entry<String, Producer<K, V>>>> producers;
   private final Properties producerConfigs;
   private final Function<Properties, KafkaProducer<K, V>> producerCreator;
+  private final AuthProvider authProvider;
 
   /**
    * Create a new Request handler.
    *
    * @param producerConfigs       common producers configurations
    * @param requestToRecordMapper request to record mapper
+   * @param producerCreator       producer creator
+   * @param authProvider          provider of Brokers credentials
    */
   public RequestHandler(
     final Properties producerConfigs,
     final RequestToRecordMapper<K, V> requestToRecordMapper,
-    final Function<Properties, KafkaProducer<K, V>> producerCreator) {
+    final Function<Properties, KafkaProducer<K, V>> producerCreator,
+    final AuthProvider authProvider) {
 
     Objects.requireNonNull(producerConfigs, "provide producerConfigs");
     Objects.requireNonNull(requestToRecordMapper, "provide a mapper");
     Objects.requireNonNull(producerCreator, "provide producerCreator");
+    Objects.requireNonNull(authProvider, "provide authProvider");
 
     this.producerConfigs = producerConfigs;
     this.requestToRecordMapper = requestToRecordMapper;
     this.producerCreator = producerCreator;
+    this.authProvider = authProvider;
     producers = new AtomicReference<>(new HashMap<>());
   }
 
@@ -149,26 +162,30 @@ public class RequestHandler<K, V> implements Handler<HttpServerRequest>,
   @Override
   public Future<Void> reconcile(Map<Broker, Set<Trigger<CloudEvent>>> objects) {
 
+    // Producers might be added asynchronously, once credentials are loaded.
     final Map<String, Entry<String, Producer<K, V>>> newProducers
-      = new HashMap<>();
+      = new ConcurrentHashMap<>();
 
     final var producers = this.producers.get();
 
+    @SuppressWarnings("rawtypes") final List<Future> futures = new ArrayList<>();
+
     for (final var broker : objects.keySet()) {
       final var pair = producers.get(broker.path());
 
       if (pair == null) {
         // There is no producer for this Broker, so create it and add it to newProducers.
-        addBroker(newProducers, broker);
+        futures.add(addBroker(newProducers, broker));
         continue;
       }
 
-      if (!pair.getKey().equals(broker.bootstrapServers())) {
-        // Bootstrap servers changed, close the old producer, and re-create a new one.
+      if (!pair.getKey().equals(connection(broker))) {
+        // Bootstrap servers or credentials changed, close the old producer, and re-create a new
+        // one.
         final var producer = pair.getValue().producer;
         producer.flush(complete -> producer.close());
 
-        addBroker(newProducers, broker);
+        futures.add(addBroker(newProducers, broker));
         continue;
       }
 
@@ -176,29 +193,54 @@ public class RequestHandler<K, V> implements Handler<HttpServerRequest>,
       newProducers.put(broker.path(), pair);
     }
 
-    this.producers.set(newProducers);
-
-    logger.debug("Added brokers to handler {}", keyValue("brokers", newProducers.keySet()));
+    return CompositeFuture.join(futures)
+      .onComplete(ignored -> {
+        this.producers.set(new HashMap<>(newProducers));
 
-    return Future.succeededFuture();
+        logger.debug("Added brokers to handler {}", keyValue("brokers", newProducers.keySet()));
+      })
+      .mapEmpty();
   }
 
-  private void addBroker(
+  private Future<Void> addBroker(
     final Map<String, Entry<String, Producer<K, V>>> producers,
     final Broker broker) {
 
-    final var producerConfigs = (Properties) this.producerConfigs.clone();
-    producerConfigs.setProperty(ProducerConfig.BOOTSTRAP_SERVERS_CONFIG, broker.bootstrapServers());
+    return getCredentials(broker).map(credentials -> {
+
+      final var producerConfigs = (Properties) this.producerConfigs.clone();
+      producerConfigs
+        .setProperty(ProducerConfig.BOOTSTRAP_SERVERS_CONFIG, broker.bootstrapServers());
+      if (credentials != null) {
+        KafkaClientsAuth.attachCredentials(producerConfigs, credentials);
+      }
 
-    final KafkaProducer<K, V> producer = producerCreator.apply(producerConfigs);
+      final KafkaProducer<K, V> producer = producerCreator.apply(producerConfigs);
 
-    producers.put(
-      broker.path(),
-      new SimpleImmutableEntry<>(
-        broker.bootstrapServers(),
-        new Producer<>(producer, broker.topic())
-      )
-    );
+      producers.put(
+        broker.path(),
+        new SimpleImmutableEntry<>(
+          connection(broker),
+          new Producer<>(producer, broker.topic())
+        )
+      );
+
+      return null;
+    });
+  }
+
+  private Future<Credentials> getCredentials(final Broker broker) {
+    if (broker.authSecretName() == null || broker.authSecretName().isEmpty()) {
+      return Future.succeededFuture();
+    }
+    return authProvider.getCredentials(broker.authSecretNamespace(), broker.authSecretName());
+  }
+
+  private static String connection(final Broker broker) {
+    return broker.bootstrapServers()
+      + "/" + broker.authSecretNamespace()
+      + "/" + broker.authSecretName()
+      + "/" + broker.authSecretVersion();
   }
 
   private static class Producer<K, V> {
@@ -28,7 +28,10 @@ class ReceiverEnvTest {
   private static final String READINESS_PATH = "/readyz";
   private static final String PRODUCER_CONFIG_PATH = "/etc/producer";
   private static final String DATA_PLANE_CONFIG_FILE_PATH = "/etc/brokers";
+  private static final String DATA_PLANE_CONFIG_SHARDS = "3";
   private static final String HTTPSERVER_CONFIG_FILE_PATH = "/etc/http-server-config";
+  private static final String POD_NAME = "kafka-broker-receiver-abcde";
+  private static final String POD_NAMESPACE = "knative-eventing";
 
   @Test
   public void create() {
@@ -40,6 +43,9 @@ class ReceiverEnvTest {
         case ReceiverEnv.HTTPSERVER_CONFIG_FILE_PATH -> HTTPSERVER_CONFIG_FILE_PATH;
         case BaseEnv.PRODUCER_CONFIG_FILE_PATH -> PRODUCER_CONFIG_PATH;
         case BaseEnv.DATA_PLANE_CONFIG_FILE_PATH -> DATA_PLANE_CONFIG_FILE_PATH;
+        case BaseEnv.DATA_PLANE_CONFIG_SHARDS -> DATA_PLANE_CONFIG_SHARDS;
+        case BaseEnv.POD_NAME -> POD_NAME;
+        case BaseEnv.POD_NAMESPACE -> POD_NAMESPACE;
         default -> throw new IllegalArgumentException();
       }
     );
@@ -49,7 +55,15 @@ class ReceiverEnvTest {
     assertThat(env.getReadinessProbePath()).isEqualTo(READINESS_PATH);
     assertThat(env.getProducerConfigFilePath()).isEqualTo(PRODUCER_CONFIG_PATH);
     assertThat(env.getDataPlaneConfigFilePath()).isEqualTo(DATA_PLANE_CONFIG_FILE_PATH);
+    assertThat(env.getDataPlaneConfigShards()).isEqualTo(3);
+    assertThat(env.getDataPlaneConfigFilePaths()).containsExactly(
+      DATA_PLANE_CONFIG_FILE_PATH,
+      DATA_PLANE_CONFIG_FILE_PATH + "-1",
+      DATA_PLANE_CONFIG_FILE_PATH + "-2"
+    );
     assertThat(env.getHttpServerConfigFilePath()).isEqualTo(HTTPSERVER_CONFIG_FILE_PATH);
+    assertThat(env.getPodName()).isEqualTo(POD_NAME);
+    assertThat(env.getPodNamespace()).isEqualTo(POD_NAMESPACE);
 
     // Check toString is overridden
     assertThat(env.toString()).doesNotContain("@");
@@ -27,6 +27,9 @@ import static org.mockito.Mockito.when;
 
 import dev.knative.eventing.kafka.broker.core.BrokerWrapper;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker;
+import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.SecretReference;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
+import dev.knative.eventing.kafka.broker.core.security.KubernetesCredentials;
 import io.vertx.core.AsyncResult;
 import io.vertx.core.Future;
 import io.vertx.core.Handler;
@@ -37,12 +40,15 @@ import io.vertx.junit5.VertxTestContext;
 import io.vertx.kafka.client.producer.KafkaProducer;
 import io.vertx.kafka.client.producer.RecordMetadata;
 import io.vertx.kafka.client.producer.impl.KafkaProducerRecordImpl;
+import java.util.ArrayList;
 import java.util.HashSet;
 import java.util.Map;
 import java.util.Properties;
 import java.util.concurrent.CountDownLatch;
 import java.util.concurrent.TimeUnit;
 import java.util.concurrent.atomic.AtomicBoolean;
+import java.util.concurrent.atomic.AtomicReference;
+import org.apache.kafka.common.config.SaslConfigs;
 import org.junit.jupiter.api.Test;
 import org.junit.jupiter.api.extension.ExtendWith;
 
@@ -95,7 +101,8 @@ public class RequestHandlerTest {
     final var handler = new RequestHandler<>(
       new Properties(),
       mapper,
-      properties -> producer
+      properties -> producer,
+      AuthProvider.noAuth()
     );
 
     final var countDown = new CountDownLatch(1);
@@ -128,7 +135,8 @@ public class RequestHandlerTest {
     final var handler = new RequestHandler<Object, Object>(
       new Properties(),
       mapper,
-      properties -> producer
+      properties -> producer,
+      AuthProvider.noAuth()
     );
 
     final var countDown = new CountDownLatch(1);
@@ -179,7 +187,8 @@ public class RequestHandlerTest {
           recreated.set(true);
         }
         return mock(KafkaProducer.class);
-      }
+      },
+      AuthProvider.noAuth()
     );
 
     final var checkpoint = context.checkpoint();
@@ -224,7 +233,8 @@ public class RequestHandlerTest {
           context.failNow(new IllegalStateException("producer should be recreated"));
         }
         return mock(KafkaProducer.class);
-      }
+      },
+      AuthProvider.noAuth()
     );
 
     final var checkpoint = context.checkpoint();
@@ -249,4 +259,151 @@ public class RequestHandlerTest {
       )
       .onFailure(context::failNow);
   }
+
+  @Test
+  @SuppressWarnings("unchecked")
+  public void shouldCreateProducerWithCredentialsWhenAuthSecretChange(
+    final VertxTestContext context) {
+
+    final RequestToRecordMapper<Object, Object> mapper
+      = (request, topic) -> Future.succeededFuture();
+
+    final var saslConfigs = new ArrayList<String>();
+
+    final var handler = new RequestHandler<Object, Object>(
+      new Properties(),
+      mapper,
+      properties -> {
+        saslConfigs.add(properties.getProperty(SaslConfigs.SASL_JAAS_CONFIG));
+        return mock(KafkaProducer.class);
+      },
+      (namespace, name) -> Future.succeededFuture(new KubernetesCredentials(Map.of(
+        KubernetesCredentials.PROTOCOL_KEY, "SASL_PLAINTEXT",
+        KubernetesCredentials.SASL_USERNAME_KEY, name,
+        KubernetesCredentials.SASL_PASSWORD_KEY, "password"
+      )))
+    );
+
+    final var checkpoint = context.checkpoint();
+
+    final var broker1 = new BrokerWrapper(Broker.newBuilder()
+      .setId("1")
+      .setBootstrapServers("kafka-1:9092,kafka-2:9092")
+      .build());
+
+    final var broker2 = new BrokerWrapper(Broker.newBuilder()
+      .setId("1")
+      .setBootstrapServers("kafka-1:9092,kafka-2:9092")
+      .setAuthSecret(SecretReference.newBuilder()
+        .setNamespace("knative-eventing")
+        .setName("user-1")
+        .build())
+      .build());
+
+    handler.reconcile(Map.of(broker1, new HashSet<>()))
+      .onSuccess(ignored -> handler.reconcile(Map.of(broker2, new HashSet<>()))
+        .onSuccess(i -> context.verify(() -> {
+          assertThat(saslConfigs).hasSize(2);
+          assertThat(saslConfigs.get(0)).isNull();
+          assertThat(saslConfigs.get(1)).contains("username=\"user-1\"");
+          checkpoint.flag();
+        }))
+        .onFailure(context::failNow)
+      )
+      .onFailure(context::failNow);
+  }
+
+  @Test
+  @SuppressWarnings("unchecked")
+  public void shouldRecreateProducerWhenCredentialsAreRotated(final VertxTestContext context) {
+
+    final RequestToRecordMapper<Object, Object> mapper
+      = (request, topic) -> Future.succeededFuture();
+
+    final var jaasConfigs = new ArrayList<String>();
+    final var password = new AtomicReference<>("password-1");
+
+    final var handler = new RequestHandler<Object, Object>(
+      new Properties(),
+      mapper,
+      properties -> {
+        jaasConfigs.add(properties.getProperty(SaslConfigs.SASL_JAAS_CONFIG));
+        return mock(KafkaProducer.class);
+      },
+      (namespace, name) -> Future.succeededFuture(new KubernetesCredentials(Map.of(
+        KubernetesCredentials.PROTOCOL_KEY, "SASL_PLAINTEXT",
+        KubernetesCredentials.SASL_USERNAME_KEY, name,
+        KubernetesCredentials.SASL_PASSWORD_KEY, password.get()
+      )))
+    );
+
+    final var checkpoint = context.checkpoint();
+
+    final var broker1 = new BrokerWrapper(Broker.newBuilder()
+      .setId("1")
+      .setBootstrapServers("kafka-1:9092,kafka-2:9092")
+      .setAuthSecret(SecretReference.newBuilder()
+        .setNamespace("knative-eventing")
+        .setName("user-1")
+        .setVersion("1")
+        .build())
+      .build());
+
+    final var broker2 = new BrokerWrapper(Broker.newBuilder()
+      .setId("1")
+      .setBootstrapServers("kafka-1:9092,kafka-2:9092")
+      .setAuthSecret(SecretReference.newBuilder()
+        .setNamespace("knative-eventing")
+        .setName("user-1")
+        .setVersion("2")
+        .build())
+      .build());
+
+    handler.reconcile(Map.of(broker1, new HashSet<>()))
+      .onSuccess(ignored -> {
+        password.set("password-2");
+        handler.reconcile(Map.of(broker2, new HashSet<>()))
+          .onSuccess(i -> context.verify(() -> {
+            assertThat(jaasConfigs).hasSize(2);
+            assertThat(jaasConfigs.get(0)).contains("password=\"password-1\"");
+            assertThat(jaasConfigs.get(1)).contains("password=\"password-2\"");
+            checkpoint.flag();
+          }))
+          .onFailure(context::failNow);
+      })
+      .onFailure(context::failNow);
+  }
+
+  @Test
+  @SuppressWarnings("unchecked")
+  public void shouldFailReconcileWhenCredentialsCannotBeLoaded(final VertxTestContext context) {
+
+    final RequestToRecordMapper<Object, Object> mapper
+      = (request, topic) -> Future.succeededFuture();
+
+    final var handler = new RequestHandler<Object, Object>(
+      new Properties(),
+      mapper,
+      properties -> mock(KafkaProducer.class),
+      (namespace, name) -> Future.failedFuture(new IllegalStateException("secret not found"))
+    );
+
+    final var checkpoint = context.checkpoint();
+
+    final var broker = new BrokerWrapper(Broker.newBuilder()
+      .setId("1")
+      .setBootstrapServers("kafka-1:9092,kafka-2:9092")
+      .setAuthSecret(SecretReference.newBuilder()
+        .setNamespace("knative-eventing")
+        .setName("user-1")
+        .build())
+      .build());
+
+    handler.reconcile(Map.of(broker, new HashSet<>()))
+      .onSuccess(ignored -> context.failNow(new IllegalStateException("reconcile succeeded")))
+      .onFailure(cause -> context.verify(() -> {
+        assertThat(cause).hasMessage("secret not found");
+        checkpoint.flag();
+      }));
+  }
 }
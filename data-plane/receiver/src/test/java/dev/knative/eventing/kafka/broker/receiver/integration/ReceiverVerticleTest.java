@@ -24,6 +24,7 @@ import com.fasterxml.jackson.databind.node.TextNode;
 import dev.knative.eventing.kafka.broker.core.Broker;
 import dev.knative.eventing.kafka.broker.core.BrokerWrapper;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
 import dev.knative.eventing.kafka.broker.receiver.CloudEventRequestToRecordMapper;
 import dev.knative.eventing.kafka.broker.receiver.HttpVerticle;
 import dev.knative.eventing.kafka.broker.receiver.RequestHandler;
@@ -83,7 +84,8 @@ public class ReceiverVerticleTest {
     handler = new RequestHandler<>(
       new Properties(),
       new CloudEventRequestToRecordMapper(),
-      properties -> producer
+      properties -> producer,
+      AuthProvider.noAuth()
     );
 
     final var httpServerOptions = new HttpServerOptions();
@@ -29,6 +29,7 @@ import dev.knative.eventing.kafka.broker.core.ObjectsReconciler;
 import dev.knative.eventing.kafka.broker.core.TriggerWrapper;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Broker;
 import dev.knative.eventing.kafka.broker.core.config.BrokersConfig.Trigger;
+import dev.knative.eventing.kafka.broker.core.security.AuthProvider;
 import dev.knative.eventing.kafka.broker.dispatcher.BrokersManager;
 import dev.knative.eventing.kafka.broker.dispatcher.ConsumerRecordOffsetStrategyFactory;
 import dev.knative.eventing.kafka.broker.dispatcher.http.HttpConsumerVerticleFactory;
@@ -298,7 +299,8 @@ public class DataPlaneTest {
       consumerConfigs,
       WebClient.create(vertx),
       vertx,
-      producerConfigs
+      producerConfigs,
+      AuthProvider.noAuth()
     );
 
     return new BrokersManager<>(
@@ -316,7 +318,8 @@ public class DataPlaneTest {
     final var handler = new RequestHandler<>(
       producerConfigs(),
       new CloudEventRequestToRecordMapper(),
-      properties -> KafkaProducer.create(vertx, properties)
+      properties -> KafkaProducer.create(vertx, properties),
+      AuthProvider.noAuth()
     );
 
     final var httpServerOptions = new HttpServerOptions();
@@ -10,7 +10,10 @@ require (
 	github.com/google/go-cmp v0.5.1
 	github.com/google/uuid v1.1.1
 	github.com/kelseyhightower/envconfig v1.4.0
+	github.com/rickb777/date v1.13.0
 	github.com/stretchr/testify v1.6.0
+	github.com/xdg/scram v0.0.0-20180814205039-7eeb5667e42c
+	go.opencensus.io v0.22.4
 	go.uber.org/zap v1.15.0
 	k8s.io/api v0.18.7-rc.0
 	k8s.io/apiextensions-apiserver v0.18.4
@@ -39,7 +39,6 @@ func main() {
 			Namespace: envConfig.DataPlaneConfigMapNamespace,
 			Name:      envConfig.DataPlaneConfigMapName,
 		},
-		envConfig.DataPlaneConfigFormat,
 	)
 
 }
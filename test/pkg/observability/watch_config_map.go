@@ -43,7 +43,7 @@ const (
 // WatchDataPlaneConfigMap watches our data plane config map, and it logs out all differences at every change.
 //
 // This function is used for troubleshooting failed test runs.
-func WatchDataPlaneConfigMap(cm types.NamespacedName, format string) {
+func WatchDataPlaneConfigMap(cm types.NamespacedName) {
 
 	ctx := signals.NewContext()
 	cfg := sharedmain.ParseAndGetConfigOrDie()
@@ -55,7 +55,6 @@ func WatchDataPlaneConfigMap(cm types.NamespacedName, format string) {
 
 		diffLogger := diffLogger{
 			logger: logger,
-			format: format,
 		}
 
 		watcher.Watch(cm.Name, diffLogger.logDiff)
@@ -73,13 +72,12 @@ func WatchDataPlaneConfigMap(cm types.NamespacedName, format string) {
 type diffLogger struct {
 	m      sync.Mutex
 	logger *zap.Logger
-	format string
 	prev   *coreconfig.Brokers
 }
 
 func (d *diffLogger) logDiff(cm *corev1.ConfigMap) {
 
-	brokers, err := base.GetDataPlaneConfigMapData(d.logger, cm, d.format)
+	brokers, err := base.GetDataPlaneConfigMapData(d.logger, cm)
 	if err != nil {
 		d.logger.Error(
 			"failed to get data plane config map data",